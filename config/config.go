@@ -74,7 +74,19 @@ func buildConfig() Config {
 			MaxConnLifeTime: PriorityInt(e.GetInt("REDIS_MAX_CONN_LIFE_TIME")),
 		},
 
-		EnableSwagger: strings.ToLower(PriorityString(e.GetString("ENABLE_SWAGGER"), "false")) == "true",
+		Storage: StorageConfig{
+			Driver:    PriorityString(e.GetString("STORAGE_DRIVER"), "local"),
+			LocalPath: PriorityString(e.GetString("STORAGE_LOCAL_PATH"), "./storage"),
+		},
+
+		Media: MediaConfig{
+			MaxUploadSize:    int64(PriorityInt(e.GetInt("MEDIA_MAX_UPLOAD_SIZE"), 20<<20)),
+			MaxAudioDuration: PriorityInt(e.GetInt("MEDIA_MAX_AUDIO_DURATION"), 600),
+			StreamURLTTL:     PriorityInt(e.GetInt("MEDIA_STREAM_URL_TTL"), 900),
+		},
+
+		EnableSwagger:   strings.ToLower(PriorityString(e.GetString("ENABLE_SWAGGER"), "false")) == "true",
+		EnableScheduler: strings.ToLower(PriorityString(e.GetString("ENABLE_SCHEDULER"), "true")) == "true",
 	}
 
 	return config
@@ -9,7 +9,15 @@ type Config struct {
 
 	JWT JWTConfig
 
+	Storage StorageConfig
+
+	Media MediaConfig
+
 	EnableSwagger bool
+
+	// EnableScheduler runs the background jobs in this process, turn it off
+	// on all but one instance when scaling out.
+	EnableScheduler bool
 }
 
 type AppConfig struct {
@@ -50,3 +58,14 @@ type RedisConfig struct {
 	IdleTimeout     int
 	MaxConnLifeTime int
 }
+
+type StorageConfig struct {
+	Driver    string
+	LocalPath string
+}
+
+type MediaConfig struct {
+	MaxUploadSize    int64
+	MaxAudioDuration int
+	StreamURLTTL     int
+}
@@ -29,6 +29,10 @@ func main() {
 		FieldWithTypeTag: true,
 	})
 	g.UseDB(db)
+	// every table is soft deleted, gorm leaves the deleted rows out of the
+	// queries of the generated DAOs
+	g.WithImportPkgPath("wakuwaku_nihongo/internals/pkg/softdelete")
+	g.WithOpts(gen.FieldType("deleted_at", "softdelete.DeletedAt"))
 
 	customers := g.GenerateModel("customers",
 		gen.FieldNewTag("password", field.Tag{
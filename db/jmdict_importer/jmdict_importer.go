@@ -0,0 +1,52 @@
+package main
+
+import (
+	"compress/gzip"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+
+	"wakuwaku_nihongo/internals/app/dictionary"
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/pkg/database"
+	"wakuwaku_nihongo/internals/utils/env"
+)
+
+func init() {
+	env := env.NewEnv()
+	env.Load(`../../.env`)
+}
+
+// usage: go run jmdict_importer.go -file JMdict_e.gz
+func main() {
+	path := flag.String("file", "JMdict_e", "path to the JMdict XML file, optionally gzipped")
+	flag.Parse()
+
+	file, err := os.Open(*path)
+	if err != nil {
+		log.Fatal().Err(err).Msg("open jmdict file")
+	}
+	defer file.Close()
+
+	var r io.Reader = file
+	if strings.HasSuffix(*path, ".gz") {
+		gz, err := gzip.NewReader(file)
+		if err != nil {
+			log.Fatal().Err(err).Msg("open gzip reader")
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	database.Init("silent")
+	f := factory.NewFactory()
+
+	_, err = dictionary.NewImporter(f.Db).Import(filepath.Base(*path), r)
+	if err != nil {
+		log.Fatal().Err(err).Msg("import jmdict")
+	}
+}
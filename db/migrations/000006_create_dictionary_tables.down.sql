@@ -0,0 +1,8 @@
+DROP TABLE dictionary_glosses;
+DROP TABLE dictionary_senses;
+DROP TABLE dictionary_reading_restrictions;
+DROP TABLE dictionary_readings;
+DROP TABLE dictionary_kanji;
+DROP TABLE dictionary_entries;
+DROP TABLE dictionary_tags;
+DROP TABLE dictionary_releases;
@@ -0,0 +1,82 @@
+CREATE TABLE IF NOT EXISTS dictionary_releases (
+    release_id UUID PRIMARY KEY,
+    created_at BIGINT NOT NULL,
+    modified_at BIGINT,
+    deleted_at BIGINT,
+    created_by VARCHAR NOT NULL,
+    modified_by VARCHAR,
+    deleted_by VARCHAR,
+    source VARCHAR NOT NULL,
+    release_date VARCHAR,
+    added_count INT NOT NULL DEFAULT 0,
+    updated_count INT NOT NULL DEFAULT 0,
+    unchanged_count INT NOT NULL DEFAULT 0,
+    removed_count INT NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS dictionary_tags (
+    name VARCHAR PRIMARY KEY,
+    description VARCHAR NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS dictionary_entries (
+    ent_seq BIGINT PRIMARY KEY,
+    created_at BIGINT NOT NULL,
+    modified_at BIGINT,
+    deleted_at BIGINT,
+    created_by VARCHAR NOT NULL,
+    modified_by VARCHAR,
+    deleted_by VARCHAR,
+    content_hash VARCHAR NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS dictionary_kanji (
+    kanji_id UUID PRIMARY KEY,
+    ent_seq BIGINT NOT NULL REFERENCES dictionary_entries(ent_seq) ON DELETE CASCADE,
+    position INT NOT NULL,
+    text VARCHAR NOT NULL,
+    info VARCHAR,
+    priority VARCHAR
+);
+CREATE INDEX IF NOT EXISTS idx_dictionary_kanji_text ON dictionary_kanji (text varchar_pattern_ops);
+CREATE INDEX IF NOT EXISTS idx_dictionary_kanji_ent_seq ON dictionary_kanji (ent_seq);
+
+CREATE TABLE IF NOT EXISTS dictionary_readings (
+    reading_id UUID PRIMARY KEY,
+    ent_seq BIGINT NOT NULL REFERENCES dictionary_entries(ent_seq) ON DELETE CASCADE,
+    position INT NOT NULL,
+    text VARCHAR NOT NULL,
+    no_kanji BOOLEAN NOT NULL DEFAULT FALSE,
+    info VARCHAR,
+    priority VARCHAR
+);
+CREATE INDEX IF NOT EXISTS idx_dictionary_readings_text ON dictionary_readings (text varchar_pattern_ops);
+CREATE INDEX IF NOT EXISTS idx_dictionary_readings_ent_seq ON dictionary_readings (ent_seq);
+
+CREATE TABLE IF NOT EXISTS dictionary_reading_restrictions (
+    reading_id UUID NOT NULL REFERENCES dictionary_readings(reading_id) ON DELETE CASCADE,
+    kanji_id UUID NOT NULL REFERENCES dictionary_kanji(kanji_id) ON DELETE CASCADE,
+    PRIMARY KEY (reading_id, kanji_id)
+);
+
+CREATE TABLE IF NOT EXISTS dictionary_senses (
+    sense_id UUID PRIMARY KEY,
+    ent_seq BIGINT NOT NULL REFERENCES dictionary_entries(ent_seq) ON DELETE CASCADE,
+    position INT NOT NULL,
+    parts_of_speech VARCHAR,
+    fields VARCHAR,
+    misc VARCHAR,
+    dialects VARCHAR,
+    info VARCHAR
+);
+CREATE INDEX IF NOT EXISTS idx_dictionary_senses_ent_seq ON dictionary_senses (ent_seq);
+
+CREATE TABLE IF NOT EXISTS dictionary_glosses (
+    gloss_id UUID PRIMARY KEY,
+    sense_id UUID NOT NULL REFERENCES dictionary_senses(sense_id) ON DELETE CASCADE,
+    position INT NOT NULL,
+    lang VARCHAR NOT NULL DEFAULT 'eng',
+    text VARCHAR NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_dictionary_glosses_text ON dictionary_glosses (lower(text) varchar_pattern_ops);
+CREATE INDEX IF NOT EXISTS idx_dictionary_glosses_sense_id ON dictionary_glosses (sense_id);
@@ -0,0 +1 @@
+ALTER TABLE customers DROP COLUMN role;
\ No newline at end of file
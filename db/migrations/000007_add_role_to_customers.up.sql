@@ -0,0 +1 @@
+ALTER TABLE customers ADD COLUMN IF NOT EXISTS role VARCHAR NOT NULL DEFAULT 'learner';
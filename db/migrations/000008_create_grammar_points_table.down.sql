@@ -0,0 +1,3 @@
+DROP TABLE question_grammar_points;
+DROP TABLE grammar_examples;
+DROP TABLE grammar_points;
\ No newline at end of file
@@ -0,0 +1,32 @@
+CREATE TABLE IF NOT EXISTS grammar_points (
+    grammar_point_id UUID PRIMARY KEY,
+    created_at BIGINT NOT NULL,
+    modified_at BIGINT,
+    deleted_at BIGINT,
+    created_by VARCHAR NOT NULL,
+    modified_by VARCHAR,
+    deleted_by VARCHAR,
+    pattern VARCHAR NOT NULL,
+    meaning VARCHAR NOT NULL,
+    formation JSONB NOT NULL DEFAULT '[]',
+    jlpt_level VARCHAR NOT NULL,
+    notes VARCHAR
+);
+CREATE INDEX IF NOT EXISTS idx_grammar_points_jlpt_level ON grammar_points (jlpt_level);
+
+CREATE TABLE IF NOT EXISTS grammar_examples (
+    grammar_example_id UUID PRIMARY KEY,
+    grammar_point_id UUID NOT NULL REFERENCES grammar_points(grammar_point_id) ON DELETE CASCADE,
+    position INT NOT NULL,
+    sentence VARCHAR NOT NULL,
+    translation VARCHAR
+);
+
+CREATE TABLE IF NOT EXISTS question_grammar_points (
+    question_id UUID NOT NULL REFERENCES questions(question_id) ON DELETE CASCADE,
+    grammar_point_id UUID NOT NULL REFERENCES grammar_points(grammar_point_id) ON DELETE CASCADE,
+    created_at BIGINT NOT NULL,
+    created_by VARCHAR NOT NULL,
+    PRIMARY KEY (question_id, grammar_point_id)
+);
+CREATE INDEX IF NOT EXISTS idx_question_grammar_points_grammar_point_id ON question_grammar_points (grammar_point_id);
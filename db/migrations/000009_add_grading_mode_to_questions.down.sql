@@ -0,0 +1 @@
+ALTER TABLE questions DROP COLUMN grading_mode;
\ No newline at end of file
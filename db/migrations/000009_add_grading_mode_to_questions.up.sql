@@ -0,0 +1 @@
+ALTER TABLE questions ADD COLUMN IF NOT EXISTS grading_mode VARCHAR NOT NULL DEFAULT 'standard';
@@ -0,0 +1,32 @@
+CREATE TABLE IF NOT EXISTS attempts (
+    attempt_id UUID PRIMARY KEY,
+    created_at BIGINT NOT NULL,
+    modified_at BIGINT,
+    deleted_at BIGINT,
+    created_by VARCHAR NOT NULL,
+    modified_by VARCHAR,
+    deleted_by VARCHAR,
+    customer_id UUID NOT NULL,
+    quiz_id UUID NOT NULL REFERENCES quizzes(quiz_id) ON DELETE CASCADE,
+    status VARCHAR NOT NULL,
+    submitted_at BIGINT,
+    score INT NOT NULL DEFAULT 0,
+    total INT NOT NULL DEFAULT 0
+);
+CREATE INDEX IF NOT EXISTS idx_attempts_customer_id ON attempts (customer_id);
+
+CREATE TABLE IF NOT EXISTS attempt_answers (
+    attempt_answer_id UUID PRIMARY KEY,
+    created_at BIGINT NOT NULL,
+    modified_at BIGINT,
+    deleted_at BIGINT,
+    created_by VARCHAR NOT NULL,
+    modified_by VARCHAR,
+    deleted_by VARCHAR,
+    attempt_id UUID NOT NULL REFERENCES attempts(attempt_id) ON DELETE CASCADE,
+    question_id UUID NOT NULL REFERENCES questions(question_id) ON DELETE CASCADE,
+    answer_id UUID REFERENCES answers(answer_id) ON DELETE SET NULL,
+    answer_text VARCHAR,
+    is_correct BOOLEAN,
+    UNIQUE (attempt_id, question_id)
+);
@@ -0,0 +1,14 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- One row per question holding its normalized text (question, answers and
+-- their furigana readings) and the character bigrams of that text. Bigrams
+-- make substring search work for Japanese, which has no spaces between words.
+CREATE TABLE IF NOT EXISTS question_search_documents (
+    question_id UUID PRIMARY KEY REFERENCES questions(question_id) ON DELETE CASCADE,
+    content TEXT NOT NULL,
+    bigrams TEXT[] NOT NULL,
+    modified_at BIGINT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_question_search_documents_bigrams ON question_search_documents USING GIN (bigrams);
+CREATE INDEX IF NOT EXISTS idx_question_search_documents_content ON question_search_documents USING GIN (content gin_trgm_ops);
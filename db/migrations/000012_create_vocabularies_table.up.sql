@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS vocabularies (
+    vocabulary_id UUID PRIMARY KEY,
+    created_at BIGINT NOT NULL,
+    modified_at BIGINT,
+    deleted_at BIGINT,
+    created_by VARCHAR NOT NULL,
+    modified_by VARCHAR,
+    deleted_by VARCHAR,
+    word VARCHAR NOT NULL,
+    reading VARCHAR NOT NULL,
+    meaning VARCHAR NOT NULL,
+    part_of_speech VARCHAR NOT NULL,
+    jlpt_level VARCHAR NOT NULL,
+    example_sentence VARCHAR,
+    ent_seq BIGINT REFERENCES dictionary_entries(ent_seq) ON DELETE SET NULL
+);
+CREATE INDEX IF NOT EXISTS idx_vocabularies_jlpt_level_part_of_speech ON vocabularies (jlpt_level, part_of_speech);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_vocabularies_word_reading ON vocabularies (word, reading) WHERE deleted_at IS NULL;
@@ -0,0 +1,2 @@
+ALTER TABLE quizzes DROP COLUMN IF EXISTS jlpt_level;
+ALTER TABLE quizzes DROP COLUMN IF EXISTS status;
@@ -0,0 +1,2 @@
+ALTER TABLE quizzes ADD COLUMN IF NOT EXISTS status VARCHAR NOT NULL DEFAULT 'published';
+ALTER TABLE quizzes ADD COLUMN IF NOT EXISTS jlpt_level VARCHAR;
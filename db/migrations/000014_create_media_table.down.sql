@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS media_attachments;
+DROP TABLE IF EXISTS media;
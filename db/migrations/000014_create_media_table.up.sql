@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS media (
+    media_id UUID PRIMARY KEY,
+    created_at BIGINT NOT NULL,
+    modified_at BIGINT,
+    deleted_at BIGINT,
+    created_by VARCHAR NOT NULL,
+    modified_by VARCHAR,
+    deleted_by VARCHAR,
+    storage_key VARCHAR NOT NULL,
+    file_name VARCHAR NOT NULL,
+    mime_type VARCHAR NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    duration_ms BIGINT NOT NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_media_storage_key ON media (storage_key);
+
+-- owner_type is question or passage, an owner has at most one audio file.
+CREATE TABLE IF NOT EXISTS media_attachments (
+    owner_type VARCHAR NOT NULL,
+    owner_id UUID NOT NULL,
+    media_id UUID NOT NULL REFERENCES media(media_id) ON DELETE CASCADE,
+    created_at BIGINT NOT NULL,
+    created_by VARCHAR NOT NULL,
+    PRIMARY KEY (owner_type, owner_id)
+);
+CREATE INDEX IF NOT EXISTS idx_media_attachments_media_id ON media_attachments (media_id);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_questions_passage_id;
+ALTER TABLE questions DROP COLUMN IF EXISTS passage_id;
+DROP TABLE IF EXISTS passages;
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS passages (
+    passage_id UUID PRIMARY KEY,
+    created_at BIGINT NOT NULL,
+    modified_at BIGINT,
+    deleted_at BIGINT,
+    created_by VARCHAR NOT NULL,
+    modified_by VARCHAR,
+    deleted_by VARCHAR,
+    title VARCHAR,
+    passage_text TEXT NOT NULL,
+    source VARCHAR,
+    image_url VARCHAR,
+    jlpt_level VARCHAR
+);
+CREATE INDEX IF NOT EXISTS idx_passages_jlpt_level ON passages (jlpt_level) WHERE deleted_at IS NULL;
+
+ALTER TABLE questions ADD COLUMN IF NOT EXISTS passage_id UUID REFERENCES passages(passage_id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS idx_questions_passage_id ON questions (passage_id);
@@ -0,0 +1,14 @@
+-- owner_type is question or answer, locale is en, id or ja.
+CREATE TABLE IF NOT EXISTS explanations (
+    owner_type VARCHAR NOT NULL,
+    owner_id UUID NOT NULL,
+    locale VARCHAR NOT NULL,
+    created_at BIGINT NOT NULL,
+    modified_at BIGINT,
+    deleted_at BIGINT,
+    created_by VARCHAR NOT NULL,
+    modified_by VARCHAR,
+    deleted_by VARCHAR,
+    explanation TEXT NOT NULL,
+    PRIMARY KEY (owner_type, owner_id, locale)
+);
@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS customer_preferences (
+    customer_id UUID PRIMARY KEY,
+    created_at BIGINT NOT NULL,
+    modified_at BIGINT,
+    locale VARCHAR
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS learner_stats;
+ALTER TABLE questions DROP COLUMN IF EXISTS section;
@@ -0,0 +1,73 @@
+ALTER TABLE questions ADD COLUMN IF NOT EXISTS section VARCHAR;
+
+-- derive the section of existing questions: audio means listening, a passage
+-- means reading and linked grammar points mean grammar.
+UPDATE questions q SET section = CASE
+    WHEN EXISTS (
+        SELECT 1 FROM media_attachments m
+        WHERE (m.owner_type = 'question' AND m.owner_id = q.question_id)
+           OR (m.owner_type = 'passage' AND m.owner_id = q.passage_id)
+    ) THEN 'listening'
+    WHEN q.passage_id IS NOT NULL THEN 'reading'
+    WHEN EXISTS (
+        SELECT 1 FROM question_grammar_points g WHERE g.question_id = q.question_id
+    ) THEN 'grammar'
+    ELSE 'vocabulary'
+END
+WHERE q.section IS NULL;
+
+-- daily answer counts of a customer per dimension (overall, jlpt_level,
+-- section, question_type, grammar_point), updated when an attempt is
+-- submitted.
+CREATE TABLE IF NOT EXISTS learner_stats (
+    customer_id UUID NOT NULL,
+    day DATE NOT NULL,
+    dimension VARCHAR NOT NULL,
+    dimension_key VARCHAR NOT NULL,
+    correct INT NOT NULL DEFAULT 0,
+    total INT NOT NULL DEFAULT 0,
+    PRIMARY KEY (customer_id, dimension, dimension_key, day)
+);
+CREATE INDEX IF NOT EXISTS idx_learner_stats_customer_id_day ON learner_stats (customer_id, day);
+
+-- backfill from the attempts submitted so far. The runtime buckets days in
+-- the customer's time zone, falling back to DB_TZ (stats.Day with
+-- preferences.LoadLocation). No customer has a time zone yet, the column is
+-- added by the next migration, so every day falls back to DB_TZ. Migrations
+-- cannot read the env and the migrate CLI session is not in DB_TZ, so its
+-- default, Asia/Jakarta, is spelled out; change it here when DB_TZ differs.
+-- The UTC day in the streak rollover only bounds the candidates it checks,
+-- each streak is still settled in the customer's day.
+WITH graded AS (
+    SELECT a.customer_id,
+           (to_timestamp(a.submitted_at / 1000.0) AT TIME ZONE 'Asia/Jakarta')::date AS day,
+           q.question_id,
+           q.question_type,
+           q.section,
+           z.jlpt_level,
+           CASE WHEN aa.is_correct THEN 1 ELSE 0 END AS correct
+    FROM attempts a
+    JOIN quizzes z ON z.quiz_id = a.quiz_id
+    JOIN questions q ON q.quiz_id = a.quiz_id AND q.deleted_at IS NULL
+    LEFT JOIN attempt_answers aa
+        ON aa.attempt_id = a.attempt_id AND aa.question_id = q.question_id AND aa.deleted_at IS NULL
+    WHERE a.status = 'submitted' AND a.deleted_at IS NULL AND a.submitted_at IS NOT NULL
+),
+keyed AS (
+    SELECT customer_id, day, 'overall' AS dimension, '' AS dimension_key, correct FROM graded
+    UNION ALL
+    SELECT customer_id, day, 'jlpt_level', jlpt_level, correct FROM graded WHERE jlpt_level IS NOT NULL
+    UNION ALL
+    SELECT customer_id, day, 'section', section, correct FROM graded WHERE section IS NOT NULL
+    UNION ALL
+    SELECT customer_id, day, 'question_type', question_type, correct FROM graded WHERE question_type IS NOT NULL
+    UNION ALL
+    SELECT g.customer_id, g.day, 'grammar_point', p.grammar_point_id::text, g.correct
+    FROM graded g
+    JOIN question_grammar_points p ON p.question_id = g.question_id
+)
+INSERT INTO learner_stats (customer_id, day, dimension, dimension_key, correct, total)
+SELECT customer_id, day, dimension, dimension_key, SUM(correct), COUNT(*)
+FROM keyed
+GROUP BY customer_id, day, dimension, dimension_key
+ON CONFLICT (customer_id, dimension, dimension_key, day) DO NOTHING;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS customer_streaks;
+ALTER TABLE customer_preferences DROP COLUMN IF EXISTS timezone;
@@ -0,0 +1,16 @@
+ALTER TABLE customer_preferences ADD COLUMN IF NOT EXISTS timezone VARCHAR;
+
+-- last_goal_day is the last local day the daily goal was met or a freeze
+-- covered, the streak ends when a day after it passes uncovered.
+CREATE TABLE IF NOT EXISTS customer_streaks (
+    customer_id UUID PRIMARY KEY,
+    created_at BIGINT NOT NULL,
+    modified_at BIGINT,
+    current_streak INT NOT NULL DEFAULT 0,
+    longest_streak INT NOT NULL DEFAULT 0,
+    last_goal_day DATE,
+    daily_goal INT NOT NULL DEFAULT 10,
+    freezes INT NOT NULL DEFAULT 0,
+    freezes_used INT NOT NULL DEFAULT 0
+);
+CREATE INDEX IF NOT EXISTS idx_customer_streaks_last_goal_day ON customer_streaks (last_goal_day) WHERE current_streak > 0;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS leaderboard_snapshots;
+DROP TABLE IF EXISTS customer_friends;
+DROP TABLE IF EXISTS xp_events;
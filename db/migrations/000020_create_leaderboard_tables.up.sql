@@ -0,0 +1,42 @@
+-- ledger of earned XP, a source earns a customer XP once. The leaderboards
+-- in redis are sums of it.
+CREATE TABLE IF NOT EXISTS xp_events (
+    xp_event_id UUID PRIMARY KEY,
+    created_at BIGINT NOT NULL,
+    customer_id UUID NOT NULL,
+    source_type VARCHAR NOT NULL,
+    source_id VARCHAR NOT NULL,
+    jlpt_level VARCHAR,
+    amount INT NOT NULL,
+    earned_at BIGINT NOT NULL,
+    UNIQUE (customer_id, source_type, source_id)
+);
+CREATE INDEX IF NOT EXISTS idx_xp_events_earned_at ON xp_events (earned_at);
+
+-- backfill the XP of the attempts submitted so far, 10 per correct answer
+INSERT INTO xp_events (xp_event_id, created_at, customer_id, source_type, source_id, jlpt_level, amount, earned_at)
+SELECT gen_random_uuid(), (EXTRACT(EPOCH FROM now()) * 1000)::BIGINT, a.customer_id, 'attempt', a.attempt_id::text, z.jlpt_level, a.score * 10, a.submitted_at
+FROM attempts a
+JOIN quizzes z ON z.quiz_id = a.quiz_id
+WHERE a.status = 'submitted' AND a.deleted_at IS NULL AND a.submitted_at IS NOT NULL AND a.score > 0
+ON CONFLICT (customer_id, source_type, source_id) DO NOTHING;
+
+-- friendships are one way, a customer follows friend_id
+CREATE TABLE IF NOT EXISTS customer_friends (
+    customer_id UUID NOT NULL,
+    friend_id UUID NOT NULL REFERENCES customers(customer_id) ON DELETE CASCADE,
+    created_at BIGINT NOT NULL,
+    PRIMARY KEY (customer_id, friend_id)
+);
+
+CREATE TABLE IF NOT EXISTS leaderboard_snapshots (
+    period_type VARCHAR NOT NULL,
+    period_key VARCHAR NOT NULL,
+    jlpt_level VARCHAR NOT NULL,
+    customer_id UUID NOT NULL,
+    rank INT NOT NULL,
+    xp BIGINT NOT NULL,
+    created_at BIGINT NOT NULL,
+    PRIMARY KEY (period_type, period_key, jlpt_level, customer_id)
+);
+CREATE INDEX IF NOT EXISTS idx_leaderboard_snapshots_rank ON leaderboard_snapshots (period_type, period_key, jlpt_level, rank);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS customer_kanji;
+DROP TABLE IF EXISTS customer_badges;
@@ -0,0 +1,21 @@
+-- badges are awarded once per customer, the primary key keeps a rule that
+-- is evaluated twice from awarding twice
+CREATE TABLE IF NOT EXISTS customer_badges (
+    customer_id UUID NOT NULL,
+    code VARCHAR NOT NULL,
+    created_at BIGINT NOT NULL,
+    event_type VARCHAR NOT NULL,
+    source_id VARCHAR NOT NULL,
+    awarded_at BIGINT NOT NULL,
+    PRIMARY KEY (customer_id, code)
+);
+
+-- correct answers per kanji, a kanji is mastered after 5 of them
+CREATE TABLE IF NOT EXISTS customer_kanji (
+    customer_id UUID NOT NULL,
+    kanji VARCHAR NOT NULL,
+    correct_count INT NOT NULL DEFAULT 0,
+    last_correct_at BIGINT NOT NULL,
+    mastered_at BIGINT,
+    PRIMARY KEY (customer_id, kanji)
+);
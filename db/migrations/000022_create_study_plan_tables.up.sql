@@ -0,0 +1,24 @@
+-- a customer has one plan, days are dates of the customer's timezone
+CREATE TABLE IF NOT EXISTS study_plans (
+    customer_id UUID PRIMARY KEY,
+    created_at BIGINT NOT NULL,
+    modified_at BIGINT,
+    jlpt_level VARCHAR NOT NULL,
+    exam_date DATE NOT NULL,
+    start_date DATE NOT NULL,
+    replanned_at BIGINT
+);
+
+-- ref_id is the kanji, vocabulary_id, grammar_point_id or quiz_id studied
+CREATE TABLE IF NOT EXISTS study_plan_tasks (
+    task_id UUID PRIMARY KEY,
+    created_at BIGINT NOT NULL,
+    customer_id UUID NOT NULL REFERENCES study_plans(customer_id) ON DELETE CASCADE,
+    task_type VARCHAR NOT NULL,
+    ref_id VARCHAR NOT NULL,
+    title VARCHAR NOT NULL,
+    day DATE NOT NULL,
+    position INT NOT NULL,
+    completed_at BIGINT
+);
+CREATE INDEX IF NOT EXISTS idx_study_plan_tasks_customer_day ON study_plan_tasks (customer_id, day, position);
@@ -0,0 +1,41 @@
+-- source_deck_id is the public deck a deck was cloned from
+CREATE TABLE IF NOT EXISTS decks (
+    deck_id UUID PRIMARY KEY,
+    created_at BIGINT NOT NULL,
+    modified_at BIGINT,
+    deleted_at BIGINT,
+    created_by VARCHAR NOT NULL,
+    modified_by VARCHAR,
+    deleted_by VARCHAR,
+    customer_id UUID NOT NULL,
+    name VARCHAR NOT NULL,
+    description VARCHAR,
+    is_public BOOLEAN NOT NULL DEFAULT FALSE,
+    source_deck_id UUID REFERENCES decks(deck_id)
+);
+CREATE INDEX IF NOT EXISTS idx_decks_customer ON decks (customer_id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_decks_public ON decks (modified_at) WHERE is_public AND deleted_at IS NULL;
+
+-- cards carry their SM-2 review schedule, times are unix milliseconds
+CREATE TABLE IF NOT EXISTS cards (
+    card_id UUID PRIMARY KEY,
+    created_at BIGINT NOT NULL,
+    modified_at BIGINT,
+    deleted_at BIGINT,
+    created_by VARCHAR NOT NULL,
+    modified_by VARCHAR,
+    deleted_by VARCHAR,
+    deck_id UUID NOT NULL REFERENCES decks(deck_id),
+    customer_id UUID NOT NULL,
+    front VARCHAR NOT NULL,
+    back VARCHAR NOT NULL DEFAULT '',
+    tags JSONB NOT NULL DEFAULT '[]',
+    due_at BIGINT NOT NULL,
+    interval_days INT NOT NULL DEFAULT 0,
+    ease DOUBLE PRECISION NOT NULL DEFAULT 2.5,
+    repetitions INT NOT NULL DEFAULT 0,
+    lapses INT NOT NULL DEFAULT 0,
+    last_reviewed_at BIGINT
+);
+CREATE INDEX IF NOT EXISTS idx_cards_deck ON cards (deck_id, created_at) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_cards_customer_due ON cards (customer_id, due_at) WHERE deleted_at IS NULL;
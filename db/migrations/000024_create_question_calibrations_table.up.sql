@@ -0,0 +1,14 @@
+-- 2PL IRT parameters of questions, refitted by the calibration job
+CREATE TABLE IF NOT EXISTS question_calibrations (
+    question_id UUID PRIMARY KEY REFERENCES questions(question_id) ON DELETE CASCADE,
+    discrimination DOUBLE PRECISION NOT NULL,
+    difficulty DOUBLE PRECISION NOT NULL,
+    responses INT NOT NULL,
+    p_correct DOUBLE PRECISION NOT NULL,
+    calibrated_at BIGINT NOT NULL,
+    flagged_at BIGINT,
+    reviewed_at BIGINT,
+    reviewed_by VARCHAR,
+    review_note VARCHAR
+);
+CREATE INDEX IF NOT EXISTS idx_question_calibrations_flagged ON question_calibrations (flagged_at) WHERE flagged_at IS NOT NULL;
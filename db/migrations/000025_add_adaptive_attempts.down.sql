@@ -0,0 +1,9 @@
+ALTER TABLE attempt_answers DROP COLUMN IF EXISTS position;
+
+DELETE FROM attempts WHERE quiz_id IS NULL;
+ALTER TABLE attempts DROP CONSTRAINT IF EXISTS attempts_quiz_mode_has_quiz;
+ALTER TABLE attempts DROP COLUMN IF EXISTS estimated_level;
+ALTER TABLE attempts DROP COLUMN IF EXISTS standard_error;
+ALTER TABLE attempts DROP COLUMN IF EXISTS ability;
+ALTER TABLE attempts DROP COLUMN IF EXISTS mode;
+ALTER TABLE attempts ALTER COLUMN quiz_id SET NOT NULL;
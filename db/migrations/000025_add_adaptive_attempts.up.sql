@@ -0,0 +1,10 @@
+-- adaptive attempts serve calibrated questions of any quiz one at a time
+ALTER TABLE attempts ALTER COLUMN quiz_id DROP NOT NULL;
+ALTER TABLE attempts ADD COLUMN IF NOT EXISTS mode VARCHAR NOT NULL DEFAULT 'quiz';
+ALTER TABLE attempts ADD COLUMN IF NOT EXISTS ability DOUBLE PRECISION;
+ALTER TABLE attempts ADD COLUMN IF NOT EXISTS standard_error DOUBLE PRECISION;
+ALTER TABLE attempts ADD COLUMN IF NOT EXISTS estimated_level VARCHAR;
+ALTER TABLE attempts ADD CONSTRAINT attempts_quiz_mode_has_quiz CHECK (mode <> 'quiz' OR quiz_id IS NOT NULL);
+
+-- position is the order questions of an adaptive attempt were served in
+ALTER TABLE attempt_answers ADD COLUMN IF NOT EXISTS position INT NOT NULL DEFAULT 0;
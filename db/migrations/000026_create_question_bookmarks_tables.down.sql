@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS question_notes;
+DROP TABLE IF EXISTS question_bookmarks;
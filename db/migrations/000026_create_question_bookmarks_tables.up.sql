@@ -0,0 +1,18 @@
+-- bookmarks and notes are private to the customer, one per question
+CREATE TABLE IF NOT EXISTS question_bookmarks (
+    customer_id UUID NOT NULL,
+    question_id UUID NOT NULL REFERENCES questions(question_id),
+    created_at BIGINT NOT NULL,
+    PRIMARY KEY (customer_id, question_id)
+);
+CREATE INDEX IF NOT EXISTS idx_question_bookmarks_customer_created ON question_bookmarks (customer_id, created_at);
+
+CREATE TABLE IF NOT EXISTS question_notes (
+    customer_id UUID NOT NULL,
+    question_id UUID NOT NULL REFERENCES questions(question_id),
+    note VARCHAR NOT NULL,
+    created_at BIGINT NOT NULL,
+    modified_at BIGINT,
+    PRIMARY KEY (customer_id, question_id)
+);
+CREATE INDEX IF NOT EXISTS idx_question_notes_customer_created ON question_notes (customer_id, created_at);
@@ -0,0 +1,37 @@
+-- errors learners report in questions, triaged by editors
+CREATE TABLE IF NOT EXISTS question_reports (
+    report_id UUID PRIMARY KEY,
+    created_at BIGINT NOT NULL,
+    modified_at BIGINT,
+    created_by VARCHAR NOT NULL,
+    modified_by VARCHAR,
+    customer_id UUID NOT NULL,
+    question_id UUID NOT NULL REFERENCES questions(question_id),
+    reason VARCHAR NOT NULL,
+    comment VARCHAR,
+    status VARCHAR NOT NULL DEFAULT 'open',
+    assigned_to UUID,
+    assigned_at BIGINT,
+    resolved_at BIGINT,
+    resolved_by VARCHAR,
+    editor_note VARCHAR
+);
+CREATE INDEX IF NOT EXISTS idx_question_reports_status ON question_reports (status, created_at);
+CREATE INDEX IF NOT EXISTS idx_question_reports_assigned_to ON question_reports (assigned_to) WHERE assigned_to IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_question_reports_question ON question_reports (question_id);
+-- a customer has at most one pending report of a question
+CREATE UNIQUE INDEX IF NOT EXISTS idx_question_reports_pending ON question_reports (customer_id, question_id)
+    WHERE status IN ('open', 'accepted');
+
+-- in-app notifications, data is what the client renders a type with
+CREATE TABLE IF NOT EXISTS notifications (
+    notification_id UUID PRIMARY KEY,
+    created_at BIGINT NOT NULL,
+    customer_id UUID NOT NULL,
+    type VARCHAR NOT NULL,
+    source_id VARCHAR NOT NULL,
+    data JSONB NOT NULL DEFAULT '{}',
+    read_at BIGINT
+);
+CREATE INDEX IF NOT EXISTS idx_notifications_customer ON notifications (customer_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_notifications_unread ON notifications (customer_id) WHERE read_at IS NULL;
@@ -0,0 +1,3 @@
+ALTER TABLE attempt_answers DROP COLUMN IF EXISTS revision_id;
+DROP TABLE IF EXISTS question_revisions;
+DROP FUNCTION IF EXISTS question_revisions_immutable();
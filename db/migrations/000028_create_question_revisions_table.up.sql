@@ -0,0 +1,47 @@
+-- revisions snapshot a question and its answers after every change
+CREATE TABLE IF NOT EXISTS question_revisions (
+    revision_id UUID PRIMARY KEY,
+    created_at BIGINT NOT NULL,
+    created_by VARCHAR NOT NULL,
+    question_id UUID NOT NULL REFERENCES questions(question_id),
+    number INT NOT NULL,
+    action VARCHAR NOT NULL,
+    restored_from INT,
+    snapshot JSONB NOT NULL,
+    UNIQUE (question_id, number)
+);
+
+-- revisions are immutable
+CREATE OR REPLACE FUNCTION question_revisions_immutable() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'question revisions are immutable';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER question_revisions_immutable
+    BEFORE UPDATE OR DELETE ON question_revisions
+    FOR EACH ROW EXECUTE FUNCTION question_revisions_immutable();
+
+-- the current content of existing questions is their first revision
+INSERT INTO question_revisions (revision_id, created_at, created_by, question_id, number, action, snapshot)
+SELECT gen_random_uuid(), COALESCE(q.modified_at, q.created_at), COALESCE(q.modified_by, q.created_by), q.question_id, 1, 'create',
+    jsonb_build_object(
+        'quiz_id', q.quiz_id,
+        'question_text', q.question_text,
+        'question_type', q.question_type,
+        'grading_mode', q.grading_mode,
+        'passage_id', q.passage_id,
+        'section', q.section,
+        'answers', COALESCE((
+            SELECT jsonb_agg(jsonb_build_object(
+                'answer_id', a.answer_id,
+                'answer_text', a.answer_text,
+                'is_correct', a.is_correct) ORDER BY a.created_at, a.answer_id)
+            FROM answers a
+            WHERE a.question_id = q.question_id AND a.deleted_at IS NULL), '[]'::jsonb))
+FROM questions q
+WHERE NOT EXISTS (SELECT 1 FROM question_revisions r WHERE r.question_id = q.question_id);
+
+-- the revision of the question an answer was graded against, answers
+-- graded before revisions were kept point at none
+ALTER TABLE attempt_answers ADD COLUMN IF NOT EXISTS revision_id UUID REFERENCES question_revisions(revision_id);
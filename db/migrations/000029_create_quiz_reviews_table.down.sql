@@ -0,0 +1,11 @@
+DROP TABLE IF EXISTS quiz_reviews;
+DROP INDEX IF EXISTS idx_quizzes_publish_at;
+DROP INDEX IF EXISTS idx_quizzes_reviewer;
+DROP INDEX IF EXISTS idx_quizzes_status;
+ALTER TABLE quizzes DROP COLUMN IF EXISTS published_by;
+ALTER TABLE quizzes DROP COLUMN IF EXISTS published_at;
+ALTER TABLE quizzes DROP COLUMN IF EXISTS publish_at;
+ALTER TABLE quizzes DROP COLUMN IF EXISTS submitted_by;
+ALTER TABLE quizzes DROP COLUMN IF EXISTS submitted_at;
+ALTER TABLE quizzes DROP COLUMN IF EXISTS reviewer_id;
+ALTER TABLE quizzes ALTER COLUMN status SET DEFAULT 'published';
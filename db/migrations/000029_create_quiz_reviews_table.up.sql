@@ -0,0 +1,24 @@
+-- quizzes are drafts until reviewed, existing ones stay published
+ALTER TABLE quizzes ALTER COLUMN status SET DEFAULT 'draft';
+ALTER TABLE quizzes ADD COLUMN IF NOT EXISTS reviewer_id UUID;
+ALTER TABLE quizzes ADD COLUMN IF NOT EXISTS submitted_at BIGINT;
+ALTER TABLE quizzes ADD COLUMN IF NOT EXISTS submitted_by VARCHAR;
+ALTER TABLE quizzes ADD COLUMN IF NOT EXISTS publish_at BIGINT;
+ALTER TABLE quizzes ADD COLUMN IF NOT EXISTS published_at BIGINT;
+ALTER TABLE quizzes ADD COLUMN IF NOT EXISTS published_by VARCHAR;
+UPDATE quizzes SET published_at = created_at WHERE status = 'published' AND published_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_quizzes_status ON quizzes (status, created_at);
+CREATE INDEX IF NOT EXISTS idx_quizzes_reviewer ON quizzes (reviewer_id) WHERE status = 'in_review';
+CREATE INDEX IF NOT EXISTS idx_quizzes_publish_at ON quizzes (publish_at) WHERE status = 'scheduled';
+
+-- the decisions of reviewers on the quizzes submitted to them
+CREATE TABLE IF NOT EXISTS quiz_reviews (
+    review_id UUID PRIMARY KEY,
+    created_at BIGINT NOT NULL,
+    quiz_id UUID NOT NULL REFERENCES quizzes(quiz_id),
+    reviewer_id UUID NOT NULL,
+    decision VARCHAR NOT NULL,
+    comment VARCHAR,
+    publish_at BIGINT
+);
+CREATE INDEX IF NOT EXISTS idx_quiz_reviews_quiz ON quiz_reviews (quiz_id, created_at);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_dictionary_glosses_text_trgm;
+CREATE INDEX IF NOT EXISTS idx_dictionary_glosses_text ON dictionary_glosses (lower(text) varchar_pattern_ops);
@@ -0,0 +1,6 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- glosses are matched anywhere in the text, which a pattern_ops index
+-- cannot serve
+DROP INDEX IF EXISTS idx_dictionary_glosses_text;
+CREATE INDEX IF NOT EXISTS idx_dictionary_glosses_text_trgm ON dictionary_glosses USING GIN (lower(text) gin_trgm_ops);
@@ -0,0 +1,3 @@
+ALTER TABLE customers ADD COLUMN IF NOT EXISTS user_id UUID;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_customers_user_id ON customers (user_id) WHERE deleted_at IS NULL;
@@ -0,0 +1,19 @@
+DELETE FROM customer_friends WHERE deleted_at IS NOT NULL;
+ALTER TABLE customer_friends DROP COLUMN IF EXISTS deleted_by;
+ALTER TABLE customer_friends DROP COLUMN IF EXISTS deleted_at;
+
+DELETE FROM study_plan_tasks WHERE deleted_at IS NOT NULL;
+ALTER TABLE study_plan_tasks DROP COLUMN IF EXISTS deleted_by;
+ALTER TABLE study_plan_tasks DROP COLUMN IF EXISTS deleted_at;
+
+DELETE FROM study_plans WHERE deleted_at IS NOT NULL;
+ALTER TABLE study_plans DROP COLUMN IF EXISTS deleted_by;
+ALTER TABLE study_plans DROP COLUMN IF EXISTS deleted_at;
+
+DELETE FROM question_notes WHERE deleted_at IS NOT NULL;
+ALTER TABLE question_notes DROP COLUMN IF EXISTS deleted_by;
+ALTER TABLE question_notes DROP COLUMN IF EXISTS deleted_at;
+
+DELETE FROM question_bookmarks WHERE deleted_at IS NOT NULL;
+ALTER TABLE question_bookmarks DROP COLUMN IF EXISTS deleted_by;
+ALTER TABLE question_bookmarks DROP COLUMN IF EXISTS deleted_at;
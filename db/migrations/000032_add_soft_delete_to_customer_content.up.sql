@@ -0,0 +1,17 @@
+-- bookmarks, notes, study plans and friendships are deleted by their customer
+-- like every other content, a bookmark, note or friendship added again and a
+-- plan made again take the place of the deleted row
+ALTER TABLE question_bookmarks ADD COLUMN IF NOT EXISTS deleted_at BIGINT;
+ALTER TABLE question_bookmarks ADD COLUMN IF NOT EXISTS deleted_by VARCHAR;
+
+ALTER TABLE question_notes ADD COLUMN IF NOT EXISTS deleted_at BIGINT;
+ALTER TABLE question_notes ADD COLUMN IF NOT EXISTS deleted_by VARCHAR;
+
+ALTER TABLE study_plans ADD COLUMN IF NOT EXISTS deleted_at BIGINT;
+ALTER TABLE study_plans ADD COLUMN IF NOT EXISTS deleted_by VARCHAR;
+
+ALTER TABLE study_plan_tasks ADD COLUMN IF NOT EXISTS deleted_at BIGINT;
+ALTER TABLE study_plan_tasks ADD COLUMN IF NOT EXISTS deleted_by VARCHAR;
+
+ALTER TABLE customer_friends ADD COLUMN IF NOT EXISTS deleted_at BIGINT;
+ALTER TABLE customer_friends ADD COLUMN IF NOT EXISTS deleted_by VARCHAR;
@@ -3,6 +3,8 @@ package abstraction
 import (
 	"time"
 
+	"wakuwaku_nihongo/internals/pkg/softdelete"
+
 	"gorm.io/gorm"
 )
 
@@ -12,8 +14,8 @@ type Entity struct {
 	ModifiedAt *int64 `json:"modified_at"`
 	ModifiedBy string `json:"modified_by"`
 
-	DeletedAt *int64 `json:"deleted_at"`
-	DeletedBy string `json:"deleted_by"`
+	DeletedAt softdelete.DeletedAt `json:"deleted_at"`
+	DeletedBy string               `json:"deleted_by"`
 }
 
 type Filter struct {
@@ -23,6 +25,12 @@ type Filter struct {
 	ModifiedBy *int   `query:"modified_by"`
 }
 
+// DeletedFilter lets admins list the soft deleted rows along with the
+// others.
+type DeletedFilter struct {
+	IncludeDeleted bool `query:"include_deleted"`
+}
+
 func (m *Entity) BeforeUpdate(tx *gorm.DB) (err error) {
 	ma := time.Now().UnixMilli()
 	m.ModifiedAt = &ma
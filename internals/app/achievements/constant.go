@@ -0,0 +1,17 @@
+package achievements
+
+const (
+	// events the rules are evaluated on
+	EVENT_QUIZ_COMPLETED = "quiz_completed"
+
+	// metrics a rule condition compares, the ones the event does not carry
+	// are loaded from the database
+	METRIC_QUIZZES_COMPLETED         = "quizzes_completed"
+	METRIC_SCORE_PERCENT             = "score_percent"
+	METRIC_STREAK_DAYS               = "streak_days"
+	METRIC_N5_KANJI_MASTERED_PERCENT = "n5_kanji_mastered_percent"
+
+	// a kanji is mastered once questions using it were answered correctly
+	// this many times
+	KANJI_MASTERY_CORRECT = 5
+)
@@ -0,0 +1,57 @@
+package achievements
+
+import (
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+type IAchievementService interface {
+	List(ctx echo.Context) (out []*AchievementResponse, err error)
+	ListBadges(ctx echo.Context) (out []*BadgeResponse, err error)
+}
+
+type handler struct {
+	service IAchievementService
+}
+
+func NewHandler(f *factory.Factory) *handler {
+	return &handler{
+		service: NewService(f),
+	}
+}
+
+// @Summary List Achievements
+// @Description List every achievement with the XP it is worth and whether the logged in customer earned it
+// @Tags achievement
+// @Produce json
+// @Success 200 {object} response.Success{data=[]AchievementResponse}
+// @Failure 401 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/achievements [get]
+func (h *handler) List(c echo.Context) error {
+	res, err := h.service.List(c)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary List Badges
+// @Description List the badges the logged in customer earned, oldest first
+// @Tags achievement
+// @Produce json
+// @Success 200 {object} response.Success{data=[]BadgeResponse}
+// @Failure 401 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/me/badges [get]
+func (h *handler) ListBadges(c echo.Context) error {
+	res, err := h.service.ListBadges(c)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
@@ -0,0 +1,40 @@
+package achievements
+
+type AchievementResponse struct {
+	Code        string `json:"code"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	XP          int    `json:"xp"`
+	Earned      bool   `json:"earned"`
+	AwardedAt   *int64 `json:"awarded_at"`
+}
+
+type BadgeResponse struct {
+	Code        string `json:"code"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	XP          int    `json:"xp"`
+	AwardedAt   int64  `json:"awarded_at"`
+}
+
+func (r *AchievementResponse) MapFromRule(m *Rule, badge *Badge) {
+	r.Code = m.Code
+	r.Name = m.Name
+	r.Description = m.Description
+	r.XP = m.XP
+	if badge != nil {
+		r.Earned = true
+		r.AwardedAt = &badge.AwardedAt
+	}
+}
+
+// MapFromModel maps a badge, rule is nil for a badge whose rule was removed.
+func (r *BadgeResponse) MapFromModel(m *Badge, rule *Rule) {
+	r.Code = m.Code
+	r.AwardedAt = m.AwardedAt
+	if rule != nil {
+		r.Name = rule.Name
+		r.Description = rule.Description
+		r.XP = rule.XP
+	}
+}
@@ -0,0 +1,50 @@
+package achievements
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Badge is an achievement a customer earned, a customer earns each rule once.
+type Badge struct {
+	CustomerID string `gorm:"column:customer_id;type:uuid;primaryKey" json:"customer_id"`
+	Code       string `gorm:"column:code;type:character varying;primaryKey" json:"code"`
+	CreatedAt  int64  `gorm:"column:created_at;type:bigint;not null" json:"created_at"`
+	EventType  string `gorm:"column:event_type;type:character varying;not null" json:"event_type"`
+	SourceID   string `gorm:"column:source_id;type:character varying;not null" json:"source_id"`
+	AwardedAt  int64  `gorm:"column:awarded_at;type:bigint;not null" json:"awarded_at"`
+}
+
+func (*Badge) TableName() string {
+	return "customer_badges"
+}
+
+func (m *Badge) BeforeCreate(tx *gorm.DB) (err error) {
+	m.CreatedAt = time.Now().UnixMilli()
+	return
+}
+
+// KanjiProgress counts the correct answers to questions using a kanji,
+// MasteredAt is set when it reaches KANJI_MASTERY_CORRECT.
+type KanjiProgress struct {
+	CustomerID    string `gorm:"column:customer_id;type:uuid;primaryKey" json:"customer_id"`
+	Kanji         string `gorm:"column:kanji;type:character varying;primaryKey" json:"kanji"`
+	CorrectCount  int    `gorm:"column:correct_count;type:integer;not null" json:"correct_count"`
+	LastCorrectAt int64  `gorm:"column:last_correct_at;type:bigint;not null" json:"last_correct_at"`
+	MasteredAt    *int64 `gorm:"column:mastered_at;type:bigint" json:"mastered_at"`
+}
+
+func (*KanjiProgress) TableName() string {
+	return "customer_kanji"
+}
+
+// Event is something a customer did that rules are evaluated on. Facts are
+// the metrics the source already knows, such as the score of a quiz.
+type Event struct {
+	Type       string
+	CustomerID string
+	SourceID   string
+	At         int64
+	Facts      map[string]int
+}
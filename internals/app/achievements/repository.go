@@ -0,0 +1,83 @@
+package achievements
+
+import (
+	"wakuwaku_nihongo/internals/app/streaks"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type repo struct {
+	db *gorm.DB
+}
+
+func NewRepo(db *gorm.DB) *repo {
+	return &repo{
+		db: db,
+	}
+}
+
+// CreateBadge stores in unless the customer already earned it, created is
+// false in that case.
+func (r *repo) CreateBadge(ctx echo.Context, in *Badge) (created bool, err error) {
+	res := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(in)
+	return res.RowsAffected == 1, res.Error
+}
+
+func (r *repo) ListBadges(ctx echo.Context, customerID string) (out []*Badge, err error) {
+	out = []*Badge{}
+	err = r.db.Where("customer_id = ?", customerID).
+		Order("awarded_at, code").
+		Find(&out).Error
+	return
+}
+
+// AddKanji counts one more correct answer for each kanji and marks the ones
+// reaching KANJI_MASTERY_CORRECT as mastered at.
+func (r *repo) AddKanji(ctx echo.Context, customerID string, kanji []string, at int64) (err error) {
+	if len(kanji) == 0 {
+		return
+	}
+	in := make([]*KanjiProgress, 0, len(kanji))
+	for _, k := range kanji {
+		in = append(in, &KanjiProgress{CustomerID: customerID, Kanji: k, CorrectCount: 1, LastCorrectAt: at})
+	}
+	return r.db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "customer_id"}, {Name: "kanji"}},
+		DoUpdates: clause.Assignments(map[string]any{
+			"correct_count":   gorm.Expr("customer_kanji.correct_count + 1"),
+			"last_correct_at": gorm.Expr("excluded.last_correct_at"),
+			"mastered_at": gorm.Expr("COALESCE(customer_kanji.mastered_at, CASE WHEN customer_kanji.correct_count + 1 >= ? THEN excluded.last_correct_at END)",
+				KANJI_MASTERY_CORRECT),
+		}),
+	}).Create(&in).Error
+}
+
+// CountMasteredKanji returns how many of kanji the customer mastered.
+func (r *repo) CountMasteredKanji(ctx echo.Context, customerID string, kanji []string) (out int64, err error) {
+	if len(kanji) == 0 {
+		return
+	}
+	err = r.db.Model(&KanjiProgress{}).
+		Where("customer_id = ? AND kanji IN ? AND mastered_at IS NOT NULL", customerID, kanji).
+		Count(&out).Error
+	return
+}
+
+// CountCompletedQuizzes returns the number of attempts the customer
+// submitted.
+func (r *repo) CountCompletedQuizzes(ctx echo.Context, customerID string) (out int64, err error) {
+	err = r.db.Table("attempts").
+		Where("customer_id = ? AND status = 'submitted' AND deleted_at IS NULL", customerID).
+		Count(&out).Error
+	return
+}
+
+func (r *repo) GetCurrentStreak(ctx echo.Context, customerID string) (out int, err error) {
+	err = r.db.Model(&streaks.Streak{}).
+		Select("COALESCE(MAX(current_streak), 0)").
+		Where("customer_id = ?", customerID).
+		Scan(&out).Error
+	return
+}
@@ -0,0 +1,14 @@
+package achievements
+
+import (
+	"github.com/labstack/echo/v4"
+	"wakuwaku_nihongo/internals/middleware"
+)
+
+func (h *handler) Route(g *echo.Group) {
+	g.GET("", h.List, middleware.Authentication)
+}
+
+func (h *handler) BadgeRoute(g *echo.Group) {
+	g.GET("", h.ListBadges, middleware.Authentication)
+}
@@ -0,0 +1,142 @@
+package achievements
+
+// Condition holds when the metric is at least Min.
+type Condition struct {
+	Metric string
+	Min    int
+}
+
+// Rule awards a badge, and its XP, the first time all of its conditions hold
+// after one of the events it listens to.
+type Rule struct {
+	Code        string
+	Name        string
+	Description string
+	XP          int
+	On          []string
+	When        []Condition
+}
+
+// RULES are the achievements, a new one only needs an entry here as long as
+// its metrics are known to the engine. Codes are stored with the badges and
+// must not change.
+var RULES = []*Rule{
+	{
+		Code:        "first_quiz",
+		Name:        "First Steps",
+		Description: "Complete your first quiz",
+		XP:          20,
+		On:          []string{EVENT_QUIZ_COMPLETED},
+		When:        []Condition{{Metric: METRIC_QUIZZES_COMPLETED, Min: 1}},
+	},
+	{
+		Code:        "quizzes_50",
+		Name:        "Dedicated Learner",
+		Description: "Complete 50 quizzes",
+		XP:          200,
+		On:          []string{EVENT_QUIZ_COMPLETED},
+		When:        []Condition{{Metric: METRIC_QUIZZES_COMPLETED, Min: 50}},
+	},
+	{
+		Code:        "perfect_score",
+		Name:        "Perfect Score",
+		Description: "Answer every question of a quiz correctly",
+		XP:          50,
+		On:          []string{EVENT_QUIZ_COMPLETED},
+		When:        []Condition{{Metric: METRIC_SCORE_PERCENT, Min: 100}},
+	},
+	{
+		Code:        "streak_7",
+		Name:        "One Week Streak",
+		Description: "Meet your daily goal 7 days in a row",
+		XP:          70,
+		On:          []string{EVENT_QUIZ_COMPLETED},
+		When:        []Condition{{Metric: METRIC_STREAK_DAYS, Min: 7}},
+	},
+	{
+		Code:        "streak_30",
+		Name:        "One Month Streak",
+		Description: "Meet your daily goal 30 days in a row",
+		XP:          300,
+		On:          []string{EVENT_QUIZ_COMPLETED},
+		When:        []Condition{{Metric: METRIC_STREAK_DAYS, Min: 30}},
+	},
+	{
+		Code:        "n5_kanji_master",
+		Name:        "N5 Kanji Master",
+		Description: "Master every N5 kanji",
+		XP:          500,
+		On:          []string{EVENT_QUIZ_COMPLETED},
+		When:        []Condition{{Metric: METRIC_N5_KANJI_MASTERED_PERCENT, Min: 100}},
+	},
+}
+
+// Listens reports whether r is evaluated on event.
+func (r *Rule) Listens(event string) bool {
+	for _, on := range r.On {
+		if on == event {
+			return true
+		}
+	}
+	return false
+}
+
+// Holds reports whether every condition holds, a missing metric fails its
+// condition.
+func (r *Rule) Holds(facts map[string]int) bool {
+	for _, c := range r.When {
+		value, ok := facts[c.Metric]
+		if !ok || value < c.Min {
+			return false
+		}
+	}
+	return true
+}
+
+// Metrics returns the distinct metrics the rules listening to event need.
+func Metrics(rules []*Rule, event string) []string {
+	seen := map[string]bool{}
+	out := []string{}
+	for _, r := range rules {
+		if !r.Listens(event) {
+			continue
+		}
+		for _, c := range r.When {
+			if !seen[c.Metric] {
+				seen[c.Metric] = true
+				out = append(out, c.Metric)
+			}
+		}
+	}
+	return out
+}
+
+// Match returns the rules listening to event whose conditions hold.
+func Match(rules []*Rule, event string, facts map[string]int) []*Rule {
+	out := []*Rule{}
+	for _, r := range rules {
+		if r.Listens(event) && r.Holds(facts) {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// Find returns the rule with code, nil when there is none.
+func Find(rules []*Rule, code string) *Rule {
+	for _, r := range rules {
+		if r.Code == code {
+			return r
+		}
+	}
+	return nil
+}
+
+// Percent returns part of total as a whole percentage rounded down, so 100
+// means all of it.
+func Percent(part, total int) int {
+	if total <= 0 {
+		return 0
+	}
+	return part * 100 / total
+}
@@ -0,0 +1,146 @@
+package achievements
+
+import (
+	"fmt"
+
+	"wakuwaku_nihongo/internals/app/xp"
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/middleware"
+	"wakuwaku_nihongo/internals/pkg/kana"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+type IAchievementRepo interface {
+	ListBadges(ctx echo.Context, customerID string) (out []*Badge, err error)
+}
+
+type service struct {
+	repo IAchievementRepo
+}
+
+func NewService(f *factory.Factory) *service {
+	return &service{
+		repo: NewRepo(f.Db),
+	}
+}
+
+// List returns every achievement and whether the customer earned it.
+func (s *service) List(ctx echo.Context) (out []*AchievementResponse, err error) {
+	badges, err := s.repo.ListBadges(ctx, middleware.GetUserID(ctx))
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	byCode := map[string]*Badge{}
+	for _, b := range badges {
+		byCode[b.Code] = b
+	}
+
+	out = make([]*AchievementResponse, 0, len(RULES))
+	for _, rule := range RULES {
+		res := &AchievementResponse{}
+		res.MapFromRule(rule, byCode[rule.Code])
+		out = append(out, res)
+	}
+	return
+}
+
+func (s *service) ListBadges(ctx echo.Context) (out []*BadgeResponse, err error) {
+	badges, err := s.repo.ListBadges(ctx, middleware.GetUserID(ctx))
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	out = make([]*BadgeResponse, 0, len(badges))
+	for _, b := range badges {
+		res := &BadgeResponse{}
+		res.MapFromModel(b, Find(RULES, b.Code))
+		out = append(out, res)
+	}
+	return
+}
+
+// RecordKanji adds the kanji of texts, the tested words and correct answers
+// of the questions got right, to the customer's mastery within db.
+func RecordKanji(ctx echo.Context, db *gorm.DB, customerID string, texts []string, at int64) (err error) {
+	seen := map[string]bool{}
+	kanji := []string{}
+	for _, text := range texts {
+		for _, k := range kana.Kanji(text) {
+			if !seen[k] {
+				seen[k] = true
+				kanji = append(kanji, k)
+			}
+		}
+	}
+	return NewRepo(db).AddKanji(ctx, customerID, kanji, at)
+}
+
+// Evaluate awards the badges whose rules hold for in and returns their XP,
+// a badge is awarded once per customer however often it is evaluated.
+func Evaluate(ctx echo.Context, db *gorm.DB, in *Event) (awarded []*xp.Event, err error) {
+	r := NewRepo(db)
+	facts := map[string]int{}
+	for metric, value := range in.Facts {
+		facts[metric] = value
+	}
+	for _, metric := range Metrics(RULES, in.Type) {
+		if _, ok := facts[metric]; ok {
+			continue
+		}
+		if facts[metric], err = r.metric(ctx, in.CustomerID, metric); err != nil {
+			return
+		}
+	}
+
+	for _, rule := range Match(RULES, in.Type, facts) {
+		created, err := r.CreateBadge(ctx, &Badge{
+			CustomerID: in.CustomerID,
+			Code:       rule.Code,
+			EventType:  in.Type,
+			SourceID:   in.SourceID,
+			AwardedAt:  in.At,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if !created {
+			continue
+		}
+
+		earned := &xp.Event{
+			CustomerID: in.CustomerID,
+			SourceType: xp.SOURCE_BADGE,
+			SourceID:   rule.Code,
+			Amount:     rule.XP,
+			EarnedAt:   in.At,
+		}
+		ok, err := xp.Award(ctx, db, earned)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			awarded = append(awarded, earned)
+		}
+	}
+	return
+}
+
+// metric loads a metric the event does not carry.
+func (r *repo) metric(ctx echo.Context, customerID string, metric string) (out int, err error) {
+	switch metric {
+	case METRIC_QUIZZES_COMPLETED:
+		count, err := r.CountCompletedQuizzes(ctx, customerID)
+		return int(count), err
+	case METRIC_STREAK_DAYS:
+		return r.GetCurrentStreak(ctx, customerID)
+	case METRIC_N5_KANJI_MASTERED_PERCENT:
+		n5 := kana.JLPTKanji("N5")
+		count, err := r.CountMasteredKanji(ctx, customerID, n5)
+		return Percent(int(count), len(n5)), err
+	}
+	return 0, fmt.Errorf("achievements: unknown metric %q", metric)
+}
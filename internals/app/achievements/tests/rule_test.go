@@ -0,0 +1,91 @@
+package tests
+
+import (
+	"testing"
+	"wakuwaku_nihongo/internals/app/achievements"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func codes(rules []*achievements.Rule) []string {
+	out := []string{}
+	for _, r := range rules {
+		out = append(out, r.Code)
+	}
+	return out
+}
+
+func TestRulesAreUnique(t *testing.T) {
+	seen := map[string]bool{}
+	for _, r := range achievements.RULES {
+		assert.False(t, seen[r.Code], r.Code)
+		seen[r.Code] = true
+		assert.NotEmpty(t, r.On, r.Code)
+		assert.NotEmpty(t, r.When, r.Code)
+		assert.Positive(t, r.XP, r.Code)
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	metrics := achievements.Metrics(achievements.RULES, achievements.EVENT_QUIZ_COMPLETED)
+	assert.ElementsMatch(t, []string{
+		achievements.METRIC_QUIZZES_COMPLETED,
+		achievements.METRIC_SCORE_PERCENT,
+		achievements.METRIC_STREAK_DAYS,
+		achievements.METRIC_N5_KANJI_MASTERED_PERCENT,
+	}, metrics)
+	assert.Empty(t, achievements.Metrics(achievements.RULES, "unknown"))
+}
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		name  string
+		facts map[string]int
+		want  []string
+	}{
+		{"first quiz", map[string]int{
+			achievements.METRIC_QUIZZES_COMPLETED: 1,
+			achievements.METRIC_SCORE_PERCENT:     60,
+		}, []string{"first_quiz"}},
+		{"perfect score and a month long streak", map[string]int{
+			achievements.METRIC_QUIZZES_COMPLETED: 40,
+			achievements.METRIC_SCORE_PERCENT:     100,
+			achievements.METRIC_STREAK_DAYS:       30,
+		}, []string{"first_quiz", "perfect_score", "streak_7", "streak_30"}},
+		{"all n5 kanji", map[string]int{
+			achievements.METRIC_QUIZZES_COMPLETED:         50,
+			achievements.METRIC_N5_KANJI_MASTERED_PERCENT: 100,
+		}, []string{"first_quiz", "quizzes_50", "n5_kanji_master"}},
+		{"almost", map[string]int{
+			achievements.METRIC_SCORE_PERCENT:             99,
+			achievements.METRIC_STREAK_DAYS:               29,
+			achievements.METRIC_N5_KANJI_MASTERED_PERCENT: 98,
+		}, []string{"streak_7"}},
+		{"missing metrics fail", map[string]int{}, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := achievements.Match(achievements.RULES, achievements.EVENT_QUIZ_COMPLETED, tt.facts)
+			assert.Equal(t, tt.want, codes(got))
+		})
+	}
+}
+
+func TestMatchAllConditions(t *testing.T) {
+	rules := []*achievements.Rule{{
+		Code: "fast_and_right",
+		On:   []string{"custom"},
+		When: []achievements.Condition{{Metric: "a", Min: 1}, {Metric: "b", Min: 2}},
+	}}
+
+	assert.Empty(t, achievements.Match(rules, "custom", map[string]int{"a": 1, "b": 1}))
+	assert.Len(t, achievements.Match(rules, "custom", map[string]int{"a": 1, "b": 2}), 1)
+	assert.Empty(t, achievements.Match(rules, "other", map[string]int{"a": 1, "b": 2}), "only the events a rule listens to")
+}
+
+func TestPercent(t *testing.T) {
+	assert.Equal(t, 100, achievements.Percent(92, 92))
+	assert.Equal(t, 98, achievements.Percent(91, 92), "rounded down so 100 needs all of it")
+	assert.Equal(t, 0, achievements.Percent(3, 0))
+}
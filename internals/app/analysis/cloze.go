@@ -0,0 +1,109 @@
+package analysis
+
+import (
+	"wakuwaku_nihongo/internals/app/quizzes"
+	"wakuwaku_nihongo/internals/pkg/morph"
+	"wakuwaku_nihongo/internals/pkg/ruby"
+)
+
+var (
+	clozeParticles = map[string]bool{"格助詞": true, "係助詞": true, "副助詞": true, "並立助詞": true, "連体化": true}
+	clozeNouns     = map[string]bool{"一般": true, "サ変接続": true, "形容動詞語幹": true, "固有名詞": true, "副詞可能": true}
+)
+
+// GenerateClozes returns a cloze for every token of the analyzed sentence that
+// can be blanked out as target. Particles become multiple choice questions,
+// verbs and vocabulary typed answer questions. A verb is blanked together
+// with its inflection and the dictionary form is given as a hint.
+func GenerateClozes(tokens []morph.Token, target string) []*ClozeResponse {
+	out := []*ClozeResponse{}
+	for i := range tokens {
+		end, ok := clozeSpan(tokens, i, target)
+		if !ok {
+			continue
+		}
+
+		answer := markup(tokens[i:end])
+		text := markup(tokens[:i]) + CLOZE_BLANK + markup(tokens[end:])
+		cloze := &ClozeResponse{
+			TokenIndex: i,
+			Target:     target,
+		}
+
+		switch target {
+		case CLOZE_TARGET_PARTICLE:
+			cloze.QuestionType = quizzes.QUESTION_TYPE_MULTIPLE_CHOICE
+			cloze.Answers = particleChoices(answer, i)
+		case CLOZE_TARGET_VERB:
+			text += "（" + tokens[i].BaseForm + "）"
+			fallthrough
+		default:
+			cloze.QuestionType = quizzes.QUESTION_TYPE_TYPED
+			cloze.Answers = []*ClozeAnswerResponse{{AnswerText: answer, IsCorrect: true}}
+		}
+		cloze.QuestionText = text
+		out = append(out, cloze)
+	}
+	return out
+}
+
+// clozeSpan reports whether token i can be blanked out as target and returns
+// the end of the blank.
+func clozeSpan(tokens []morph.Token, i int, target string) (end int, ok bool) {
+	t := tokens[i]
+	switch target {
+	case CLOZE_TARGET_PARTICLE:
+		ok = t.Is(morph.PosParticle) && len(t.POS) > 1 && clozeParticles[t.POS[1]]
+		return i + 1, ok
+
+	case CLOZE_TARGET_VERB:
+		if !t.Is(morph.PosVerb, "自立") || t.BaseForm == "" {
+			return
+		}
+		end = i + 1
+		for end < len(tokens) && isInflection(tokens[end]) {
+			end++
+		}
+		return end, true
+
+	case CLOZE_TARGET_VOCABULARY:
+		ok = (t.Is(morph.PosNoun) && len(t.POS) > 1 && clozeNouns[t.POS[1]]) ||
+			t.Is(morph.PosAdjective, "自立") ||
+			t.Is(morph.PosAdverb)
+		return i + 1, ok
+	}
+	return
+}
+
+// isInflection matches the auxiliaries and helper verbs that follow a verb,
+// as in 食べ|て|い|ます.
+func isInflection(t morph.Token) bool {
+	return t.Is(morph.PosAuxiliary) ||
+		t.Is(morph.PosVerb, "非自立") ||
+		t.Is(morph.PosVerb, "接尾") ||
+		(t.Is(morph.PosParticle, "接続助詞") && (t.Surface == "て" || t.Surface == "で"))
+}
+
+// particleChoices puts the correct particle among the first distractors. Its
+// position depends on seed so the answer is not always the first choice.
+func particleChoices(correct string, seed int) []*ClozeAnswerResponse {
+	out := []*ClozeAnswerResponse{}
+	for _, p := range PARTICLE_CHOICES {
+		if len(out) == PARTICLE_DISTRACTOR_COUNT {
+			break
+		}
+		if p != correct {
+			out = append(out, &ClozeAnswerResponse{AnswerText: p})
+		}
+	}
+
+	pos := seed % (len(out) + 1)
+	out = append(out, nil)
+	copy(out[pos+1:], out[pos:])
+	out[pos] = &ClozeAnswerResponse{AnswerText: correct, IsCorrect: true}
+	return out
+}
+
+func markup(tokens []morph.Token) string {
+	return ruby.Markup(morph.Furigana(tokens))
+}
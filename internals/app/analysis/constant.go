@@ -0,0 +1,17 @@
+package analysis
+
+const (
+	CLOZE_TARGET_PARTICLE   = "particle"
+	CLOZE_TARGET_VERB       = "verb"
+	CLOZE_TARGET_VOCABULARY = "vocabulary"
+
+	CLOZE_BLANK = "（　　）"
+)
+
+// PARTICLE_CHOICES are offered as distractors for particle clozes, in order
+// of preference.
+var PARTICLE_CHOICES = []string{"は", "が", "を", "に", "で", "へ", "と", "も", "から", "まで", "より", "の"}
+
+// PARTICLE_DISTRACTOR_COUNT is the number of wrong choices of a particle
+// cloze.
+const PARTICLE_DISTRACTOR_COUNT = 3
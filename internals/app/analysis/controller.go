@@ -0,0 +1,85 @@
+package analysis
+
+import (
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+type IAnalysisService interface {
+	Analyze(ctx echo.Context, in *AnalyzeRequest) (out *AnalyzeResponse, err error)
+	Cloze(ctx echo.Context, in *ClozeRequest) (out []*ClozeResponse, err error)
+}
+
+type handler struct {
+	service IAnalysisService
+}
+
+func NewHandler(f *factory.Factory) *handler {
+	return &handler{
+		service: NewService(f),
+	}
+}
+
+// @Summary Analyze Sentence
+// @Description Split a Japanese sentence into morphemes and add furigana to its kanji (editor only)
+// @Tags analysis
+// @Accept json
+// @Produce json
+// @Param payload body AnalyzeRequest true "Payload"
+// @Success 200 {object} response.Success{data=AnalyzeResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/analysis [post]
+func (h *handler) Analyze(c echo.Context) error {
+	req := &AnalyzeRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.Analyze(c, req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Generate Cloze Questions
+// @Description Generate cloze questions from a sentence by blanking out a particle, verb or vocabulary item (editor only). Without token_index every candidate is returned
+// @Tags analysis
+// @Accept json
+// @Produce json
+// @Param payload body ClozeRequest true "Payload"
+// @Success 200 {object} response.Success{data=[]ClozeResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/analysis/cloze [post]
+func (h *handler) Cloze(c echo.Context) error {
+	req := &ClozeRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.Cloze(c, req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
@@ -0,0 +1,66 @@
+package analysis
+
+import (
+	"wakuwaku_nihongo/internals/pkg/morph"
+	"wakuwaku_nihongo/internals/pkg/ruby"
+)
+
+type AnalyzeRequest struct {
+	Text string `json:"text" validate:"required,max=1000"`
+}
+
+type ClozeRequest struct {
+	Text       string `json:"text" validate:"required,max=1000"`
+	Target     string `json:"target" validate:"required,oneof=particle verb vocabulary"`
+	TokenIndex *int   `json:"token_index" validate:"omitempty,min=0"`
+}
+
+type AnalyzeResponse struct {
+	Furigana string           `json:"furigana"`
+	Segments []ruby.Segment   `json:"segments"`
+	Tokens   []*TokenResponse `json:"tokens"`
+}
+
+type TokenResponse struct {
+	Index        int      `json:"index"`
+	Surface      string   `json:"surface"`
+	BaseForm     string   `json:"base_form"`
+	Reading      string   `json:"reading"`
+	PartOfSpeech string   `json:"part_of_speech"`
+	POS          []string `json:"pos"`
+	Start        int      `json:"start"`
+	End          int      `json:"end"`
+}
+
+// ClozeResponse has the fields of a question create request so an editor can
+// review it and save it as is.
+type ClozeResponse struct {
+	TokenIndex   int                    `json:"token_index"`
+	Target       string                 `json:"target"`
+	QuestionText string                 `json:"question_text"`
+	QuestionType string                 `json:"question_type"`
+	Answers      []*ClozeAnswerResponse `json:"answers"`
+}
+
+type ClozeAnswerResponse struct {
+	AnswerText string `json:"answer_text"`
+	IsCorrect  bool   `json:"is_correct"`
+}
+
+func (r *AnalyzeResponse) MapFromTokens(tokens []morph.Token) {
+	r.Segments = morph.Furigana(tokens)
+	r.Furigana = ruby.Markup(r.Segments)
+	r.Tokens = []*TokenResponse{}
+	for i, t := range tokens {
+		r.Tokens = append(r.Tokens, &TokenResponse{
+			Index:        i,
+			Surface:      t.Surface,
+			BaseForm:     t.BaseForm,
+			Reading:      t.Reading,
+			PartOfSpeech: t.PartOfSpeech(),
+			POS:          t.POS,
+			Start:        t.Start,
+			End:          t.End,
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package analysis
+
+import (
+	"github.com/labstack/echo/v4"
+	"wakuwaku_nihongo/internals/middleware"
+	"wakuwaku_nihongo/internals/utils/token"
+)
+
+func (h *handler) Route(g *echo.Group) {
+	editor := middleware.Authorization(token.ROLE_EDITOR)
+
+	g.POST("", h.Analyze, middleware.Authentication, editor)
+	g.POST("/cloze", h.Cloze, middleware.Authentication, editor)
+}
@@ -0,0 +1,43 @@
+package analysis
+
+import (
+	"fmt"
+
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/pkg/morph"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+type service struct {
+	analyzer morph.Analyzer
+}
+
+func NewService(f *factory.Factory) *service {
+	return &service{
+		analyzer: morph.Default(),
+	}
+}
+
+func (s *service) Analyze(ctx echo.Context, in *AnalyzeRequest) (out *AnalyzeResponse, err error) {
+	out = &AnalyzeResponse{}
+	out.MapFromTokens(s.analyzer.Analyze(in.Text))
+	return
+}
+
+func (s *service) Cloze(ctx echo.Context, in *ClozeRequest) (out []*ClozeResponse, err error) {
+	out = GenerateClozes(s.analyzer.Analyze(in.Text), in.Target)
+	if in.TokenIndex == nil {
+		return
+	}
+
+	for _, cloze := range out {
+		if cloze.TokenIndex == *in.TokenIndex {
+			out = []*ClozeResponse{cloze}
+			return
+		}
+	}
+	err = response.ErrorWrap(response.ErrValidation, fmt.Errorf("token %d cannot be blanked out as %s", *in.TokenIndex, in.Target))
+	return
+}
@@ -0,0 +1,52 @@
+package tests
+
+import (
+	"testing"
+	"wakuwaku_nihongo/internals/app/analysis"
+	"wakuwaku_nihongo/internals/app/quizzes"
+	"wakuwaku_nihongo/internals/pkg/morph"
+	"wakuwaku_nihongo/internals/pkg/ruby"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const sentence = "私は毎朝学校へ行きます。"
+
+func TestGenerateClozesParticle(t *testing.T) {
+	clozes := analysis.GenerateClozes(morph.Default().Analyze(sentence), analysis.CLOZE_TARGET_PARTICLE)
+
+	assert.Len(t, clozes, 2)
+	assert.Equal(t, 1, clozes[0].TokenIndex)
+	assert.Equal(t, "私[わたし]（　　）毎朝[まいあさ]学校[がっこう]へ行[い]きます。", clozes[0].QuestionText)
+	assert.Equal(t, quizzes.QUESTION_TYPE_MULTIPLE_CHOICE, clozes[0].QuestionType)
+	assert.Len(t, clozes[0].Answers, analysis.PARTICLE_DISTRACTOR_COUNT+1)
+
+	correct := []string{}
+	for _, a := range clozes[1].Answers {
+		if a.IsCorrect {
+			correct = append(correct, a.AnswerText)
+		}
+	}
+	assert.Equal(t, []string{"へ"}, correct)
+}
+
+func TestGenerateClozesVerb(t *testing.T) {
+	clozes := analysis.GenerateClozes(morph.Default().Analyze(sentence), analysis.CLOZE_TARGET_VERB)
+
+	assert.Len(t, clozes, 1)
+	assert.Equal(t, "私[わたし]は毎朝[まいあさ]学校[がっこう]へ（　　）。（行く）", clozes[0].QuestionText)
+	assert.Equal(t, quizzes.QUESTION_TYPE_TYPED, clozes[0].QuestionType)
+	assert.Equal(t, "行[い]きます", clozes[0].Answers[0].AnswerText)
+	assert.True(t, clozes[0].Answers[0].IsCorrect)
+}
+
+func TestGenerateClozesVocabulary(t *testing.T) {
+	clozes := analysis.GenerateClozes(morph.Default().Analyze(sentence), analysis.CLOZE_TARGET_VOCABULARY)
+
+	answers := []string{}
+	for _, c := range clozes {
+		assert.True(t, ruby.IsValid(c.QuestionText))
+		answers = append(answers, c.Answers[0].AnswerText)
+	}
+	assert.Equal(t, []string{"毎朝[まいあさ]", "学校[がっこう]"}, answers)
+}
@@ -0,0 +1,82 @@
+package attempts
+
+import (
+	"math"
+
+	"wakuwaku_nihongo/internals/pkg/irt"
+)
+
+// PoolItem is a calibrated question an adaptive attempt can serve.
+type PoolItem struct {
+	QuestionID     string
+	Discrimination float64
+	Difficulty     float64
+}
+
+func (p *PoolItem) Item() irt.Item {
+	return irt.Item{Discrimination: p.Discrimination, Difficulty: p.Difficulty}
+}
+
+// NextQuestion returns the question of the pool that is most informative at
+// ability theta and was not served yet, nil when none is left. Ties go to
+// the first in the pool.
+func NextQuestion(pool []*PoolItem, served map[string]bool, theta float64) *PoolItem {
+	var best *PoolItem
+	bestInfo := math.Inf(-1)
+	for _, p := range pool {
+		if served[p.QuestionID] {
+			continue
+		}
+		if info := p.Item().Information(theta); info > bestInfo {
+			best, bestInfo = p, info
+		}
+	}
+	return best
+}
+
+// EstimateAbility estimates the ability of an adaptive attempt from its
+// graded answers. Answers to questions that left the pool since they were
+// served are ignored.
+func EstimateAbility(answers []*AttemptAnswer, pool []*PoolItem) (theta float64, se float64) {
+	byID := map[string]*PoolItem{}
+	for _, p := range pool {
+		byID[p.QuestionID] = p
+	}
+
+	graded := []irt.Answer{}
+	for _, a := range answers {
+		p, ok := byID[a.QuestionID]
+		if !ok || a.IsCorrect == nil {
+			continue
+		}
+		graded = append(graded, irt.Answer{Item: p.Item(), Correct: *a.IsCorrect})
+	}
+	return irt.Estimate(graded)
+}
+
+// Finished reports whether an adaptive attempt with answered questions
+// stops at standard error se.
+func Finished(se float64, answered int) bool {
+	return se <= ADAPTIVE_TARGET_SE || answered >= ADAPTIVE_MAX_QUESTIONS
+}
+
+// Level places ability theta at the hardest JLPT level whose typical
+// question, with the median difficulty of the level, it answers correctly
+// at least half the time. thresholds maps levels to that difficulty, a
+// threshold below the one of an easier level is raised to it so levels stay
+// in order. Learners below N5, or before any calibration, are placed at N5.
+func Level(theta float64, thresholds map[string]float64) string {
+	level := LEVELS[0]
+	bar := math.Inf(-1)
+	for _, l := range LEVELS {
+		threshold, ok := thresholds[l]
+		if !ok {
+			continue
+		}
+		bar = math.Max(bar, threshold)
+		if theta >= bar {
+			level = l
+		}
+	}
+	return level
+}
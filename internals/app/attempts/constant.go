@@ -0,0 +1,25 @@
+package attempts
+
+const (
+	STATUS_IN_PROGRESS = "in_progress"
+	STATUS_SUBMITTED   = "submitted"
+
+	// a quiz attempt answers the questions of a quiz in any order, an
+	// adaptive attempt is served one calibrated question at a time and a
+	// practice attempt answers a random pick of the bookmarked questions.
+	MODE_QUIZ     = "quiz"
+	MODE_ADAPTIVE = "adaptive"
+	MODE_PRACTICE = "practice"
+
+	// an adaptive attempt ends once the standard error of the ability
+	// estimate reaches ADAPTIVE_TARGET_SE, after ADAPTIVE_MAX_QUESTIONS or
+	// when no question is left.
+	ADAPTIVE_TARGET_SE     = 0.3
+	ADAPTIVE_MAX_QUESTIONS = 30
+	// ADAPTIVE_MIN_DISCRIMINATION keeps questions telling little about
+	// ability, and flagged ones, out of adaptive attempts.
+	ADAPTIVE_MIN_DISCRIMINATION = 0.3
+)
+
+// LEVELS are the JLPT levels from the easiest.
+var LEVELS = []string{"N5", "N4", "N3", "N2", "N1"}
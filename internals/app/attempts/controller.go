@@ -0,0 +1,272 @@
+package attempts
+
+import (
+	"wakuwaku_nihongo/internals/abstraction"
+	"wakuwaku_nihongo/internals/app/quizzes"
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/pkg/ruby"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+type IAttemptService interface {
+	Start(ctx echo.Context, in *StartAttemptRequest, format ruby.Format) (out *AttemptResponse, err error)
+	StartAdaptive(ctx echo.Context, in *StartAdaptiveRequest, format ruby.Format) (out *AttemptResponse, err error)
+	StartPractice(ctx echo.Context, in *StartPracticeRequest, format ruby.Format) (out *AttemptResponse, err error)
+	Get(ctx echo.Context, attemptID string, format ruby.Format) (out *AttemptResponse, err error)
+	List(ctx echo.Context, p *abstraction.Pagination) (out []*AttemptResponse, info *abstraction.PaginationInfo, err error)
+	SaveAnswer(ctx echo.Context, attemptID string, in *SaveAnswerRequest, format ruby.Format) (out *AttemptResponse, err error)
+	Submit(ctx echo.Context, attemptID string, format ruby.Format) (out *AttemptResponse, err error)
+}
+
+type handler struct {
+	service IAttemptService
+}
+
+func NewHandler(f *factory.Factory) *handler {
+	return &handler{
+		service: NewService(f),
+	}
+}
+
+// @Summary Start Attempt
+// @Description Start a new attempt of a quiz
+// @Tags attempt
+// @Accept json
+// @Produce json
+// @Param payload body StartAttemptRequest true "Payload"
+// @Param ruby query string false "Furigana rendering" Enums(markup, html, segments, strip)
+// @Success 200 {object} response.Success{data=AttemptResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/attempts [post]
+func (h *handler) Start(c echo.Context) error {
+	req := &StartAttemptRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	filter, err := bindRubyFilter(c)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+
+	res, err := h.service.Start(c, req, filter.Format())
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Start Adaptive Attempt
+// @Description Start a placement test serving one calibrated question at a time, each the most informative at the current ability estimate. jlpt_level aims the first question at a level. Answer the current_question_id with PUT /attempts/{id}/answers until the attempt is submitted with the estimated JLPT level
+// @Tags attempt
+// @Accept json
+// @Produce json
+// @Param payload body StartAdaptiveRequest true "Payload"
+// @Param ruby query string false "Furigana rendering" Enums(markup, html, segments, strip)
+// @Success 200 {object} response.Success{data=AttemptResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/attempts/adaptive [post]
+func (h *handler) StartAdaptive(c echo.Context) error {
+	req := &StartAdaptiveRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	filter, err := bindRubyFilter(c)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+
+	res, err := h.service.StartAdaptive(c, req, filter.Format())
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Start Bookmark Practice
+// @Description Start a practice attempt of up to limit (default 20) random questions the logged in customer bookmarked, of jlpt_level and section when given. It is answered and submitted like a quiz attempt
+// @Tags attempt
+// @Accept json
+// @Produce json
+// @Param payload body StartPracticeRequest true "Payload"
+// @Param ruby query string false "Furigana rendering" Enums(markup, html, segments, strip)
+// @Success 200 {object} response.Success{data=AttemptResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/attempts/bookmarks [post]
+func (h *handler) StartPractice(c echo.Context) error {
+	req := &StartPracticeRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	filter, err := bindRubyFilter(c)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+
+	res, err := h.service.StartPractice(c, req, filter.Format())
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Get List of Attempt
+// @Description Get attempts of the logged in customer
+// @Tags attempt
+// @Produce json
+// @Param page query int false "Page"
+// @Param page_size query int false "Page size"
+// @Success 200 {object} response.SuccessResponseWithInfo{data=[]AttemptResponse}
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/attempts [get]
+func (h *handler) List(c echo.Context) error {
+	req := &abstraction.Pagination{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+	req.SetDefault()
+
+	res, info, err := h.service.List(c, req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponseInfo(res, info).Send(c)
+}
+
+// @Summary Get Attempt
+// @Description Get attempt with its questions and given answers
+// @Tags attempt
+// @Produce json
+// @Param id path string true "Attempt ID"
+// @Param ruby query string false "Furigana rendering" Enums(markup, html, segments, strip)
+// @Success 200 {object} response.Success{data=AttemptResponse}
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/attempts/{id} [get]
+func (h *handler) Get(c echo.Context) error {
+	filter, err := bindRubyFilter(c)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+
+	res, err := h.service.Get(c, c.Param("id"), filter.Format())
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Save Answer
+// @Description Save or replace the answer to a question of a quiz attempt in progress. The answer to the current question of an adaptive attempt is final and the attempt is returned with the next question, or submitted once the ability is estimated precisely enough
+// @Tags attempt
+// @Accept json
+// @Produce json
+// @Param id path string true "Attempt ID"
+// @Param payload body SaveAnswerRequest true "Payload"
+// @Param ruby query string false "Furigana rendering" Enums(markup, html, segments, strip)
+// @Success 200 {object} response.Success{data=AttemptResponse} "the attempt for adaptive attempts, saved for quiz attempts"
+// @Failure 400 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/attempts/{id}/answers [put]
+func (h *handler) SaveAnswer(c echo.Context) error {
+	req := &SaveAnswerRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	filter, err := bindRubyFilter(c)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+
+	res, err := h.service.SaveAnswer(c, c.Param("id"), req, filter.Format())
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	if res == nil {
+		return response.SuccessResponse("saved").Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Submit Attempt
+// @Description Grade and submit an attempt
+// @Tags attempt
+// @Produce json
+// @Param id path string true "Attempt ID"
+// @Param ruby query string false "Furigana rendering" Enums(markup, html, segments, strip)
+// @Success 200 {object} response.Success{data=AttemptResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/attempts/{id}/submit [post]
+func (h *handler) Submit(c echo.Context) error {
+	filter, err := bindRubyFilter(c)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+
+	res, err := h.service.Submit(c, c.Param("id"), filter.Format())
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// bindRubyFilter reads the ruby query parameter, which echo does not bind for
+// POST requests.
+func bindRubyFilter(c echo.Context) (out *quizzes.RubyFilter, err error) {
+	out = &quizzes.RubyFilter{}
+	err = (&echo.DefaultBinder{}).BindQueryParams(c, out)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrUnprocessableEntity, err)
+		return
+	}
+
+	err = c.Validate(out)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrValidation, err)
+	}
+	return
+}
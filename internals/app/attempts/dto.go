@@ -0,0 +1,269 @@
+package attempts
+
+import (
+	"strings"
+
+	"wakuwaku_nihongo/internals/app/explanations"
+	"wakuwaku_nihongo/internals/app/media"
+	"wakuwaku_nihongo/internals/app/passages"
+	"wakuwaku_nihongo/internals/model"
+	"wakuwaku_nihongo/internals/pkg/normalizer"
+	"wakuwaku_nihongo/internals/pkg/ruby"
+)
+
+type StartAttemptRequest struct {
+	QuizID string `json:"quiz_id" validate:"required,uuid"`
+}
+
+// StartAdaptiveRequest starts an adaptive attempt, JlptLevel aims the first
+// question at a level instead of the average learner.
+type StartAdaptiveRequest struct {
+	JlptLevel string `json:"jlpt_level" validate:"omitempty,oneof=N5 N4 N3 N2 N1"`
+}
+
+// StartPracticeRequest starts a practice attempt of the bookmarked
+// questions, of a JLPT level and section when given.
+type StartPracticeRequest struct {
+	JlptLevel string `json:"jlpt_level" validate:"omitempty,oneof=N5 N4 N3 N2 N1"`
+	Section   string `json:"section" validate:"omitempty,oneof=vocabulary grammar reading listening"`
+	Limit     int    `json:"limit" validate:"omitempty,min=1,max=100"`
+}
+
+type SaveAnswerRequest struct {
+	QuestionID string  `json:"question_id" validate:"required,uuid"`
+	AnswerID   *string `json:"answer_id" validate:"omitempty,uuid"`
+	AnswerText *string `json:"answer_text"`
+}
+
+type AttemptResponse struct {
+	AttemptID   string  `json:"attempt_id"`
+	QuizID      *string `json:"quiz_id"`
+	Mode        string  `json:"mode"`
+	Status      string  `json:"status"`
+	StartedAt   int64   `json:"started_at"`
+	SubmittedAt *int64  `json:"submitted_at"`
+	Score       *int    `json:"score"`
+	Total       int     `json:"total"`
+	// CurrentQuestionID is the question an adaptive attempt waits for an
+	// answer to.
+	CurrentQuestionID *string `json:"current_question_id,omitempty"`
+	// Ability, StandardError and EstimatedLevel are the result of a
+	// submitted adaptive attempt.
+	Ability        *float64                   `json:"ability,omitempty"`
+	StandardError  *float64                   `json:"standard_error,omitempty"`
+	EstimatedLevel *string                    `json:"estimated_level,omitempty"`
+	Questions      []*AttemptQuestionResponse `json:"questions,omitempty"`
+	Passages       []*AttemptPassageResponse  `json:"passages,omitempty"`
+}
+
+// AttemptPassageResponse is a reading passage with the questions about it,
+// so the passage text is sent once.
+type AttemptPassageResponse struct {
+	PassageID       string                     `json:"passage_id"`
+	Title           *string                    `json:"title"`
+	PassageText     string                     `json:"passage_text"`
+	PassageSegments []ruby.Segment             `json:"passage_segments,omitempty"`
+	Source          *string                    `json:"source"`
+	ImageURL        *string                    `json:"image_url"`
+	Audio           *media.MediaResponse       `json:"audio,omitempty"`
+	Questions       []*AttemptQuestionResponse `json:"questions"`
+}
+
+type AttemptQuestionResponse struct {
+	QuestionID       string            `json:"question_id"`
+	QuestionText     string            `json:"question_text"`
+	QuestionSegments []ruby.Segment    `json:"question_segments,omitempty"`
+	QuestionType     *string           `json:"question_type"`
+	PassageID        *string           `json:"passage_id,omitempty"`
+	Choices          []*ChoiceResponse `json:"choices,omitempty"`
+	AnswerID         *string           `json:"answer_id"`
+	AnswerText       *string           `json:"answer_text"`
+	IsCorrect        *bool             `json:"is_correct,omitempty"`
+	CorrectAnswers   []string          `json:"correct_answers,omitempty"`
+	// RevisionID is the revision of the question the answer was graded
+	// against, the question is shown as it was then.
+	RevisionID   *string                   `json:"revision_id,omitempty"`
+	Audio        *media.MediaResponse      `json:"audio,omitempty"`
+	Explanations explanations.Translations `json:"explanations,omitempty"`
+}
+
+type ChoiceResponse struct {
+	AnswerID       string                    `json:"answer_id"`
+	AnswerText     string                    `json:"answer_text"`
+	AnswerSegments []ruby.Segment            `json:"answer_segments,omitempty"`
+	Explanations   explanations.Translations `json:"explanations,omitempty"`
+}
+
+// MapFromModel fills the response rendering furigana with format. Correctness
+// and the answer key are only revealed once the attempt has been submitted.
+// Until then readings that would give the answer away are hidden.
+func (r *AttemptResponse) MapFromModel(attempt *Attempt, questions []*model.Question, format ruby.Format) {
+	r.AttemptID = attempt.AttemptID
+	r.QuizID = attempt.QuizID
+	r.Mode = attempt.Mode
+	r.Status = attempt.Status
+	r.StartedAt = attempt.CreatedAt
+	r.SubmittedAt = attempt.SubmittedAt
+	r.Total = attempt.Total
+
+	submitted := attempt.Status == STATUS_SUBMITTED
+	if submitted {
+		score := attempt.Score
+		r.Score = &score
+		r.Ability = attempt.Ability
+		r.StandardError = attempt.StandardError
+		r.EstimatedLevel = attempt.EstimatedLevel
+	} else if current := attempt.Current(); attempt.Mode == MODE_ADAPTIVE && current != nil {
+		r.CurrentQuestionID = &current.QuestionID
+	}
+
+	given := map[string]*AttemptAnswer{}
+	for _, a := range attempt.Answers {
+		given[a.QuestionID] = a
+	}
+
+	for _, q := range questions {
+		var hideInQuestion, hideInChoice func(ruby.Segment) bool
+		if !submitted {
+			hideInQuestion, hideInChoice = leakingReadings(q)
+		}
+
+		text := ruby.Render(q.QuestionText, format, hideInQuestion)
+		question := &AttemptQuestionResponse{
+			QuestionID:       q.QuestionID,
+			QuestionText:     text.Text,
+			QuestionSegments: text.Segments,
+			QuestionType:     q.QuestionType,
+			PassageID:        q.PassageID,
+		}
+		if !isTyped(q) {
+			for _, a := range q.Answers {
+				text := ruby.Render(a.AnswerText, format, hideInChoice)
+				question.Choices = append(question.Choices, &ChoiceResponse{
+					AnswerID:       a.AnswerID,
+					AnswerText:     text.Text,
+					AnswerSegments: text.Segments,
+				})
+			}
+		}
+		if a, ok := given[q.QuestionID]; ok && a.Answered() {
+			question.AnswerID = a.AnswerID
+			question.AnswerText = a.AnswerText
+		}
+		if submitted {
+			isCorrect := false
+			if a, ok := given[q.QuestionID]; ok && a.IsCorrect != nil {
+				isCorrect = *a.IsCorrect
+			}
+			question.IsCorrect = &isCorrect
+			if a, ok := given[q.QuestionID]; ok {
+				question.RevisionID = a.RevisionID
+			}
+			for _, a := range q.Answers {
+				if a.IsCorrect {
+					question.CorrectAnswers = append(question.CorrectAnswers, ruby.Render(a.AnswerText, format, nil).Text)
+				}
+			}
+		}
+		r.Questions = append(r.Questions, question)
+	}
+}
+
+// GroupByPassage moves the questions about a passage under it. Passages are
+// ordered by their first question, questions keep their order and those
+// whose passage is not in passages stay in Questions.
+func (r *AttemptResponse) GroupByPassage(passageList []*passages.Passage, format ruby.Format) {
+	byID := map[string]*passages.Passage{}
+	for _, p := range passageList {
+		byID[p.PassageID] = p
+	}
+
+	groups := map[string]*AttemptPassageResponse{}
+	standalone := []*AttemptQuestionResponse{}
+	for _, q := range r.Questions {
+		if q.PassageID == nil || byID[*q.PassageID] == nil {
+			standalone = append(standalone, q)
+			continue
+		}
+		group, ok := groups[*q.PassageID]
+		if !ok {
+			p := byID[*q.PassageID]
+			text := ruby.Render(p.PassageText, format, nil)
+			group = &AttemptPassageResponse{
+				PassageID:       p.PassageID,
+				Title:           p.Title,
+				PassageText:     text.Text,
+				PassageSegments: text.Segments,
+				Source:          p.Source,
+				ImageURL:        p.ImageURL,
+			}
+			groups[p.PassageID] = group
+			r.Passages = append(r.Passages, group)
+		}
+		group.Questions = append(group.Questions, q)
+	}
+	r.Questions = standalone
+}
+
+// AllQuestions returns the standalone questions followed by those grouped
+// under passages.
+func (r *AttemptResponse) AllQuestions() []*AttemptQuestionResponse {
+	out := append([]*AttemptQuestionResponse{}, r.Questions...)
+	for _, p := range r.Passages {
+		out = append(out, p.Questions...)
+	}
+	return out
+}
+
+// SetAudio attaches the listening audio to the questions and passages it
+// belongs to.
+func (r *AttemptResponse) SetAudio(questionAudio, passageAudio map[string]*media.MediaResponse) {
+	for _, q := range r.AllQuestions() {
+		q.Audio = questionAudio[q.QuestionID]
+	}
+	for _, p := range r.Passages {
+		p.Audio = passageAudio[p.PassageID]
+	}
+}
+
+// SetExplanations reveals the question and answer explanations, keyed by
+// question or answer ID, once the attempt has been submitted.
+func (r *AttemptResponse) SetExplanations(translations map[string]explanations.Translations, format ruby.Format) {
+	if r.Status != STATUS_SUBMITTED {
+		return
+	}
+	for _, q := range r.AllQuestions() {
+		q.Explanations = translations[q.QuestionID].Render(format)
+		for _, c := range q.Choices {
+			c.Explanations = translations[c.AnswerID].Render(format)
+		}
+	}
+}
+
+// leakingReadings returns the predicates hiding readings that reveal the
+// answer: a reading in the question that matches one of the answers, as in a
+// kanji reading question, and a reading in a choice that already appears in
+// the question, as in a writing question.
+func leakingReadings(q *model.Question) (inQuestion, inChoice func(ruby.Segment) bool) {
+	opt := normalizer.ModeStandard.Options()
+
+	answers := map[string]bool{}
+	for _, a := range q.Answers {
+		segs, err := ruby.Parse(a.AnswerText)
+		if err != nil {
+			answers[normalizer.Normalize(a.AnswerText, opt)] = true
+			continue
+		}
+		answers[normalizer.Normalize(ruby.Text(segs), opt)] = true
+		answers[normalizer.Normalize(ruby.Reading(segs), opt)] = true
+	}
+	questionText := normalizer.Normalize(ruby.Strip(q.QuestionText), opt)
+
+	inQuestion = func(seg ruby.Segment) bool {
+		return answers[normalizer.Normalize(seg.Reading, opt)]
+	}
+	inChoice = func(seg ruby.Segment) bool {
+		return strings.Contains(questionText, normalizer.Normalize(seg.Reading, opt))
+	}
+	return
+}
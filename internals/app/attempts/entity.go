@@ -0,0 +1,113 @@
+package attempts
+
+import (
+	"time"
+
+	"wakuwaku_nihongo/internals/app/achievements"
+	"wakuwaku_nihongo/internals/app/stats"
+	"wakuwaku_nihongo/internals/app/xp"
+	"wakuwaku_nihongo/internals/pkg/softdelete"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type Attempt struct {
+	AttemptID   string               `gorm:"column:attempt_id;type:uuid;primaryKey" json:"attempt_id"`
+	CreatedAt   int64                `gorm:"column:created_at;type:bigint;not null" json:"created_at"`
+	ModifiedAt  *int64               `gorm:"column:modified_at;type:bigint" json:"modified_at"`
+	DeletedAt   softdelete.DeletedAt `gorm:"column:deleted_at;type:bigint" json:"deleted_at"`
+	CreatedBy   string               `gorm:"column:created_by;type:character varying;not null" json:"created_by"`
+	ModifiedBy  *string              `gorm:"column:modified_by;type:character varying" json:"modified_by"`
+	DeletedBy   *string              `gorm:"column:deleted_by;type:character varying" json:"deleted_by"`
+	CustomerID  string               `gorm:"column:customer_id;type:uuid;not null" json:"customer_id"`
+	QuizID      *string              `gorm:"column:quiz_id;type:uuid" json:"quiz_id"`
+	Mode        string               `gorm:"column:mode;type:character varying;not null" json:"mode"`
+	Status      string               `gorm:"column:status;type:character varying;not null" json:"status"`
+	SubmittedAt *int64               `gorm:"column:submitted_at;type:bigint" json:"submitted_at"`
+	Score       int                  `gorm:"column:score;type:integer;not null" json:"score"`
+	Total       int                  `gorm:"column:total;type:integer;not null" json:"total"`
+	// Ability and StandardError are the IRT estimate of an adaptive attempt,
+	// EstimatedLevel the JLPT level it places the learner at.
+	Ability        *float64         `gorm:"column:ability;type:double precision" json:"ability"`
+	StandardError  *float64         `gorm:"column:standard_error;type:double precision" json:"standard_error"`
+	EstimatedLevel *string          `gorm:"column:estimated_level;type:character varying" json:"estimated_level"`
+	Answers        []*AttemptAnswer `gorm:"foreignKey:attempt_id;references:attempt_id" json:"answers"`
+}
+
+func (*Attempt) TableName() string {
+	return "attempts"
+}
+
+func (m *Attempt) BeforeCreate(tx *gorm.DB) (err error) {
+	m.CreatedAt = time.Now().UnixMilli()
+	if m.AttemptID == "" {
+		m.AttemptID = uuid.NewString()
+	}
+	if m.Mode == "" {
+		m.Mode = MODE_QUIZ
+	}
+	return
+}
+
+// Current returns the question an adaptive attempt is waiting for an
+// answer to, nil once every served question is answered.
+func (m *Attempt) Current() *AttemptAnswer {
+	for _, a := range m.Answers {
+		if !a.Answered() {
+			return a
+		}
+	}
+	return nil
+}
+
+type AttemptAnswer struct {
+	AttemptAnswerID string               `gorm:"column:attempt_answer_id;type:uuid;primaryKey" json:"attempt_answer_id"`
+	CreatedAt       int64                `gorm:"column:created_at;type:bigint;not null" json:"created_at"`
+	ModifiedAt      *int64               `gorm:"column:modified_at;type:bigint" json:"modified_at"`
+	DeletedAt       softdelete.DeletedAt `gorm:"column:deleted_at;type:bigint" json:"deleted_at"`
+	CreatedBy       string               `gorm:"column:created_by;type:character varying;not null" json:"created_by"`
+	ModifiedBy      *string              `gorm:"column:modified_by;type:character varying" json:"modified_by"`
+	DeletedBy       *string              `gorm:"column:deleted_by;type:character varying" json:"deleted_by"`
+	AttemptID       string               `gorm:"column:attempt_id;type:uuid;not null" json:"attempt_id"`
+	QuestionID      string               `gorm:"column:question_id;type:uuid;not null" json:"question_id"`
+	AnswerID        *string              `gorm:"column:answer_id;type:uuid" json:"answer_id"`
+	AnswerText      *string              `gorm:"column:answer_text;type:character varying" json:"answer_text"`
+	IsCorrect       *bool                `gorm:"column:is_correct;type:boolean" json:"is_correct"`
+	// Position is the order a question of an adaptive or practice attempt
+	// was served in, 0 in quiz attempts.
+	Position int `gorm:"column:position;type:integer;not null" json:"position"`
+	// RevisionID is the revision of the question the answer was graded
+	// against.
+	RevisionID *string `gorm:"column:revision_id;type:uuid" json:"revision_id"`
+}
+
+func (*AttemptAnswer) TableName() string {
+	return "attempt_answers"
+}
+
+func (m *AttemptAnswer) BeforeCreate(tx *gorm.DB) (err error) {
+	m.CreatedAt = time.Now().UnixMilli()
+	if m.AttemptAnswerID == "" {
+		m.AttemptAnswerID = uuid.NewString()
+	}
+	return
+}
+
+// Answered reports whether the learner answered, adaptive and practice
+// attempts store a served question before it is answered.
+func (m *AttemptAnswer) Answered() bool {
+	return m.AnswerID != nil || m.AnswerText != nil
+}
+
+// Progress is what a submitted attempt adds to the learner's stats, streak,
+// XP, kanji mastery and achievements.
+type Progress struct {
+	Graded []stats.Answer
+	Day    time.Time
+	Earned *xp.Event
+	// CorrectTexts are the plain texts of the correctly answered questions,
+	// their kanji count toward mastery.
+	CorrectTexts []string
+	Achieved     *achievements.Event
+}
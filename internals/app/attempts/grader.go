@@ -0,0 +1,97 @@
+package attempts
+
+import (
+	"strings"
+
+	"wakuwaku_nihongo/internals/app/quizzes"
+	"wakuwaku_nihongo/internals/model"
+	"wakuwaku_nihongo/internals/pkg/normalizer"
+	"wakuwaku_nihongo/internals/pkg/ruby"
+)
+
+// Grade reports whether the given answer is correct for the question. Typed
+// answers are compared against every correct answer using the question's
+// grading mode, other questions are graded by the chosen answer id. Outside
+// exact mode the kana reading of an answer with furigana is accepted too.
+func Grade(question *model.Question, given *AttemptAnswer) bool {
+	if given == nil {
+		return false
+	}
+
+	if isTyped(question) {
+		if given.AnswerText == nil {
+			return false
+		}
+		mode := normalizer.Mode(question.GradingMode)
+		if !mode.IsValid() {
+			mode = normalizer.DefaultMode
+		}
+		for _, answer := range question.Answers {
+			if !answer.IsCorrect {
+				continue
+			}
+			for _, expected := range expectedTexts(answer.AnswerText, mode) {
+				if normalizer.Match(*given.AnswerText, expected, mode.Options()) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	if given.AnswerID == nil {
+		return false
+	}
+	for _, answer := range question.Answers {
+		if answer.AnswerID == *given.AnswerID {
+			return answer.IsCorrect
+		}
+	}
+	return false
+}
+
+func expectedTexts(markup string, mode normalizer.Mode) []string {
+	segs, err := ruby.Parse(markup)
+	if err != nil {
+		return []string{markup}
+	}
+	out := []string{ruby.Text(segs)}
+	if mode != normalizer.ModeExact {
+		if reading := ruby.Reading(segs); reading != out[0] {
+			out = append(out, reading)
+		}
+	}
+	return out
+}
+
+func isTyped(question *model.Question) bool {
+	return question.QuestionType != nil && *question.QuestionType == quizzes.QUESTION_TYPE_TYPED
+}
+
+// TestedTexts returns the texts whose kanji a correct answer to the question
+// shows mastery of: its correct answers and the terms quoted in 「」 in the
+// question text. The rest of the question text is instructions, such as
+// 読み方 in every generated reading question.
+func TestedTexts(question *model.Question) []string {
+	out := []string{}
+	for _, answer := range question.Answers {
+		if answer.IsCorrect {
+			out = append(out, ruby.Strip(answer.AnswerText))
+		}
+	}
+	text := ruby.Strip(question.QuestionText)
+	for {
+		start := strings.Index(text, "「")
+		if start < 0 {
+			break
+		}
+		text = text[start+len("「"):]
+		end := strings.Index(text, "」")
+		if end < 0 {
+			break
+		}
+		out = append(out, text[:end])
+		text = text[end+len("」"):]
+	}
+	return out
+}
@@ -0,0 +1,303 @@
+package attempts
+
+import (
+	"errors"
+	"time"
+
+	"wakuwaku_nihongo/internals/abstraction"
+	"wakuwaku_nihongo/internals/app/achievements"
+	"wakuwaku_nihongo/internals/app/passages"
+	"wakuwaku_nihongo/internals/app/quizzes"
+	"wakuwaku_nihongo/internals/app/stats"
+	"wakuwaku_nihongo/internals/app/streaks"
+	"wakuwaku_nihongo/internals/app/xp"
+	"wakuwaku_nihongo/internals/model"
+	"wakuwaku_nihongo/internals/query"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type repo struct {
+	db *gorm.DB
+	*query.Query
+}
+
+func NewRepo(db *gorm.DB) *repo {
+	return &repo{
+		db:    db,
+		Query: query.Use(db),
+	}
+}
+
+// GetQuiz returns the quiz when it is published, drafts cannot be attempted.
+func (r *repo) GetQuiz(ctx echo.Context, quizID string) (out *model.Quiz, err error) {
+	q := r.Quiz
+	return q.Where(q.QuizID.Eq(quizID), q.Status.Eq(quizzes.QUIZ_STATUS_PUBLISHED)).First()
+}
+
+func (r *repo) GetQuestions(ctx echo.Context, quizID string) (out []*model.Question, err error) {
+	q := r.Question
+	return q.Where(q.QuizID.Eq(quizID)).
+		Preload(q.Answers).
+		Order(q.CreatedAt).
+		Find()
+}
+
+// GetQuestionsByIDs returns the questions that are not deleted in the
+// order of ids.
+func (r *repo) GetQuestionsByIDs(ctx echo.Context, ids []string) (out []*model.Question, err error) {
+	q := r.Question
+	found, err := q.Where(q.QuestionID.In(ids...)).
+		Preload(q.Answers).
+		Find()
+	if err != nil {
+		return
+	}
+
+	byID := map[string]*model.Question{}
+	for _, question := range found {
+		byID[question.QuestionID] = question
+	}
+	out = []*model.Question{}
+	for _, id := range ids {
+		if question, ok := byID[id]; ok {
+			out = append(out, question)
+		}
+	}
+	return
+}
+
+// GetPool returns the calibrated questions of published quizzes adaptive
+// attempts serve, leaving out flagged and weakly discriminating ones.
+func (r *repo) GetPool(ctx echo.Context) (out []*PoolItem, err error) {
+	out = []*PoolItem{}
+	err = r.db.Raw(`
+		SELECT c.question_id, c.discrimination, c.difficulty
+		FROM question_calibrations c
+		JOIN questions q ON q.question_id = c.question_id AND q.deleted_at IS NULL
+		JOIN quizzes z ON z.quiz_id = q.quiz_id AND z.deleted_at IS NULL AND z.status = ?
+		WHERE c.flagged_at IS NULL AND c.discrimination >= ?
+		ORDER BY c.question_id`, quizzes.QUIZ_STATUS_PUBLISHED, ADAPTIVE_MIN_DISCRIMINATION).
+		Scan(&out).Error
+	return
+}
+
+// GetLevelThresholds returns the median difficulty of the questions of
+// every JLPT level in the pool of adaptive attempts.
+func (r *repo) GetLevelThresholds(ctx echo.Context) (out map[string]float64, err error) {
+	rows := []struct {
+		JlptLevel  string
+		Difficulty float64
+	}{}
+	err = r.db.Raw(`
+		SELECT z.jlpt_level, percentile_cont(0.5) WITHIN GROUP (ORDER BY c.difficulty) AS difficulty
+		FROM question_calibrations c
+		JOIN questions q ON q.question_id = c.question_id AND q.deleted_at IS NULL
+		JOIN quizzes z ON z.quiz_id = q.quiz_id AND z.deleted_at IS NULL AND z.status = ?
+		WHERE c.flagged_at IS NULL AND c.discrimination >= ? AND z.jlpt_level IS NOT NULL
+		GROUP BY z.jlpt_level`, quizzes.QUIZ_STATUS_PUBLISHED, ADAPTIVE_MIN_DISCRIMINATION).
+		Scan(&rows).Error
+	if err != nil {
+		return
+	}
+
+	out = map[string]float64{}
+	for _, row := range rows {
+		out[row.JlptLevel] = row.Difficulty
+	}
+	return
+}
+
+func (r *repo) GetPassages(ctx echo.Context, passageIDs []string) (out []*passages.Passage, err error) {
+	out = []*passages.Passage{}
+	if len(passageIDs) == 0 {
+		return
+	}
+	err = r.db.Where("passage_id IN ?", passageIDs).Find(&out).Error
+	return
+}
+
+func (r *repo) Create(ctx echo.Context, in *Attempt) (err error) {
+	return r.db.Omit(clause.Associations).Create(in).Error
+}
+
+// CreateServed creates an attempt serving the questions of served, the first
+// question of an adaptive attempt or all those of a practice attempt.
+func (r *repo) CreateServed(ctx echo.Context, in *Attempt, served []*AttemptAnswer) (err error) {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Create(in).Error; err != nil {
+			return err
+		}
+		for _, a := range served {
+			a.AttemptID = in.AttemptID
+		}
+		return tx.Create(served).Error
+	})
+}
+
+// Advance stores the graded answer to the current question of an adaptive
+// attempt with the new ability estimate and serves next, unless it is nil.
+// It reports false when the question was answered meanwhile.
+func (r *repo) Advance(ctx echo.Context, in *Attempt, answer *AttemptAnswer, next *AttemptAnswer) (advanced bool, err error) {
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&AttemptAnswer{}).
+			Where("attempt_answer_id = ? AND answer_id IS NULL AND answer_text IS NULL", answer.AttemptAnswerID).
+			Updates(map[string]any{
+				"answer_id":   answer.AnswerID,
+				"answer_text": answer.AnswerText,
+				"is_correct":  answer.IsCorrect,
+				"revision_id": answer.RevisionID,
+				"modified_at": answer.ModifiedAt,
+				"modified_by": answer.ModifiedBy,
+			})
+		if res.Error != nil || res.RowsAffected == 0 {
+			return res.Error
+		}
+
+		err := tx.Model(&Attempt{}).
+			Where("attempt_id = ?", in.AttemptID).
+			Updates(map[string]any{
+				"ability":        in.Ability,
+				"standard_error": in.StandardError,
+				"total":          in.Total,
+				"modified_at":    in.ModifiedAt,
+				"modified_by":    in.ModifiedBy,
+			}).Error
+		if err != nil {
+			return err
+		}
+		if next != nil {
+			if err := tx.Create(next).Error; err != nil {
+				return err
+			}
+		}
+		advanced = true
+		return nil
+	})
+	if err != nil {
+		advanced = false
+	}
+	return
+}
+
+func (r *repo) GetByID(ctx echo.Context, attemptID string, customerID string) (out *Attempt, err error) {
+	out = &Attempt{}
+	err = r.db.Where("attempt_id = ? AND customer_id = ?", attemptID, customerID).
+		Preload("Answers", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position, created_at")
+		}).
+		First(out).Error
+	return
+}
+
+func (r *repo) List(ctx echo.Context, customerID string, p *abstraction.Pagination) (out []*Attempt, count int64, err error) {
+	db := r.db.Model(&Attempt{}).
+		Where("customer_id = ?", customerID).
+		Session(&gorm.Session{})
+
+	if err = db.Count(&count).Error; err != nil {
+		return
+	}
+
+	out = []*Attempt{}
+	err = db.Order("created_at desc").
+		Limit(p.Limit()).
+		Offset(p.Offset()).
+		Find(&out).Error
+	return
+}
+
+// SaveAnswer stores the learner's answer, replacing a previous answer to the
+// same question.
+func (r *repo) SaveAnswer(ctx echo.Context, in *AttemptAnswer) (err error) {
+	return r.db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "attempt_id"}, {Name: "question_id"}},
+		DoUpdates: clause.Assignments(map[string]any{
+			"answer_id":   in.AnswerID,
+			"answer_text": in.AnswerText,
+			"modified_at": time.Now().UnixMilli(),
+			"modified_by": in.CreatedBy,
+		}),
+	}).Create(in).Error
+}
+
+// GetQuizLevel returns the JLPT level of a quiz whatever its status.
+func (r *repo) GetQuizLevel(ctx echo.Context, quizID string) (out *string, err error) {
+	q := r.Quiz
+	quiz, err := q.Select(q.JlptLevel).Where(q.QuizID.Eq(quizID)).First()
+	if err != nil {
+		return
+	}
+	return quiz.JlptLevel, nil
+}
+
+// ErrAlreadySubmitted is returned by Submit when a concurrent submit
+// graded the attempt first.
+var ErrAlreadySubmitted = errors.New("attempt has already been submitted")
+
+// Submit stores the grades and adds progress to the learner's stats,
+// streak, XP ledger, kanji mastery and badges in the same transaction, so a
+// graded attempt is counted exactly once. awarded are the XP events added.
+func (r *repo) Submit(ctx echo.Context, in *Attempt, progress *Progress) (awarded []*xp.Event, err error) {
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		// the status guard goes first so a concurrent submit leaves the
+		// grades of the one that won alone
+		res := tx.Model(&Attempt{}).
+			Where("attempt_id = ? AND status = ?", in.AttemptID, STATUS_IN_PROGRESS).
+			Updates(map[string]any{
+				"status":          in.Status,
+				"submitted_at":    in.SubmittedAt,
+				"score":           in.Score,
+				"total":           in.Total,
+				"ability":         in.Ability,
+				"standard_error":  in.StandardError,
+				"estimated_level": in.EstimatedLevel,
+				"modified_at":     in.ModifiedAt,
+				"modified_by":     in.ModifiedBy,
+			})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return ErrAlreadySubmitted
+		}
+
+		for _, a := range in.Answers {
+			err := tx.Model(&AttemptAnswer{}).
+				Where("attempt_answer_id = ?", a.AttemptAnswerID).
+				Updates(map[string]any{
+					"is_correct":  a.IsCorrect,
+					"revision_id": a.RevisionID,
+				}).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := stats.Record(ctx, tx, in.CustomerID, progress.Day, progress.Graded); err != nil {
+			return err
+		}
+		if err := streaks.Record(ctx, tx, in.CustomerID, progress.Day); err != nil {
+			return err
+		}
+		earned, err := xp.Award(ctx, tx, progress.Earned)
+		if err != nil {
+			return err
+		}
+		if earned {
+			awarded = append(awarded, progress.Earned)
+		}
+		if err := achievements.RecordKanji(ctx, tx, in.CustomerID, progress.CorrectTexts, progress.Earned.EarnedAt); err != nil {
+			return err
+		}
+		badges, err := achievements.Evaluate(ctx, tx, progress.Achieved)
+		awarded = append(awarded, badges...)
+		return err
+	})
+	if err != nil {
+		awarded = nil
+	}
+	return
+}
@@ -0,0 +1,16 @@
+package attempts
+
+import (
+	"github.com/labstack/echo/v4"
+	"wakuwaku_nihongo/internals/middleware"
+)
+
+func (h *handler) Route(g *echo.Group) {
+	g.POST("", h.Start, middleware.Authentication)
+	g.POST("/adaptive", h.StartAdaptive, middleware.Authentication)
+	g.POST("/bookmarks", h.StartPractice, middleware.Authentication)
+	g.GET("", h.List, middleware.Authentication)
+	g.GET("/:id", h.Get, middleware.Authentication)
+	g.PUT("/:id/answers", h.SaveAnswer, middleware.Authentication)
+	g.POST("/:id/submit", h.Submit, middleware.Authentication)
+}
@@ -0,0 +1,687 @@
+package attempts
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"wakuwaku_nihongo/config"
+	"wakuwaku_nihongo/internals/abstraction"
+	"wakuwaku_nihongo/internals/app/achievements"
+	"wakuwaku_nihongo/internals/app/bookmarks"
+	"wakuwaku_nihongo/internals/app/explanations"
+	"wakuwaku_nihongo/internals/app/leaderboards"
+	"wakuwaku_nihongo/internals/app/media"
+	"wakuwaku_nihongo/internals/app/passages"
+	"wakuwaku_nihongo/internals/app/preferences"
+	"wakuwaku_nihongo/internals/app/revisions"
+	"wakuwaku_nihongo/internals/app/stats"
+	"wakuwaku_nihongo/internals/app/xp"
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/middleware"
+	"wakuwaku_nihongo/internals/model"
+	"wakuwaku_nihongo/internals/pkg/ruby"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+type IAttemptRepo interface {
+	GetQuiz(ctx echo.Context, quizID string) (out *model.Quiz, err error)
+	GetQuestions(ctx echo.Context, quizID string) (out []*model.Question, err error)
+	GetQuestionsByIDs(ctx echo.Context, ids []string) (out []*model.Question, err error)
+	GetPool(ctx echo.Context) (out []*PoolItem, err error)
+	GetLevelThresholds(ctx echo.Context) (out map[string]float64, err error)
+	GetPassages(ctx echo.Context, passageIDs []string) (out []*passages.Passage, err error)
+	Create(ctx echo.Context, in *Attempt) (err error)
+	CreateServed(ctx echo.Context, in *Attempt, served []*AttemptAnswer) (err error)
+	Advance(ctx echo.Context, in *Attempt, answer *AttemptAnswer, next *AttemptAnswer) (advanced bool, err error)
+	GetByID(ctx echo.Context, attemptID string, customerID string) (out *Attempt, err error)
+	List(ctx echo.Context, customerID string, p *abstraction.Pagination) (out []*Attempt, count int64, err error)
+	SaveAnswer(ctx echo.Context, in *AttemptAnswer) (err error)
+	GetQuizLevel(ctx echo.Context, quizID string) (out *string, err error)
+	Submit(ctx echo.Context, in *Attempt, progress *Progress) (awarded []*xp.Event, err error)
+}
+
+type IAudioProvider interface {
+	GetAudio(ctx echo.Context, ownerType string, ownerIDs []string) (out map[string]*media.MediaResponse, err error)
+}
+
+type IExplanationProvider interface {
+	GetForQuestions(ctx echo.Context, questions []*model.Question) (out map[string]explanations.Translations, err error)
+}
+
+type ILocationProvider interface {
+	Location(ctx echo.Context, customerID string) *time.Location
+}
+
+type ILeaderboard interface {
+	Add(ctx echo.Context, event *xp.Event) (err error)
+}
+
+type IRevisionProvider interface {
+	LatestIDs(ctx echo.Context, questionIDs []string) (out map[string]string, err error)
+	Snapshots(ctx echo.Context, revisionIDs []string) (out map[string]revisions.Snapshot, err error)
+}
+
+type IBookmarkProvider interface {
+	PracticeQuestionIDs(ctx echo.Context, customerID string, jlptLevel, section string, limit int) (out []string, err error)
+}
+
+type service struct {
+	repo         IAttemptRepo
+	audio        IAudioProvider
+	explanations IExplanationProvider
+	locations    ILocationProvider
+	leaderboard  ILeaderboard
+	bookmarks    IBookmarkProvider
+	revisions    IRevisionProvider
+}
+
+func NewService(f *factory.Factory) *service {
+	return &service{
+		repo:         NewRepo(f.Db),
+		audio:        media.NewService(f),
+		explanations: explanations.NewService(f),
+		locations:    preferences.NewService(f),
+		leaderboard:  leaderboards.NewService(f),
+		bookmarks:    bookmarks.NewService(f),
+		revisions:    revisions.NewService(f),
+	}
+}
+
+func (s *service) Start(ctx echo.Context, in *StartAttemptRequest, format ruby.Format) (out *AttemptResponse, err error) {
+	_, err = s.repo.GetQuiz(ctx, in.QuizID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("quiz not found"))
+		return
+	}
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	questions, err := s.repo.GetQuestions(ctx, in.QuizID)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	userID := middleware.GetUserID(ctx)
+	attempt := &Attempt{
+		CreatedBy:  userID,
+		CustomerID: userID,
+		QuizID:     &in.QuizID,
+		Mode:       MODE_QUIZ,
+		Status:     STATUS_IN_PROGRESS,
+		Total:      len(questions),
+	}
+	err = s.repo.Create(ctx, attempt)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	out, err = s.mapResponse(ctx, attempt, questions, format)
+	return
+}
+
+// StartAdaptive starts an adaptive attempt serving the calibrated question
+// most informative about an average learner, or one of in.JlptLevel.
+func (s *service) StartAdaptive(ctx echo.Context, in *StartAdaptiveRequest, format ruby.Format) (out *AttemptResponse, err error) {
+	pool, err := s.repo.GetPool(ctx)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	if len(pool) == 0 {
+		err = response.ErrorWrap(response.ErrBadRequest, fmt.Errorf("no calibrated questions are available yet"))
+		return
+	}
+
+	theta := 0.0
+	if in.JlptLevel != "" {
+		thresholds, thresholdErr := s.repo.GetLevelThresholds(ctx)
+		if thresholdErr != nil {
+			err = response.ErrorWrap(response.ErrInternalServerError, thresholdErr)
+			return
+		}
+		theta = thresholds[in.JlptLevel]
+	}
+
+	userID := middleware.GetUserID(ctx)
+	attempt := &Attempt{
+		CreatedBy:  userID,
+		CustomerID: userID,
+		Mode:       MODE_ADAPTIVE,
+		Status:     STATUS_IN_PROGRESS,
+		Total:      1,
+	}
+	first := &AttemptAnswer{
+		CreatedBy:  userID,
+		QuestionID: NextQuestion(pool, nil, theta).QuestionID,
+		Position:   1,
+	}
+	if err = s.repo.CreateServed(ctx, attempt, []*AttemptAnswer{first}); err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	attempt.Answers = []*AttemptAnswer{first}
+
+	questions, err := s.questionsOf(ctx, attempt)
+	if err != nil {
+		return
+	}
+	out, err = s.mapResponse(ctx, attempt, questions, format)
+	return
+}
+
+// StartPractice starts a practice attempt of up to in.Limit random
+// questions the customer bookmarked, of in.JlptLevel and in.Section when
+// given.
+func (s *service) StartPractice(ctx echo.Context, in *StartPracticeRequest, format ruby.Format) (out *AttemptResponse, err error) {
+	userID := middleware.GetUserID(ctx)
+	ids, err := s.bookmarks.PracticeQuestionIDs(ctx, userID, in.JlptLevel, in.Section, in.Limit)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	if len(ids) == 0 {
+		err = response.ErrorWrap(response.ErrBadRequest, fmt.Errorf("no bookmarked questions to practice"))
+		return
+	}
+
+	attempt := &Attempt{
+		CreatedBy:  userID,
+		CustomerID: userID,
+		Mode:       MODE_PRACTICE,
+		Status:     STATUS_IN_PROGRESS,
+		Total:      len(ids),
+	}
+	served := make([]*AttemptAnswer, 0, len(ids))
+	for i, id := range ids {
+		served = append(served, &AttemptAnswer{
+			CreatedBy:  userID,
+			QuestionID: id,
+			Position:   i + 1,
+		})
+	}
+	if err = s.repo.CreateServed(ctx, attempt, served); err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	attempt.Answers = served
+
+	questions, err := s.questionsOf(ctx, attempt)
+	if err != nil {
+		return
+	}
+	out, err = s.mapResponse(ctx, attempt, questions, format)
+	return
+}
+
+func (s *service) Get(ctx echo.Context, attemptID string, format ruby.Format) (out *AttemptResponse, err error) {
+	attempt, err := s.getAttempt(ctx, attemptID)
+	if err != nil {
+		return
+	}
+
+	questions, err := s.questionsOf(ctx, attempt)
+	if err != nil {
+		return
+	}
+
+	out, err = s.mapResponse(ctx, attempt, questions, format)
+	return
+}
+
+func (s *service) List(ctx echo.Context, p *abstraction.Pagination) (out []*AttemptResponse, info *abstraction.PaginationInfo, err error) {
+	attempts, count, err := s.repo.List(ctx, middleware.GetUserID(ctx), p)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	out = []*AttemptResponse{}
+	for _, val := range attempts {
+		attempt := &AttemptResponse{}
+		attempt.MapFromModel(val, nil, ruby.FormatMarkup)
+		out = append(out, attempt)
+	}
+	info = p.CreatePageInfo(count)
+	return
+}
+
+// SaveAnswer stores the answer to a question of a quiz or practice attempt,
+// which can be changed until the attempt is submitted. The answer to the current
+// question of an adaptive attempt is final, it is graded right away and out
+// is the attempt serving the next question or, once the ability is known
+// precisely enough, the submitted attempt.
+func (s *service) SaveAnswer(ctx echo.Context, attemptID string, in *SaveAnswerRequest, format ruby.Format) (out *AttemptResponse, err error) {
+	attempt, err := s.getAttempt(ctx, attemptID)
+	if err != nil {
+		return
+	}
+	if attempt.Status != STATUS_IN_PROGRESS {
+		err = response.ErrorWrap(response.ErrBadRequest, fmt.Errorf("attempt has already been submitted"))
+		return
+	}
+	if attempt.Mode == MODE_ADAPTIVE {
+		return s.answerAdaptive(ctx, attempt, in, format)
+	}
+
+	questions, err := s.questionsOf(ctx, attempt)
+	if err != nil {
+		return
+	}
+
+	var question *model.Question
+	for _, q := range questions {
+		if q.QuestionID == in.QuestionID {
+			question = q
+			break
+		}
+	}
+	if question == nil {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("question is not part of this attempt"))
+		return
+	}
+
+	answer := &AttemptAnswer{
+		CreatedBy:  middleware.GetUserID(ctx),
+		AttemptID:  attempt.AttemptID,
+		QuestionID: question.QuestionID,
+	}
+	if err = setAnswer(answer, question, in); err != nil {
+		return
+	}
+
+	err = s.repo.SaveAnswer(ctx, answer)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	return
+}
+
+// answerAdaptive grades the answer to the current question of an adaptive
+// attempt, estimates the ability again and serves the most informative
+// question left or submits the attempt.
+func (s *service) answerAdaptive(ctx echo.Context, attempt *Attempt, in *SaveAnswerRequest, format ruby.Format) (out *AttemptResponse, err error) {
+	current := attempt.Current()
+	if current == nil || current.QuestionID != in.QuestionID {
+		err = response.ErrorWrap(response.ErrBadRequest, fmt.Errorf("only the current question of an adaptive attempt can be answered"))
+		return
+	}
+	questions, err := s.repo.GetQuestionsByIDs(ctx, []string{current.QuestionID})
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	if len(questions) == 0 {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("question not found"))
+		return
+	}
+	if err = setAnswer(current, questions[0], in); err != nil {
+		return
+	}
+
+	now := time.Now().UnixMilli()
+	userID := middleware.GetUserID(ctx)
+	if err = s.setRevisions(ctx, []*AttemptAnswer{current}); err != nil {
+		return
+	}
+	isCorrect := Grade(questions[0], current)
+	current.IsCorrect = &isCorrect
+	current.ModifiedAt = &now
+	current.ModifiedBy = &userID
+
+	pool, err := s.repo.GetPool(ctx)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	theta, se := EstimateAbility(attempt.Answers, pool)
+
+	var next *AttemptAnswer
+	served := map[string]bool{}
+	for _, a := range attempt.Answers {
+		served[a.QuestionID] = true
+	}
+	if !Finished(se, len(attempt.Answers)) {
+		if p := NextQuestion(pool, served, theta); p != nil {
+			next = &AttemptAnswer{
+				CreatedBy:  userID,
+				AttemptID:  attempt.AttemptID,
+				QuestionID: p.QuestionID,
+				Position:   len(attempt.Answers) + 1,
+			}
+		}
+	}
+
+	attempt.Ability = &theta
+	attempt.StandardError = &se
+	attempt.Total = len(attempt.Answers)
+	if next != nil {
+		attempt.Total++
+	}
+	attempt.ModifiedAt = &now
+	attempt.ModifiedBy = &userID
+	advanced, err := s.repo.Advance(ctx, attempt, current, next)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	if !advanced {
+		err = response.ErrorWrap(response.ErrBadRequest, fmt.Errorf("question has already been answered"))
+		return
+	}
+
+	if next == nil {
+		return s.submit(ctx, attempt, format)
+	}
+	attempt.Answers = append(attempt.Answers, next)
+	questions, err = s.questionsOf(ctx, attempt)
+	if err != nil {
+		return
+	}
+	out, err = s.mapResponse(ctx, attempt, questions, format)
+	return
+}
+
+func (s *service) Submit(ctx echo.Context, attemptID string, format ruby.Format) (out *AttemptResponse, err error) {
+	attempt, err := s.getAttempt(ctx, attemptID)
+	if err != nil {
+		return
+	}
+	if attempt.Status != STATUS_IN_PROGRESS {
+		err = response.ErrorWrap(response.ErrBadRequest, fmt.Errorf("attempt has already been submitted"))
+		return
+	}
+	return s.submit(ctx, attempt, format)
+}
+
+// submit grades the attempt, places the learner of an adaptive attempt at
+// a JLPT level and adds the progress made.
+func (s *service) submit(ctx echo.Context, attempt *Attempt, format ruby.Format) (out *AttemptResponse, err error) {
+	questions, err := s.questionsOf(ctx, attempt)
+	if err != nil {
+		return
+	}
+
+	byID := map[string]*model.Question{}
+	for _, q := range questions {
+		byID[q.QuestionID] = q
+	}
+
+	if err = s.setRevisions(ctx, attempt.Answers); err != nil {
+		return
+	}
+
+	score := 0
+	correct := map[string]bool{}
+	for _, a := range attempt.Answers {
+		// a served question of an adaptive attempt left unanswered
+		if !a.Answered() {
+			continue
+		}
+		isCorrect := false
+		if q, ok := byID[a.QuestionID]; ok {
+			isCorrect = Grade(q, a)
+		}
+		a.IsCorrect = &isCorrect
+		if isCorrect {
+			score++
+		}
+		correct[a.QuestionID] = isCorrect
+	}
+
+	// unanswered questions count as incorrect in the stats
+	graded := make([]stats.Answer, 0, len(questions))
+	correctTexts := []string{}
+	for _, q := range questions {
+		graded = append(graded, stats.Answer{QuestionID: q.QuestionID, IsCorrect: correct[q.QuestionID]})
+		if correct[q.QuestionID] {
+			correctTexts = append(correctTexts, TestedTexts(q)...)
+		}
+	}
+
+	now := time.Now().UnixMilli()
+	userID := middleware.GetUserID(ctx)
+	attempt.Status = STATUS_SUBMITTED
+	attempt.SubmittedAt = &now
+	attempt.Score = score
+	attempt.ModifiedAt = &now
+	attempt.ModifiedBy = &userID
+
+	// practice mixes the levels of the bookmarked questions
+	var level *string
+	switch attempt.Mode {
+	case MODE_ADAPTIVE:
+		if err = s.place(ctx, attempt); err != nil {
+			return
+		}
+		attempt.Total = len(questions)
+		level = attempt.EstimatedLevel
+	case MODE_QUIZ:
+		level, err = s.repo.GetQuizLevel(ctx, *attempt.QuizID)
+		if err != nil {
+			err = response.ErrorWrap(response.ErrInternalServerError, err)
+			return
+		}
+	}
+	progress := &Progress{
+		Graded: graded,
+		Day:    stats.Day(now, s.locations.Location(ctx, userID)),
+		Earned: &xp.Event{
+			CustomerID: attempt.CustomerID,
+			SourceType: xp.SOURCE_ATTEMPT,
+			SourceID:   attempt.AttemptID,
+			JlptLevel:  level,
+			Amount:     xp.ForAttempt(score),
+			EarnedAt:   now,
+		},
+		CorrectTexts: correctTexts,
+		Achieved: &achievements.Event{
+			Type:       achievements.EVENT_QUIZ_COMPLETED,
+			CustomerID: attempt.CustomerID,
+			SourceID:   attempt.AttemptID,
+			At:         now,
+			Facts: map[string]int{
+				achievements.METRIC_SCORE_PERCENT: achievements.Percent(score, len(questions)),
+			},
+		},
+	}
+
+	awarded, err := s.repo.Submit(ctx, attempt, progress)
+	if errors.Is(err, ErrAlreadySubmitted) {
+		err = response.ErrorWrap(response.ErrBadRequest, err)
+		return
+	}
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	// the ledger is committed, a board missing the XP is fixed by a rebuild
+	for _, earned := range awarded {
+		if lbErr := s.leaderboard.Add(ctx, earned); lbErr != nil {
+			log.Error().Err(lbErr).Str("attempt_id", attempt.AttemptID).Msg("failed to update leaderboards")
+		}
+	}
+
+	out, err = s.mapResponse(ctx, attempt, questions, format)
+	return
+}
+
+// place estimates the ability of an adaptive attempt from its answers and
+// the JLPT level it places the learner at.
+func (s *service) place(ctx echo.Context, attempt *Attempt) (err error) {
+	pool, err := s.repo.GetPool(ctx)
+	if err != nil {
+		return response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	thresholds, err := s.repo.GetLevelThresholds(ctx)
+	if err != nil {
+		return response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+
+	theta, se := EstimateAbility(attempt.Answers, pool)
+	level := Level(theta, thresholds)
+	attempt.Ability = &theta
+	attempt.StandardError = &se
+	attempt.EstimatedLevel = &level
+	return
+}
+
+// questionsOf returns the questions of the quiz of a quiz attempt, or those
+// served by an adaptive or practice attempt in the order they were served.
+// A question answered in a submitted attempt is the revision it was graded
+// against.
+func (s *service) questionsOf(ctx echo.Context, attempt *Attempt) (out []*model.Question, err error) {
+	if attempt.Mode != MODE_QUIZ {
+		ids := make([]string, 0, len(attempt.Answers))
+		for _, a := range attempt.Answers {
+			ids = append(ids, a.QuestionID)
+		}
+		out, err = s.repo.GetQuestionsByIDs(ctx, ids)
+	} else {
+		out, err = s.repo.GetQuestions(ctx, *attempt.QuizID)
+	}
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	if attempt.Status != STATUS_SUBMITTED {
+		return
+	}
+
+	graded := map[string]string{}
+	revisionIDs := []string{}
+	for _, a := range attempt.Answers {
+		if a.RevisionID != nil {
+			graded[a.QuestionID] = *a.RevisionID
+			revisionIDs = append(revisionIDs, *a.RevisionID)
+		}
+	}
+	if len(revisionIDs) == 0 {
+		return
+	}
+	snapshots, err := s.revisions.Snapshots(ctx, revisionIDs)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	for i, q := range out {
+		if snapshot, ok := snapshots[graded[q.QuestionID]]; ok {
+			out[i] = snapshot.Apply(q)
+		}
+	}
+	return
+}
+
+// setRevisions points the answered answers at the latest revision of their
+// questions, the one they are graded against.
+func (s *service) setRevisions(ctx echo.Context, answers []*AttemptAnswer) (err error) {
+	ids := []string{}
+	for _, a := range answers {
+		if a.Answered() {
+			ids = append(ids, a.QuestionID)
+		}
+	}
+	latest, err := s.revisions.LatestIDs(ctx, ids)
+	if err != nil {
+		return response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	for _, a := range answers {
+		if id, ok := latest[a.QuestionID]; ok && a.Answered() {
+			a.RevisionID = &id
+		}
+	}
+	return
+}
+
+func (s *service) getAttempt(ctx echo.Context, attemptID string) (out *Attempt, err error) {
+	out, err = s.repo.GetByID(ctx, attemptID, middleware.GetUserID(ctx))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("attempt not found"))
+		return
+	}
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	return
+}
+
+// mapResponse builds the response with the questions grouped under their
+// reading passages, the listening audio attached and, after submission, the
+// explanations revealed.
+func (s *service) mapResponse(ctx echo.Context, attempt *Attempt, questions []*model.Question, format ruby.Format) (out *AttemptResponse, err error) {
+	passageIDs := []string{}
+	for _, q := range questions {
+		if q.PassageID != nil {
+			passageIDs = append(passageIDs, *q.PassageID)
+		}
+	}
+	passageList, err := s.repo.GetPassages(ctx, config.UniqueStrings(passageIDs))
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	res := &AttemptResponse{}
+	res.MapFromModel(attempt, questions, format)
+	res.GroupByPassage(passageList, format)
+
+	questionIDs := []string{}
+	for _, q := range questions {
+		questionIDs = append(questionIDs, q.QuestionID)
+	}
+	questionAudio, err := s.audio.GetAudio(ctx, media.OWNER_TYPE_QUESTION, questionIDs)
+	if err != nil {
+		return
+	}
+	passageAudio, err := s.audio.GetAudio(ctx, media.OWNER_TYPE_PASSAGE, config.UniqueStrings(passageIDs))
+	if err != nil {
+		return
+	}
+	res.SetAudio(questionAudio, passageAudio)
+
+	if attempt.Status == STATUS_SUBMITTED {
+		var translations map[string]explanations.Translations
+		translations, err = s.explanations.GetForQuestions(ctx, questions)
+		if err != nil {
+			return
+		}
+		res.SetExplanations(translations, format)
+	}
+	out = res
+	return
+}
+
+// setAnswer puts the learner's answer on given, typed for typed questions
+// and a choice of the question otherwise.
+func setAnswer(given *AttemptAnswer, question *model.Question, in *SaveAnswerRequest) error {
+	if isTyped(question) {
+		if in.AnswerText == nil || strings.TrimSpace(*in.AnswerText) == "" {
+			return response.ErrorWrap(response.ErrValidation, fmt.Errorf("answer_text is required"))
+		}
+		given.AnswerText = in.AnswerText
+		return nil
+	}
+	if in.AnswerID == nil || !hasAnswer(question, *in.AnswerID) {
+		return response.ErrorWrap(response.ErrValidation, fmt.Errorf("answer_id is not a choice of this question"))
+	}
+	given.AnswerID = in.AnswerID
+	return nil
+}
+
+func hasAnswer(question *model.Question, answerID string) bool {
+	for _, a := range question.Answers {
+		if a.AnswerID == answerID {
+			return true
+		}
+	}
+	return false
+}
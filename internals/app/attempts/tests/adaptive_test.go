@@ -0,0 +1,135 @@
+package tests
+
+import (
+	"testing"
+
+	"wakuwaku_nihongo/internals/app/attempts"
+	"wakuwaku_nihongo/internals/model"
+	"wakuwaku_nihongo/internals/pkg/ruby"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func adaptivePool() []*attempts.PoolItem {
+	return []*attempts.PoolItem{
+		{QuestionID: "easy", Discrimination: 1.2, Difficulty: -2},
+		{QuestionID: "middle", Discrimination: 1.2, Difficulty: 0},
+		{QuestionID: "sharp", Discrimination: 2.5, Difficulty: 0.2},
+		{QuestionID: "hard", Discrimination: 1.2, Difficulty: 2},
+	}
+}
+
+func TestNextQuestion(t *testing.T) {
+	pool := adaptivePool()
+
+	assert.Equal(t, "sharp", attempts.NextQuestion(pool, nil, 0).QuestionID)
+	assert.Equal(t, "middle", attempts.NextQuestion(pool, map[string]bool{"sharp": true}, 0).QuestionID)
+	assert.Equal(t, "hard", attempts.NextQuestion(pool, map[string]bool{"sharp": true}, 2.5).QuestionID)
+	assert.Equal(t, "easy", attempts.NextQuestion(pool, map[string]bool{"sharp": true}, -2).QuestionID)
+	assert.Nil(t, attempts.NextQuestion(pool, map[string]bool{"easy": true, "middle": true, "sharp": true, "hard": true}, 0))
+}
+
+func TestEstimateAbility(t *testing.T) {
+	right, wrong := true, false
+	answers := []*attempts.AttemptAnswer{
+		{QuestionID: "easy", IsCorrect: &right},
+		{QuestionID: "middle", IsCorrect: &right},
+		{QuestionID: "sharp", IsCorrect: &right},
+		// left the pool since it was served
+		{QuestionID: "gone", IsCorrect: &wrong},
+		// served, not answered yet
+		{QuestionID: "hard"},
+	}
+
+	theta, se := attempts.EstimateAbility(answers, adaptivePool())
+	assert.Greater(t, theta, 0.5)
+	assert.Less(t, se, 1.0)
+
+	answers[2].IsCorrect = &wrong
+	lower, _ := attempts.EstimateAbility(answers, adaptivePool())
+	assert.Less(t, lower, theta)
+}
+
+func TestFinished(t *testing.T) {
+	assert.False(t, attempts.Finished(0.5, 5))
+	assert.True(t, attempts.Finished(attempts.ADAPTIVE_TARGET_SE, 5))
+	assert.True(t, attempts.Finished(0.5, attempts.ADAPTIVE_MAX_QUESTIONS))
+}
+
+func TestLevel(t *testing.T) {
+	thresholds := map[string]float64{"N5": -1.5, "N4": -0.5, "N3": 0.4, "N2": 1.1, "N1": 1.8}
+
+	assert.Equal(t, "N5", attempts.Level(-3, thresholds))
+	assert.Equal(t, "N5", attempts.Level(-1, thresholds))
+	assert.Equal(t, "N4", attempts.Level(0, thresholds))
+	assert.Equal(t, "N3", attempts.Level(0.4, thresholds))
+	assert.Equal(t, "N1", attempts.Level(3, thresholds))
+	assert.Equal(t, "N5", attempts.Level(2, nil))
+
+	// an N2 bar below N3 is raised to it, a missing level is skipped
+	unordered := map[string]float64{"N5": -1, "N3": 0, "N2": -0.2}
+	assert.Equal(t, "N5", attempts.Level(-0.1, unordered))
+	assert.Equal(t, "N2", attempts.Level(0.1, unordered))
+}
+
+func TestMapFromModelAdaptive(t *testing.T) {
+	questions := []*model.Question{
+		{QuestionID: "first", QuestionText: "一", Answers: []*model.Answer{{AnswerID: "a", AnswerText: "いち", IsCorrect: true}}},
+		{QuestionID: "second", QuestionText: "二", Answers: []*model.Answer{{AnswerID: "b", AnswerText: "に", IsCorrect: true}}},
+	}
+	answerID, correct := "a", true
+	ability, se, level := 0.8, 0.29, "N3"
+	attempt := &attempts.Attempt{
+		Mode:           attempts.MODE_ADAPTIVE,
+		Status:         attempts.STATUS_IN_PROGRESS,
+		Total:          2,
+		Ability:        &ability,
+		StandardError:  &se,
+		EstimatedLevel: &level,
+		Answers: []*attempts.AttemptAnswer{
+			{QuestionID: "first", AnswerID: &answerID, IsCorrect: &correct, Position: 1},
+			{QuestionID: "second", Position: 2},
+		},
+	}
+
+	res := &attempts.AttemptResponse{}
+	res.MapFromModel(attempt, questions, ruby.FormatMarkup)
+	assert.Equal(t, attempts.MODE_ADAPTIVE, res.Mode)
+	assert.Equal(t, "second", *res.CurrentQuestionID)
+	assert.Equal(t, &answerID, res.Questions[0].AnswerID)
+	assert.Nil(t, res.Questions[0].IsCorrect)
+	assert.Nil(t, res.Ability)
+	assert.Nil(t, res.EstimatedLevel)
+
+	attempt.Status = attempts.STATUS_SUBMITTED
+	res = &attempts.AttemptResponse{}
+	res.MapFromModel(attempt, questions, ruby.FormatMarkup)
+	assert.Nil(t, res.CurrentQuestionID)
+	assert.Equal(t, "N3", *res.EstimatedLevel)
+	assert.Equal(t, 0.8, *res.Ability)
+	assert.True(t, *res.Questions[0].IsCorrect)
+	assert.False(t, *res.Questions[1].IsCorrect)
+}
+
+func TestMapFromModelPractice(t *testing.T) {
+	questions := []*model.Question{
+		{QuestionID: "first", QuestionText: "一", Answers: []*model.Answer{{AnswerID: "a", AnswerText: "いち", IsCorrect: true}}},
+		{QuestionID: "second", QuestionText: "二", Answers: []*model.Answer{{AnswerID: "b", AnswerText: "に", IsCorrect: true}}},
+	}
+	attempt := &attempts.Attempt{
+		Mode:   attempts.MODE_PRACTICE,
+		Status: attempts.STATUS_IN_PROGRESS,
+		Total:  2,
+		Answers: []*attempts.AttemptAnswer{
+			{QuestionID: "first", Position: 1},
+			{QuestionID: "second", Position: 2},
+		},
+	}
+
+	res := &attempts.AttemptResponse{}
+	res.MapFromModel(attempt, questions, ruby.FormatMarkup)
+	assert.Equal(t, attempts.MODE_PRACTICE, res.Mode)
+	assert.Nil(t, res.QuizID)
+	assert.Nil(t, res.CurrentQuestionID)
+	assert.Len(t, res.Questions, 2)
+}
@@ -0,0 +1,142 @@
+package tests
+
+import (
+	"testing"
+	"wakuwaku_nihongo/internals/app/attempts"
+	"wakuwaku_nihongo/internals/app/explanations"
+	"wakuwaku_nihongo/internals/app/media"
+	"wakuwaku_nihongo/internals/app/passages"
+	"wakuwaku_nihongo/internals/model"
+	"wakuwaku_nihongo/internals/pkg/ruby"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapFromModelHidesLeakingReadings(t *testing.T) {
+	questions := []*model.Question{
+		{
+			QuestionID:   "reading",
+			QuestionText: "漢字[かんじ]の読[よ]み方[かた]はどれですか",
+			Answers: []*model.Answer{
+				{AnswerID: "a", AnswerText: "かんじ", IsCorrect: true},
+				{AnswerID: "b", AnswerText: "かんず"},
+			},
+		},
+		{
+			QuestionID:   "writing",
+			QuestionText: "「かんじ」を漢字で書くとどれですか",
+			Answers: []*model.Answer{
+				{AnswerID: "c", AnswerText: "漢字[かんじ]", IsCorrect: true},
+				{AnswerID: "d", AnswerText: "感字[かんじ]"},
+				{AnswerID: "e", AnswerText: "漢[かん]", IsCorrect: false},
+			},
+		},
+	}
+
+	res := &attempts.AttemptResponse{}
+	res.MapFromModel(&attempts.Attempt{Status: attempts.STATUS_IN_PROGRESS}, questions, ruby.FormatMarkup)
+
+	assert.Equal(t, "漢字の読[よ]み方[かた]はどれですか", res.Questions[0].QuestionText)
+	assert.Equal(t, "漢字", res.Questions[1].Choices[0].AnswerText)
+	assert.Equal(t, "感字", res.Questions[1].Choices[1].AnswerText)
+	assert.Equal(t, "漢", res.Questions[1].Choices[2].AnswerText)
+
+	res = &attempts.AttemptResponse{}
+	res.MapFromModel(&attempts.Attempt{Status: attempts.STATUS_SUBMITTED}, questions, ruby.FormatHTML)
+
+	assert.Contains(t, res.Questions[0].QuestionText, "<rt>かんじ</rt>")
+	assert.Equal(t, []string{"<ruby>漢字<rp>(</rp><rt>かんじ</rt><rp>)</rp></ruby>"}, res.Questions[1].CorrectAnswers)
+}
+
+func TestGroupByPassage(t *testing.T) {
+	first, second, deleted := "p1", "p2", "gone"
+	questions := []*model.Question{
+		{QuestionID: "q1", QuestionText: "一"},
+		{QuestionID: "q2", QuestionText: "二", PassageID: &second},
+		{QuestionID: "q3", QuestionText: "三", PassageID: &first},
+		{QuestionID: "q4", QuestionText: "四", PassageID: &second},
+		{QuestionID: "q5", QuestionText: "五", PassageID: &deleted},
+	}
+	passageList := []*passages.Passage{
+		{PassageID: first, PassageText: "最初[さいしょ]の文章"},
+		{PassageID: second, PassageText: "次[つぎ]の文章"},
+	}
+
+	res := &attempts.AttemptResponse{}
+	res.MapFromModel(&attempts.Attempt{Status: attempts.STATUS_IN_PROGRESS}, questions, ruby.FormatStrip)
+	res.GroupByPassage(passageList, ruby.FormatStrip)
+
+	ids := func(qs []*attempts.AttemptQuestionResponse) (out []string) {
+		for _, q := range qs {
+			out = append(out, q.QuestionID)
+		}
+		return
+	}
+	assert.Equal(t, []string{"q1", "q5"}, ids(res.Questions))
+	assert.Len(t, res.Passages, 2)
+	assert.Equal(t, second, res.Passages[0].PassageID)
+	assert.Equal(t, "次の文章", res.Passages[0].PassageText)
+	assert.Equal(t, []string{"q2", "q4"}, ids(res.Passages[0].Questions))
+	assert.Equal(t, first, res.Passages[1].PassageID)
+	assert.Equal(t, []string{"q3"}, ids(res.Passages[1].Questions))
+	assert.Equal(t, []string{"q1", "q5", "q2", "q4", "q3"}, ids(res.AllQuestions()))
+
+	res.SetAudio(
+		map[string]*media.MediaResponse{"q4": {MediaID: "m1"}},
+		map[string]*media.MediaResponse{second: {MediaID: "m2"}},
+	)
+	assert.Equal(t, "m1", res.Passages[0].Questions[1].Audio.MediaID)
+	assert.Nil(t, res.Questions[0].Audio)
+	assert.Equal(t, "m2", res.Passages[0].Audio.MediaID)
+	assert.Nil(t, res.Passages[1].Audio)
+}
+
+func TestSetExplanationsOnlyAfterSubmit(t *testing.T) {
+	questions := []*model.Question{
+		{
+			QuestionID:   "q1",
+			QuestionText: "どれですか",
+			Answers: []*model.Answer{
+				{AnswerID: "a1", AnswerText: "はい", IsCorrect: true},
+				{AnswerID: "a2", AnswerText: "いいえ"},
+			},
+		},
+	}
+	translations := map[string]explanations.Translations{
+		"q1": {"en": "Because", "ja": "理由[りゆう]"},
+		"a2": {"id": "Salah"},
+	}
+
+	res := &attempts.AttemptResponse{}
+	res.MapFromModel(&attempts.Attempt{Status: attempts.STATUS_IN_PROGRESS}, questions, ruby.FormatMarkup)
+	res.SetExplanations(translations, ruby.FormatMarkup)
+	assert.Nil(t, res.Questions[0].Explanations)
+	assert.Nil(t, res.Questions[0].Choices[1].Explanations)
+
+	res = &attempts.AttemptResponse{}
+	res.MapFromModel(&attempts.Attempt{Status: attempts.STATUS_SUBMITTED}, questions, ruby.FormatStrip)
+	res.SetExplanations(translations, ruby.FormatStrip)
+	assert.Equal(t, explanations.Translations{"en": "Because", "ja": "理由"}, res.Questions[0].Explanations)
+	assert.Nil(t, res.Questions[0].Choices[0].Explanations)
+	assert.Equal(t, explanations.Translations{"id": "Salah"}, res.Questions[0].Choices[1].Explanations)
+}
+
+func TestMapFromModelRevisionAfterSubmit(t *testing.T) {
+	questions := []*model.Question{
+		{QuestionID: "q", QuestionText: "一", Answers: []*model.Answer{{AnswerID: "a", AnswerText: "いち", IsCorrect: true}}},
+	}
+	answerID, revisionID, correct := "a", "r", true
+	attempt := &attempts.Attempt{
+		Status:  attempts.STATUS_IN_PROGRESS,
+		Answers: []*attempts.AttemptAnswer{{QuestionID: "q", AnswerID: &answerID, IsCorrect: &correct, RevisionID: &revisionID}},
+	}
+
+	res := &attempts.AttemptResponse{}
+	res.MapFromModel(attempt, questions, ruby.FormatMarkup)
+	assert.Nil(t, res.Questions[0].RevisionID)
+
+	attempt.Status = attempts.STATUS_SUBMITTED
+	res = &attempts.AttemptResponse{}
+	res.MapFromModel(attempt, questions, ruby.FormatMarkup)
+	assert.Equal(t, &revisionID, res.Questions[0].RevisionID)
+}
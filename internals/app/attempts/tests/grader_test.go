@@ -0,0 +1,83 @@
+package tests
+
+import (
+	"testing"
+	"wakuwaku_nihongo/internals/app/attempts"
+	"wakuwaku_nihongo/internals/app/quizzes"
+	"wakuwaku_nihongo/internals/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func typedQuestion(mode string, correct ...string) *model.Question {
+	questionType := quizzes.QUESTION_TYPE_TYPED
+	q := &model.Question{QuestionType: &questionType, GradingMode: mode}
+	for _, text := range correct {
+		q.Answers = append(q.Answers, &model.Answer{AnswerText: text, IsCorrect: true})
+	}
+	return q
+}
+
+func TestGradeTyped(t *testing.T) {
+	tests := []struct {
+		name     string
+		question *model.Question
+		given    string
+		want     bool
+	}{
+		{"exact match", typedQuestion("exact", "たべる"), "たべる", true},
+		{"exact rejects katakana", typedQuestion("exact", "たべる"), "タベル", false},
+		{"standard accepts katakana", typedQuestion("standard", "たべる"), "タベル", true},
+		{"empty mode falls back to standard", typedQuestion("", "たべる"), "タベル", true},
+		{"any correct answer", typedQuestion("standard", "食べる", "たべる"), "たべる", true},
+		{"lenient long vowel", typedQuestion("lenient", "とうきょう"), "ときょう", true},
+		{"wrong answer", typedQuestion("lenient", "たべる"), "のむ", false},
+		{"furigana base text", typedQuestion("standard", "食[た]べる"), "食べる", true},
+		{"furigana reading", typedQuestion("standard", "食[た]べる"), "たべる", true},
+		{"exact ignores reading", typedQuestion("exact", "食[た]べる"), "たべる", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			given := tt.given
+			assert.Equal(t, tt.want, attempts.Grade(tt.question, &attempts.AttemptAnswer{AnswerText: &given}))
+		})
+	}
+}
+
+func TestGradeChoice(t *testing.T) {
+	q := &model.Question{Answers: []*model.Answer{
+		{AnswerID: "a", IsCorrect: false},
+		{AnswerID: "b", IsCorrect: true},
+	}}
+	a, b, c := "a", "b", "c"
+
+	assert.False(t, attempts.Grade(q, &attempts.AttemptAnswer{AnswerID: &a}))
+	assert.True(t, attempts.Grade(q, &attempts.AttemptAnswer{AnswerID: &b}))
+	assert.False(t, attempts.Grade(q, &attempts.AttemptAnswer{AnswerID: &c}))
+	assert.False(t, attempts.Grade(q, &attempts.AttemptAnswer{}))
+	assert.False(t, attempts.Grade(q, nil))
+}
+
+func TestTestedTexts(t *testing.T) {
+	reading := &model.Question{
+		QuestionText: "「先生[せんせい]」の読み方として正しいものはどれですか。",
+		Answers: []*model.Answer{
+			{AnswerText: "せんせい", IsCorrect: true},
+			{AnswerText: "せんせ"},
+		},
+	}
+	assert.Equal(t, []string{"せんせい", "先生"}, attempts.TestedTexts(reading))
+
+	writing := &model.Question{
+		QuestionText: "「がくせい」を漢字で書くとどれですか。",
+		Answers: []*model.Answer{
+			{AnswerText: "学生", IsCorrect: true},
+			{AnswerText: "学正"},
+		},
+	}
+	assert.Equal(t, []string{"学生", "がくせい"}, attempts.TestedTexts(writing))
+
+	plain := &model.Question{QuestionText: "日本の首都はどこですか", Answers: []*model.Answer{{AnswerText: "東京", IsCorrect: true}}}
+	assert.Equal(t, []string{"東京"}, attempts.TestedTexts(plain))
+}
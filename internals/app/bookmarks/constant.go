@@ -0,0 +1,14 @@
+package bookmarks
+
+const (
+	DEFAULT_SORT_BY = "created_at"
+
+	// a practice session is made of DEFAULT_PRACTICE_SIZE bookmarked
+	// questions picked at random unless the learner asks for more.
+	DEFAULT_PRACTICE_SIZE = 20
+	MAX_PRACTICE_SIZE     = 100
+)
+
+var SORTABLE_COLUMNS = map[string]bool{
+	"created_at": true,
+}
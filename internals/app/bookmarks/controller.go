@@ -0,0 +1,205 @@
+package bookmarks
+
+import (
+	"wakuwaku_nihongo/internals/abstraction"
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+type IBookmarkService interface {
+	ListBookmarks(ctx echo.Context, filter *BookmarkFilter) (out []*EntryResponse, info *abstraction.PaginationInfo, err error)
+	ListNotes(ctx echo.Context, filter *BookmarkFilter) (out []*EntryResponse, info *abstraction.PaginationInfo, err error)
+	Bookmark(ctx echo.Context, questionID string) (out *BookmarkResponse, err error)
+	Unbookmark(ctx echo.Context, questionID string) (err error)
+	GetNote(ctx echo.Context, questionID string) (out *NoteResponse, err error)
+	SaveNote(ctx echo.Context, questionID string, in *NoteRequest) (out *NoteResponse, err error)
+	DeleteNote(ctx echo.Context, questionID string) (err error)
+}
+
+type handler struct {
+	service IBookmarkService
+}
+
+func NewHandler(f *factory.Factory) *handler {
+	return &handler{
+		service: NewService(f),
+	}
+}
+
+// @Summary List My Bookmarks
+// @Description List the questions the logged in customer bookmarked, with their notes
+// @Tags bookmarks
+// @Produce json
+// @Param jlpt_level query string false "JLPT level" Enums(N5, N4, N3, N2, N1)
+// @Param section query string false "Section" Enums(vocabulary, grammar, reading, listening)
+// @Param page query int false "Page"
+// @Param page_size query int false "Page size"
+// @Param sort_by query string false "Sort by" Enums(created_at)
+// @Success 200 {object} response.SuccessResponseWithInfo{data=[]EntryResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 401 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/me/bookmarks [get]
+func (h *handler) ListBookmarks(c echo.Context) error {
+	req, err := bindFilter(c)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+
+	res, info, err := h.service.ListBookmarks(c, req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponseInfo(res, info).Send(c)
+}
+
+// @Summary List My Notes
+// @Description List the questions the logged in customer wrote notes on, bookmarked or not
+// @Tags bookmarks
+// @Produce json
+// @Param jlpt_level query string false "JLPT level" Enums(N5, N4, N3, N2, N1)
+// @Param section query string false "Section" Enums(vocabulary, grammar, reading, listening)
+// @Param page query int false "Page"
+// @Param page_size query int false "Page size"
+// @Param sort_by query string false "Sort by" Enums(created_at)
+// @Success 200 {object} response.SuccessResponseWithInfo{data=[]EntryResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 401 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/me/notes [get]
+func (h *handler) ListNotes(c echo.Context) error {
+	req, err := bindFilter(c)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+
+	res, info, err := h.service.ListNotes(c, req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponseInfo(res, info).Send(c)
+}
+
+// @Summary Bookmark Question
+// @Description Bookmark a question. Bookmarking a bookmarked question keeps the first bookmark
+// @Tags bookmarks
+// @Produce json
+// @Param question_id path string true "Question ID"
+// @Success 200 {object} response.Success{data=BookmarkResponse}
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/questions/{question_id}/bookmark [put]
+func (h *handler) Bookmark(c echo.Context) error {
+	res, err := h.service.Bookmark(c, c.Param("question_id"))
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Remove Bookmark
+// @Description Remove the bookmark of a question. Its note is kept
+// @Tags bookmarks
+// @Produce json
+// @Param question_id path string true "Question ID"
+// @Success 200 {object} response.Success{data=string}
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/questions/{question_id}/bookmark [delete]
+func (h *handler) Unbookmark(c echo.Context) error {
+	err := h.service.Unbookmark(c, c.Param("question_id"))
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse("deleted").Send(c)
+}
+
+// @Summary Get Note
+// @Description Get the private note of the logged in customer on a question
+// @Tags bookmarks
+// @Produce json
+// @Param question_id path string true "Question ID"
+// @Success 200 {object} response.Success{data=NoteResponse}
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/questions/{question_id}/note [get]
+func (h *handler) GetNote(c echo.Context) error {
+	res, err := h.service.GetNote(c, c.Param("question_id"))
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Save Note
+// @Description Create or replace the private note of the logged in customer on a question
+// @Tags bookmarks
+// @Accept json
+// @Produce json
+// @Param question_id path string true "Question ID"
+// @Param payload body NoteRequest true "Payload"
+// @Success 200 {object} response.Success{data=NoteResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/questions/{question_id}/note [put]
+func (h *handler) SaveNote(c echo.Context) error {
+	req := &NoteRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.SaveNote(c, c.Param("question_id"), req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Delete Note
+// @Description Delete the private note of the logged in customer on a question
+// @Tags bookmarks
+// @Produce json
+// @Param question_id path string true "Question ID"
+// @Success 200 {object} response.Success{data=string}
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/questions/{question_id}/note [delete]
+func (h *handler) DeleteNote(c echo.Context) error {
+	err := h.service.DeleteNote(c, c.Param("question_id"))
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse("deleted").Send(c)
+}
+
+func bindFilter(c echo.Context) (req *BookmarkFilter, err error) {
+	req = &BookmarkFilter{}
+	err = c.Bind(req)
+	if err != nil {
+		return nil, response.ErrorWrap(response.ErrUnprocessableEntity, err)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return nil, response.ErrorWrap(response.ErrValidation, err)
+	}
+	req.ChangeDefaultSortingClause(DEFAULT_SORT_BY, nil)
+	req.Pagination.SetDefault()
+	return
+}
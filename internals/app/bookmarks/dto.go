@@ -0,0 +1,72 @@
+package bookmarks
+
+import (
+	"wakuwaku_nihongo/internals/abstraction"
+)
+
+type BookmarkFilter struct {
+	JlptLevel string `query:"jlpt_level" validate:"omitempty,oneof=N5 N4 N3 N2 N1"`
+	Section   string `query:"section" validate:"omitempty,oneof=vocabulary grammar reading listening"`
+	abstraction.Pagination
+}
+
+type NoteRequest struct {
+	Note string `json:"note" validate:"required,max=5000"`
+}
+
+type EntryResponse struct {
+	QuestionID   string        `json:"question_id"`
+	QuestionText string        `json:"question_text"`
+	QuestionType *string       `json:"question_type"`
+	Section      *string       `json:"section"`
+	QuizID       string        `json:"quiz_id"`
+	QuizTitle    string        `json:"quiz_title"`
+	JlptLevel    *string       `json:"jlpt_level"`
+	Bookmarked   bool          `json:"bookmarked"`
+	BookmarkedAt *int64        `json:"bookmarked_at"`
+	Note         *NoteResponse `json:"note"`
+}
+
+func (r *EntryResponse) MapFromModel(m *entry) {
+	r.QuestionID = m.QuestionID
+	r.QuestionText = m.QuestionText
+	r.QuestionType = m.QuestionType
+	r.Section = m.Section
+	r.QuizID = m.QuizID
+	r.QuizTitle = m.QuizTitle
+	r.JlptLevel = m.JlptLevel
+	r.Bookmarked = m.BookmarkedAt != nil
+	r.BookmarkedAt = m.BookmarkedAt
+	if m.Note != nil {
+		r.Note = &NoteResponse{
+			QuestionID: m.QuestionID,
+			Note:       *m.Note,
+			CreatedAt:  *m.NoteCreatedAt,
+			ModifiedAt: m.NoteModifiedAt,
+		}
+	}
+}
+
+type BookmarkResponse struct {
+	QuestionID   string `json:"question_id"`
+	BookmarkedAt int64  `json:"bookmarked_at"`
+}
+
+func (r *BookmarkResponse) MapFromModel(m *Bookmark) {
+	r.QuestionID = m.QuestionID
+	r.BookmarkedAt = m.CreatedAt
+}
+
+type NoteResponse struct {
+	QuestionID string `json:"question_id"`
+	Note       string `json:"note"`
+	CreatedAt  int64  `json:"created_at"`
+	ModifiedAt *int64 `json:"modified_at"`
+}
+
+func (r *NoteResponse) MapFromModel(m *Note) {
+	r.QuestionID = m.QuestionID
+	r.Note = m.Note
+	r.CreatedAt = m.CreatedAt
+	r.ModifiedAt = m.ModifiedAt
+}
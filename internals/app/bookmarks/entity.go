@@ -0,0 +1,63 @@
+package bookmarks
+
+import (
+	"time"
+
+	"wakuwaku_nihongo/internals/pkg/softdelete"
+
+	"gorm.io/gorm"
+)
+
+// Bookmark is a question a customer starred.
+type Bookmark struct {
+	CustomerID string               `gorm:"column:customer_id;type:uuid;primaryKey" json:"customer_id"`
+	QuestionID string               `gorm:"column:question_id;type:uuid;primaryKey" json:"question_id"`
+	CreatedAt  int64                `gorm:"column:created_at;type:bigint;not null" json:"created_at"`
+	DeletedAt  softdelete.DeletedAt `gorm:"column:deleted_at;type:bigint" json:"deleted_at"`
+	DeletedBy  *string              `gorm:"column:deleted_by;type:character varying" json:"deleted_by"`
+}
+
+func (*Bookmark) TableName() string {
+	return "question_bookmarks"
+}
+
+func (m *Bookmark) BeforeCreate(tx *gorm.DB) (err error) {
+	m.CreatedAt = time.Now().UnixMilli()
+	return
+}
+
+// Note is the private note of a customer on a question.
+type Note struct {
+	CustomerID string               `gorm:"column:customer_id;type:uuid;primaryKey" json:"customer_id"`
+	QuestionID string               `gorm:"column:question_id;type:uuid;primaryKey" json:"question_id"`
+	Note       string               `gorm:"column:note;type:character varying;not null" json:"note"`
+	CreatedAt  int64                `gorm:"column:created_at;type:bigint;not null" json:"created_at"`
+	ModifiedAt *int64               `gorm:"column:modified_at;type:bigint" json:"modified_at"`
+	DeletedAt  softdelete.DeletedAt `gorm:"column:deleted_at;type:bigint" json:"deleted_at"`
+	DeletedBy  *string              `gorm:"column:deleted_by;type:character varying" json:"deleted_by"`
+}
+
+func (*Note) TableName() string {
+	return "question_notes"
+}
+
+func (m *Note) BeforeCreate(tx *gorm.DB) (err error) {
+	m.CreatedAt = time.Now().UnixMilli()
+	return
+}
+
+// entry is a bookmarked or annotated question with its quiz, its bookmark
+// and its note.
+type entry struct {
+	QuestionID     string
+	QuestionText   string
+	QuestionType   *string
+	Section        *string
+	QuizID         string
+	QuizTitle      string
+	JlptLevel      *string
+	BookmarkedAt   *int64
+	Note           *string
+	NoteCreatedAt  *int64
+	NoteModifiedAt *int64
+}
@@ -0,0 +1,156 @@
+package bookmarks
+
+import (
+	"time"
+
+	"wakuwaku_nihongo/internals/app/quizzes"
+	"wakuwaku_nihongo/internals/pkg/softdelete"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type repo struct {
+	db *gorm.DB
+}
+
+func NewRepo(db *gorm.DB) *repo {
+	return &repo{
+		db: db,
+	}
+}
+
+// QuestionExists reports whether a question learners can see exists, one
+// of a published quiz.
+func (r *repo) QuestionExists(ctx echo.Context, questionID string) (exists bool, err error) {
+	err = r.db.Raw(`SELECT EXISTS (
+		SELECT 1 FROM questions q
+		JOIN quizzes z ON z.quiz_id = q.quiz_id AND z.deleted_at IS NULL AND z.status = ?
+		WHERE q.question_id = ? AND q.deleted_at IS NULL)`, quizzes.QUIZ_STATUS_PUBLISHED, questionID).
+		Scan(&exists).Error
+	return
+}
+
+// CreateBookmark bookmarks a question, keeping the first bookmark of a
+// question bookmarked twice, and returns the bookmark. Bookmarking a question
+// again after deleting its bookmark brings the bookmark back as a new one.
+func (r *repo) CreateBookmark(ctx echo.Context, in *Bookmark) (out *Bookmark, err error) {
+	err = r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "customer_id"}, {Name: "question_id"}},
+		Where:     clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "question_bookmarks.deleted_at IS NOT NULL"}}},
+		DoUpdates: clause.AssignmentColumns([]string{"created_at", "deleted_at", "deleted_by"}),
+	}).Create(in).Error
+	if err != nil {
+		return
+	}
+	out = &Bookmark{}
+	err = r.db.Where("customer_id = ? AND question_id = ?", in.CustomerID, in.QuestionID).First(out).Error
+	return
+}
+
+// DeleteBookmark soft deletes the bookmark, the customer deletes their own.
+func (r *repo) DeleteBookmark(ctx echo.Context, customerID, questionID string) (deleted bool, err error) {
+	res := softdelete.By(r.db, customerID).Where("customer_id = ? AND question_id = ?", customerID, questionID).Delete(&Bookmark{})
+	return res.RowsAffected > 0, res.Error
+}
+
+func (r *repo) GetNote(ctx echo.Context, customerID, questionID string) (out *Note, err error) {
+	out = &Note{}
+	err = r.db.Where("customer_id = ? AND question_id = ?", customerID, questionID).First(out).Error
+	return
+}
+
+// SaveNote creates or replaces the note of a customer on a question. A note
+// saved in place of a deleted one is a new note.
+func (r *repo) SaveNote(ctx echo.Context, in *Note) (err error) {
+	return r.db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "customer_id"}, {Name: "question_id"}},
+		DoUpdates: clause.Assignments(map[string]any{
+			"note":        in.Note,
+			"created_at":  gorm.Expr("CASE WHEN question_notes.deleted_at IS NULL THEN question_notes.created_at ELSE excluded.created_at END"),
+			"modified_at": gorm.Expr("CASE WHEN question_notes.deleted_at IS NULL THEN ?::bigint END", time.Now().UnixMilli()),
+			"deleted_at":  nil,
+			"deleted_by":  nil,
+		}),
+	}).Create(in).Error
+}
+
+// DeleteNote soft deletes the note, the customer deletes their own.
+func (r *repo) DeleteNote(ctx echo.Context, customerID, questionID string) (deleted bool, err error) {
+	res := softdelete.By(r.db, customerID).Where("customer_id = ? AND question_id = ?", customerID, questionID).Delete(&Note{})
+	return res.RowsAffected > 0, res.Error
+}
+
+// ListBookmarks returns the bookmarked questions of a customer with their
+// notes.
+func (r *repo) ListBookmarks(ctx echo.Context, customerID string, filter *BookmarkFilter) (out []*entry, count int64, err error) {
+	db := r.db.Table("question_bookmarks b").
+		Joins("LEFT JOIN question_notes n ON n.customer_id = b.customer_id AND n.question_id = b.question_id AND n.deleted_at IS NULL").
+		Where("b.customer_id = ? AND b.deleted_at IS NULL", customerID)
+	return r.list(db, "b", filter)
+}
+
+// ListNotes returns the questions a customer wrote notes on, bookmarked
+// or not.
+func (r *repo) ListNotes(ctx echo.Context, customerID string, filter *BookmarkFilter) (out []*entry, count int64, err error) {
+	db := r.db.Table("question_notes n").
+		Joins("LEFT JOIN question_bookmarks b ON b.customer_id = n.customer_id AND b.question_id = n.question_id AND b.deleted_at IS NULL").
+		Where("n.customer_id = ? AND n.deleted_at IS NULL", customerID)
+	return r.list(db, "n", filter)
+}
+
+// list filters and pages entries from db, which selects from the bookmarks
+// as b and the notes as n, ordered by the table named base.
+func (r *repo) list(db *gorm.DB, base string, filter *BookmarkFilter) (out []*entry, count int64, err error) {
+	db = db.Joins("JOIN questions q ON q.question_id = "+base+".question_id AND q.deleted_at IS NULL").
+		Joins("JOIN quizzes z ON z.quiz_id = q.quiz_id AND z.deleted_at IS NULL AND z.status = ?", quizzes.QUIZ_STATUS_PUBLISHED)
+	if filter.JlptLevel != "" {
+		db = db.Where("z.jlpt_level = ?", filter.JlptLevel)
+	}
+	if filter.Section != "" {
+		db = db.Where("q.section = ?", filter.Section)
+	}
+	db = db.Session(&gorm.Session{})
+
+	if err = db.Count(&count).Error; err != nil {
+		return
+	}
+
+	out = []*entry{}
+	sortBy := *filter.SortBy
+	if !SORTABLE_COLUMNS[sortBy] {
+		sortBy = DEFAULT_SORT_BY
+	}
+	err = db.Select(`q.question_id, q.question_text, q.question_type, q.section, q.quiz_id,
+			z.title AS quiz_title, z.jlpt_level, b.created_at AS bookmarked_at,
+			n.note, n.created_at AS note_created_at, n.modified_at AS note_modified_at`).
+		Order(clause.OrderByColumn{
+			Column: clause.Column{Table: base, Name: sortBy},
+			Desc:   filter.GetOrderBy() == "desc",
+		}).
+		Order("q.question_id").
+		Limit(filter.Limit()).
+		Offset(filter.Offset()).
+		Scan(&out).Error
+	return
+}
+
+// ListPracticeIDs returns up to limit bookmarked questions of a customer,
+// which learners can see, in random order.
+func (r *repo) ListPracticeIDs(ctx echo.Context, customerID string, jlptLevel, section string, limit int) (out []string, err error) {
+	db := r.db.Table("question_bookmarks b").
+		Joins("JOIN questions q ON q.question_id = b.question_id AND q.deleted_at IS NULL").
+		Joins("JOIN quizzes z ON z.quiz_id = q.quiz_id AND z.deleted_at IS NULL AND z.status = ?", quizzes.QUIZ_STATUS_PUBLISHED).
+		Where("b.customer_id = ? AND b.deleted_at IS NULL", customerID)
+	if jlptLevel != "" {
+		db = db.Where("z.jlpt_level = ?", jlptLevel)
+	}
+	if section != "" {
+		db = db.Where("q.section = ?", section)
+	}
+
+	out = []string{}
+	err = db.Order("RANDOM()").Limit(limit).Pluck("b.question_id", &out).Error
+	return
+}
@@ -0,0 +1,22 @@
+package bookmarks
+
+import (
+	"github.com/labstack/echo/v4"
+	"wakuwaku_nihongo/internals/middleware"
+)
+
+func (h *handler) Route(g *echo.Group) {
+	g.GET("", h.ListBookmarks, middleware.Authentication)
+}
+
+func (h *handler) NoteRoute(g *echo.Group) {
+	g.GET("", h.ListNotes, middleware.Authentication)
+}
+
+func (h *handler) QuestionRoute(g *echo.Group) {
+	g.PUT("/:question_id/bookmark", h.Bookmark, middleware.Authentication)
+	g.DELETE("/:question_id/bookmark", h.Unbookmark, middleware.Authentication)
+	g.GET("/:question_id/note", h.GetNote, middleware.Authentication)
+	g.PUT("/:question_id/note", h.SaveNote, middleware.Authentication)
+	g.DELETE("/:question_id/note", h.DeleteNote, middleware.Authentication)
+}
@@ -0,0 +1,150 @@
+package bookmarks
+
+import (
+	"errors"
+	"fmt"
+
+	"wakuwaku_nihongo/internals/abstraction"
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/middleware"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+type IBookmarkRepo interface {
+	QuestionExists(ctx echo.Context, questionID string) (exists bool, err error)
+	CreateBookmark(ctx echo.Context, in *Bookmark) (out *Bookmark, err error)
+	DeleteBookmark(ctx echo.Context, customerID, questionID string) (deleted bool, err error)
+	GetNote(ctx echo.Context, customerID, questionID string) (out *Note, err error)
+	SaveNote(ctx echo.Context, in *Note) (err error)
+	DeleteNote(ctx echo.Context, customerID, questionID string) (deleted bool, err error)
+	ListBookmarks(ctx echo.Context, customerID string, filter *BookmarkFilter) (out []*entry, count int64, err error)
+	ListNotes(ctx echo.Context, customerID string, filter *BookmarkFilter) (out []*entry, count int64, err error)
+	ListPracticeIDs(ctx echo.Context, customerID string, jlptLevel, section string, limit int) (out []string, err error)
+}
+
+type service struct {
+	repo IBookmarkRepo
+}
+
+func NewService(f *factory.Factory) *service {
+	return &service{
+		repo: NewRepo(f.Db),
+	}
+}
+
+func (s *service) ListBookmarks(ctx echo.Context, filter *BookmarkFilter) (out []*EntryResponse, info *abstraction.PaginationInfo, err error) {
+	entries, count, err := s.repo.ListBookmarks(ctx, middleware.GetUserID(ctx), filter)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	return mapEntries(entries), filter.Pagination.CreatePageInfo(count), nil
+}
+
+func (s *service) ListNotes(ctx echo.Context, filter *BookmarkFilter) (out []*EntryResponse, info *abstraction.PaginationInfo, err error) {
+	entries, count, err := s.repo.ListNotes(ctx, middleware.GetUserID(ctx), filter)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	return mapEntries(entries), filter.Pagination.CreatePageInfo(count), nil
+}
+
+func (s *service) Bookmark(ctx echo.Context, questionID string) (out *BookmarkResponse, err error) {
+	if err = s.checkQuestion(ctx, questionID); err != nil {
+		return
+	}
+
+	bookmark, err := s.repo.CreateBookmark(ctx, &Bookmark{CustomerID: middleware.GetUserID(ctx), QuestionID: questionID})
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	out = &BookmarkResponse{}
+	out.MapFromModel(bookmark)
+	return
+}
+
+func (s *service) Unbookmark(ctx echo.Context, questionID string) (err error) {
+	deleted, err := s.repo.DeleteBookmark(ctx, middleware.GetUserID(ctx), questionID)
+	if err != nil {
+		return response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	if !deleted {
+		return response.ErrorWrap(response.ErrNotFound, fmt.Errorf("bookmark not found"))
+	}
+	return
+}
+
+func (s *service) GetNote(ctx echo.Context, questionID string) (out *NoteResponse, err error) {
+	note, err := s.repo.GetNote(ctx, middleware.GetUserID(ctx), questionID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("note not found"))
+		return
+	}
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	out = &NoteResponse{}
+	out.MapFromModel(note)
+	return
+}
+
+func (s *service) SaveNote(ctx echo.Context, questionID string, in *NoteRequest) (out *NoteResponse, err error) {
+	if err = s.checkQuestion(ctx, questionID); err != nil {
+		return
+	}
+
+	customerID := middleware.GetUserID(ctx)
+	err = s.repo.SaveNote(ctx, &Note{CustomerID: customerID, QuestionID: questionID, Note: in.Note})
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	return s.GetNote(ctx, questionID)
+}
+
+func (s *service) DeleteNote(ctx echo.Context, questionID string) (err error) {
+	deleted, err := s.repo.DeleteNote(ctx, middleware.GetUserID(ctx), questionID)
+	if err != nil {
+		return response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	if !deleted {
+		return response.ErrorWrap(response.ErrNotFound, fmt.Errorf("note not found"))
+	}
+	return
+}
+
+// PracticeQuestionIDs returns up to limit bookmarked questions of a
+// customer of a JLPT level and section, when given, in random order.
+func (s *service) PracticeQuestionIDs(ctx echo.Context, customerID string, jlptLevel, section string, limit int) (out []string, err error) {
+	if limit <= 0 {
+		limit = DEFAULT_PRACTICE_SIZE
+	}
+	return s.repo.ListPracticeIDs(ctx, customerID, jlptLevel, section, min(limit, MAX_PRACTICE_SIZE))
+}
+
+func (s *service) checkQuestion(ctx echo.Context, questionID string) (err error) {
+	exists, err := s.repo.QuestionExists(ctx, questionID)
+	if err != nil {
+		return response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	if !exists {
+		return response.ErrorWrap(response.ErrNotFound, fmt.Errorf("question not found"))
+	}
+	return
+}
+
+func mapEntries(entries []*entry) []*EntryResponse {
+	out := []*EntryResponse{}
+	for _, e := range entries {
+		res := &EntryResponse{}
+		res.MapFromModel(e)
+		out = append(out, res)
+	}
+	return out
+}
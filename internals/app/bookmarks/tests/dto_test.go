@@ -0,0 +1,25 @@
+package tests
+
+import (
+	"testing"
+
+	"wakuwaku_nihongo/internals/app/bookmarks"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNoteResponseMapFromModel(t *testing.T) {
+	modified := int64(2000)
+	res := &bookmarks.NoteResponse{}
+	res.MapFromModel(&bookmarks.Note{QuestionID: "q", Note: "は vs が", CreatedAt: 1000, ModifiedAt: &modified})
+
+	assert.Equal(t, &bookmarks.NoteResponse{QuestionID: "q", Note: "は vs が", CreatedAt: 1000, ModifiedAt: &modified}, res)
+}
+
+func TestBookmarkResponseMapFromModel(t *testing.T) {
+	res := &bookmarks.BookmarkResponse{}
+	res.MapFromModel(&bookmarks.Bookmark{CustomerID: "c", QuestionID: "q", CreatedAt: 1000})
+
+	assert.Equal(t, "q", res.QuestionID)
+	assert.Equal(t, int64(1000), res.BookmarkedAt)
+}
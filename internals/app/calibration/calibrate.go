@@ -0,0 +1,68 @@
+package calibration
+
+import (
+	"wakuwaku_nihongo/internals/pkg/irt"
+)
+
+// Fit calibrates the questions answered by at least MIN_RESPONSES customers
+// from the responses, flagging those with negative discrimination at now.
+// It returns irt.ErrNoResponses when no question has enough responses.
+func Fit(responses []*Response, now int64) (out []*Calibration, result *irt.Result, err error) {
+	answered := map[string]int{}
+	for _, r := range responses {
+		answered[r.QuestionID]++
+	}
+
+	items := map[string]int{}
+	persons := map[string]int{}
+	out = []*Calibration{}
+	fitted := []irt.Response{}
+	for _, r := range responses {
+		if answered[r.QuestionID] < MIN_RESPONSES {
+			continue
+		}
+		item, ok := items[r.QuestionID]
+		if !ok {
+			item = len(out)
+			items[r.QuestionID] = item
+			out = append(out, &Calibration{QuestionID: r.QuestionID, CalibratedAt: now})
+		}
+		person, ok := persons[r.CustomerID]
+		if !ok {
+			person = len(persons)
+			persons[r.CustomerID] = person
+		}
+
+		out[item].Responses++
+		if r.IsCorrect {
+			out[item].PCorrect++
+		}
+		fitted = append(fitted, irt.Response{Person: person, Item: item, Correct: r.IsCorrect})
+	}
+
+	result, err = irt.Fit(fitted, len(persons), len(out))
+	if err != nil {
+		return nil, nil, err
+	}
+	for i, c := range out {
+		c.Discrimination = result.Items[i].Discrimination
+		c.Difficulty = result.Items[i].Difficulty
+		c.PCorrect /= float64(c.Responses)
+		if c.Discrimination < 0 {
+			c.FlaggedAt = &now
+		}
+	}
+	return
+}
+
+// Status tells whether the question is ok, flagged or reviewed.
+func (m *Calibration) Status() string {
+	switch {
+	case m.FlaggedAt == nil:
+		return STATUS_OK
+	case m.ReviewedAt == nil:
+		return STATUS_FLAGGED
+	default:
+		return STATUS_REVIEWED
+	}
+}
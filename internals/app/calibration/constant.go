@@ -0,0 +1,32 @@
+package calibration
+
+import "time"
+
+const (
+	// CALIBRATION_INTERVAL is how often the calibration job refits every
+	// question, difficulties move slowly so once a day is enough.
+	CALIBRATION_INTERVAL = 24 * time.Hour
+	CALIBRATION_JOB_NAME = "irt_calibration"
+
+	// MIN_RESPONSES is the number of customers who must have answered a
+	// question before it is calibrated.
+	MIN_RESPONSES = 30
+
+	SAVE_BATCH_SIZE = 500
+
+	// a question is ok while its discrimination is not negative, flagged
+	// until an editor reviews it and reviewed after.
+	STATUS_OK       = "ok"
+	STATUS_FLAGGED  = "flagged"
+	STATUS_REVIEWED = "reviewed"
+
+	DEFAULT_SORT_BY = "discrimination"
+)
+
+var SORTABLE_COLUMNS = map[string]bool{
+	"discrimination": true,
+	"difficulty":     true,
+	"responses":      true,
+	"p_correct":      true,
+	"calibrated_at":  true,
+}
@@ -0,0 +1,135 @@
+package calibration
+
+import (
+	"wakuwaku_nihongo/internals/abstraction"
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ICalibrationService interface {
+	List(ctx echo.Context, filter *CalibrationFilter) (out []*CalibrationResponse, info *abstraction.PaginationInfo, err error)
+	Get(ctx echo.Context, questionID string) (out *CalibrationResponse, err error)
+	Review(ctx echo.Context, questionID string, in *ReviewRequest) (out *CalibrationResponse, err error)
+	Run(ctx echo.Context) (out *RunResponse, err error)
+}
+
+type handler struct {
+	service ICalibrationService
+}
+
+func NewHandler(f *factory.Factory) *handler {
+	return &handler{
+		service: NewService(f),
+	}
+}
+
+// @Summary List Question Calibrations
+// @Description List the IRT difficulty and discrimination of calibrated questions (editor only). status=flagged lists the questions with negative discrimination, likely miskeyed, that no editor reviewed yet. Sorted by discrimination ascending by default
+// @Tags calibration
+// @Produce json
+// @Param status query string false "Status" Enums(ok, flagged, reviewed)
+// @Param jlpt_level query string false "JLPT level" Enums(N5, N4, N3, N2, N1)
+// @Param section query string false "Section" Enums(vocabulary, grammar, reading, listening)
+// @Param page query int false "Page"
+// @Param page_size query int false "Page size"
+// @Param sort_by query string false "Sort by" Enums(discrimination, difficulty, responses, p_correct, calibrated_at)
+// @Param order_by query string false "Order" Enums(asc, desc)
+// @Success 200 {object} response.SuccessResponseWithInfo{data=[]CalibrationResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/calibrations [get]
+func (h *handler) List(c echo.Context) error {
+	req := &CalibrationFilter{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+	asc := "asc"
+	req.ChangeDefaultSortingClause(DEFAULT_SORT_BY, &asc)
+	req.Pagination.SetDefault()
+
+	res, info, err := h.service.List(c, req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponseInfo(res, info).Send(c)
+}
+
+// @Summary Get Question Calibration
+// @Description Get the IRT difficulty and discrimination of a question (editor only)
+// @Tags calibration
+// @Produce json
+// @Param question_id path string true "Question ID"
+// @Success 200 {object} response.Success{data=CalibrationResponse}
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/calibrations/{question_id} [get]
+func (h *handler) Get(c echo.Context) error {
+	res, err := h.service.Get(c, c.Param("question_id"))
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Review Flagged Question
+// @Description Mark a question flagged for negative discrimination as reviewed, for instance after fixing its answer key (editor only). It is flagged again only after its discrimination turned positive and negative again
+// @Tags calibration
+// @Accept json
+// @Produce json
+// @Param question_id path string true "Question ID"
+// @Param payload body ReviewRequest true "Payload"
+// @Success 200 {object} response.Success{data=CalibrationResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/calibrations/{question_id}/review [put]
+func (h *handler) Review(c echo.Context) error {
+	req := &ReviewRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.Review(c, c.Param("question_id"), req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Run Question Calibration
+// @Description Fit the 2PL IRT model over the first answer of every customer to every question and store the parameters of questions answered by at least 30 customers (admin only). The same job runs every day
+// @Tags calibration
+// @Produce json
+// @Success 200 {object} response.Success{data=RunResponse}
+// @Failure 401 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/calibrations/run [post]
+func (h *handler) Run(c echo.Context) error {
+	res, err := h.service.Run(c)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
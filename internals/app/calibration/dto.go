@@ -0,0 +1,57 @@
+package calibration
+
+import (
+	"wakuwaku_nihongo/internals/abstraction"
+)
+
+type CalibrationFilter struct {
+	Status    string `query:"status" validate:"omitempty,oneof=ok flagged reviewed"`
+	JlptLevel string `query:"jlpt_level" validate:"omitempty,oneof=N5 N4 N3 N2 N1"`
+	Section   string `query:"section" validate:"omitempty,oneof=vocabulary grammar reading listening"`
+	abstraction.Pagination
+}
+
+type ReviewRequest struct {
+	Note *string `json:"note" validate:"omitempty,max=2000"`
+}
+
+type CalibrationResponse struct {
+	QuestionID     string  `json:"question_id"`
+	QuizID         string  `json:"quiz_id,omitempty"`
+	QuestionText   string  `json:"question_text,omitempty"`
+	Section        *string `json:"section,omitempty"`
+	JlptLevel      *string `json:"jlpt_level,omitempty"`
+	Discrimination float64 `json:"discrimination"`
+	Difficulty     float64 `json:"difficulty"`
+	Responses      int     `json:"responses"`
+	PCorrect       float64 `json:"p_correct"`
+	CalibratedAt   int64   `json:"calibrated_at"`
+	Status         string  `json:"status"`
+	FlaggedAt      *int64  `json:"flagged_at"`
+	ReviewedAt     *int64  `json:"reviewed_at"`
+	ReviewedBy     *string `json:"reviewed_by"`
+	ReviewNote     *string `json:"review_note"`
+}
+
+func (r *CalibrationResponse) MapFromModel(m *Calibration) {
+	r.QuestionID = m.QuestionID
+	r.Discrimination = m.Discrimination
+	r.Difficulty = m.Difficulty
+	r.Responses = m.Responses
+	r.PCorrect = m.PCorrect
+	r.CalibratedAt = m.CalibratedAt
+	r.Status = m.Status()
+	r.FlaggedAt = m.FlaggedAt
+	r.ReviewedAt = m.ReviewedAt
+	r.ReviewedBy = m.ReviewedBy
+	r.ReviewNote = m.ReviewNote
+}
+
+type RunResponse struct {
+	Questions  int  `json:"questions"`
+	Customers  int  `json:"customers"`
+	Responses  int  `json:"responses"`
+	Flagged    int  `json:"flagged"`
+	Iterations int  `json:"iterations"`
+	Converged  bool `json:"converged"`
+}
@@ -0,0 +1,36 @@
+package calibration
+
+// Calibration holds the 2PL parameters of a question fitted from the first
+// answer of every customer to it. A question with negative discrimination
+// is flagged for editors until one reviews it, or a later fit clears it.
+type Calibration struct {
+	QuestionID     string  `gorm:"column:question_id;type:uuid;primaryKey" json:"question_id"`
+	Discrimination float64 `gorm:"column:discrimination;type:double precision;not null" json:"discrimination"`
+	Difficulty     float64 `gorm:"column:difficulty;type:double precision;not null" json:"difficulty"`
+	Responses      int     `gorm:"column:responses;type:integer;not null" json:"responses"`
+	PCorrect       float64 `gorm:"column:p_correct;type:double precision;not null" json:"p_correct"`
+	CalibratedAt   int64   `gorm:"column:calibrated_at;type:bigint;not null" json:"calibrated_at"`
+	FlaggedAt      *int64  `gorm:"column:flagged_at;type:bigint" json:"flagged_at"`
+	ReviewedAt     *int64  `gorm:"column:reviewed_at;type:bigint" json:"reviewed_at"`
+	ReviewedBy     *string `gorm:"column:reviewed_by;type:character varying" json:"reviewed_by"`
+	ReviewNote     *string `gorm:"column:review_note;type:character varying" json:"review_note"`
+}
+
+func (*Calibration) TableName() string {
+	return "question_calibrations"
+}
+
+// Response is the first graded answer of a customer to a question.
+type Response struct {
+	CustomerID string
+	QuestionID string
+	IsCorrect  bool
+}
+
+type calibrationWithQuestion struct {
+	Calibration
+	QuestionText string
+	Section      *string
+	QuizID       string
+	JlptLevel    *string
+}
@@ -0,0 +1,72 @@
+package calibration
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/pkg/irt"
+
+	"github.com/rs/zerolog/log"
+)
+
+type ICalibrationJobRepo interface {
+	ListResponses(ctx context.Context) (out []*Response, err error)
+	Save(ctx context.Context, in []*Calibration) (err error)
+}
+
+type job struct {
+	repo ICalibrationJobRepo
+}
+
+func NewJob(f *factory.Factory) *job {
+	return &job{
+		repo: NewRepo(f.Db),
+	}
+}
+
+// Run calibrates the questions, it is scheduled every CALIBRATION_INTERVAL.
+func (j *job) Run(ctx context.Context) (err error) {
+	_, err = j.Calibrate(ctx, time.Now())
+	return
+}
+
+// Calibrate fits the 2PL model over the answers of every customer and
+// stores the parameters of every question with enough of them.
+func (j *job) Calibrate(ctx context.Context, now time.Time) (out *RunResponse, err error) {
+	responses, err := j.repo.ListResponses(ctx)
+	if err != nil {
+		return
+	}
+
+	out = &RunResponse{}
+	calibrations, result, err := Fit(responses, now.UnixMilli())
+	if errors.Is(err, irt.ErrNoResponses) {
+		return out, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = ctx.Err(); err != nil {
+		return nil, err
+	}
+	if err = j.repo.Save(ctx, calibrations); err != nil {
+		return nil, err
+	}
+
+	out.Questions = len(calibrations)
+	out.Customers = len(result.Abilities)
+	out.Iterations = result.Iterations
+	out.Converged = result.Converged
+	for _, c := range calibrations {
+		out.Responses += c.Responses
+		if c.FlaggedAt != nil {
+			out.Flagged++
+		}
+	}
+	if !result.Converged {
+		log.Warn().Int("iterations", result.Iterations).Msg("question calibration did not converge")
+	}
+	return
+}
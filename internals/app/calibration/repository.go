@@ -0,0 +1,127 @@
+package calibration
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type repo struct {
+	db *gorm.DB
+}
+
+func NewRepo(db *gorm.DB) *repo {
+	return &repo{
+		db: db,
+	}
+}
+
+// ListResponses returns the first graded answer of every customer to every
+// question that is not deleted, later answers are influenced by having
+// seen the question before.
+func (r *repo) ListResponses(ctx context.Context) (out []*Response, err error) {
+	rows, err := r.db.WithContext(ctx).Raw(`
+		SELECT DISTINCT ON (a.customer_id, aa.question_id) a.customer_id, aa.question_id, aa.is_correct
+		FROM attempt_answers aa
+		JOIN attempts a ON a.attempt_id = aa.attempt_id
+		JOIN questions q ON q.question_id = aa.question_id
+		WHERE a.status = 'submitted' AND a.deleted_at IS NULL AND aa.deleted_at IS NULL
+			AND aa.is_correct IS NOT NULL AND q.deleted_at IS NULL
+		ORDER BY a.customer_id, aa.question_id, a.submitted_at, aa.created_at`).
+		Rows()
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	out = []*Response{}
+	for rows.Next() {
+		res := &Response{}
+		if err = rows.Scan(&res.CustomerID, &res.QuestionID, &res.IsCorrect); err != nil {
+			return
+		}
+		out = append(out, res)
+	}
+	err = rows.Err()
+	return
+}
+
+// Save stores new fits. A question stays flagged, and keeps its review,
+// while its discrimination is negative and is cleared once it is not.
+func (r *repo) Save(ctx context.Context, in []*Calibration) (err error) {
+	if len(in) == 0 {
+		return
+	}
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "question_id"}},
+		DoUpdates: clause.Set{
+			{Column: clause.Column{Name: "discrimination"}, Value: clause.Column{Table: "excluded", Name: "discrimination"}},
+			{Column: clause.Column{Name: "difficulty"}, Value: clause.Column{Table: "excluded", Name: "difficulty"}},
+			{Column: clause.Column{Name: "responses"}, Value: clause.Column{Table: "excluded", Name: "responses"}},
+			{Column: clause.Column{Name: "p_correct"}, Value: clause.Column{Table: "excluded", Name: "p_correct"}},
+			{Column: clause.Column{Name: "calibrated_at"}, Value: clause.Column{Table: "excluded", Name: "calibrated_at"}},
+			{Column: clause.Column{Name: "flagged_at"}, Value: gorm.Expr("CASE WHEN excluded.flagged_at IS NULL THEN NULL ELSE COALESCE(question_calibrations.flagged_at, excluded.flagged_at) END")},
+			{Column: clause.Column{Name: "reviewed_at"}, Value: gorm.Expr("CASE WHEN excluded.flagged_at IS NULL THEN NULL ELSE question_calibrations.reviewed_at END")},
+			{Column: clause.Column{Name: "reviewed_by"}, Value: gorm.Expr("CASE WHEN excluded.flagged_at IS NULL THEN NULL ELSE question_calibrations.reviewed_by END")},
+			{Column: clause.Column{Name: "review_note"}, Value: gorm.Expr("CASE WHEN excluded.flagged_at IS NULL THEN NULL ELSE question_calibrations.review_note END")},
+		},
+	}).CreateInBatches(in, SAVE_BATCH_SIZE).Error
+}
+
+func (r *repo) List(ctx context.Context, filter *CalibrationFilter) (out []*calibrationWithQuestion, count int64, err error) {
+	db := r.db.WithContext(ctx).Table("question_calibrations c").
+		Joins("JOIN questions q ON q.question_id = c.question_id AND q.deleted_at IS NULL").
+		Joins("JOIN quizzes z ON z.quiz_id = q.quiz_id")
+	switch filter.Status {
+	case STATUS_FLAGGED:
+		db = db.Where("c.flagged_at IS NOT NULL AND c.reviewed_at IS NULL")
+	case STATUS_REVIEWED:
+		db = db.Where("c.flagged_at IS NOT NULL AND c.reviewed_at IS NOT NULL")
+	case STATUS_OK:
+		db = db.Where("c.flagged_at IS NULL")
+	}
+	if filter.JlptLevel != "" {
+		db = db.Where("z.jlpt_level = ?", filter.JlptLevel)
+	}
+	if filter.Section != "" {
+		db = db.Where("q.section = ?", filter.Section)
+	}
+	db = db.Session(&gorm.Session{})
+
+	if err = db.Count(&count).Error; err != nil {
+		return
+	}
+
+	out = []*calibrationWithQuestion{}
+	sortBy := *filter.SortBy
+	if !SORTABLE_COLUMNS[sortBy] {
+		sortBy = DEFAULT_SORT_BY
+	}
+	err = db.Select("c.*, q.question_text, q.section, q.quiz_id, z.jlpt_level").
+		Order(clause.OrderByColumn{
+			Column: clause.Column{Table: "c", Name: sortBy},
+			Desc:   filter.GetOrderBy() == "desc",
+		}).
+		Order("c.question_id").
+		Limit(filter.Limit()).
+		Offset(filter.Offset()).
+		Scan(&out).Error
+	return
+}
+
+func (r *repo) Get(ctx context.Context, questionID string) (out *Calibration, err error) {
+	out = &Calibration{}
+	err = r.db.WithContext(ctx).Where("question_id = ?", questionID).First(out).Error
+	return
+}
+
+func (r *repo) Review(ctx context.Context, in *Calibration) (err error) {
+	return r.db.WithContext(ctx).Model(&Calibration{}).
+		Where("question_id = ?", in.QuestionID).
+		Updates(map[string]any{
+			"reviewed_at": in.ReviewedAt,
+			"reviewed_by": in.ReviewedBy,
+			"review_note": in.ReviewNote,
+		}).Error
+}
@@ -0,0 +1,17 @@
+package calibration
+
+import (
+	"github.com/labstack/echo/v4"
+	"wakuwaku_nihongo/internals/middleware"
+	"wakuwaku_nihongo/internals/utils/token"
+)
+
+func (h *handler) Route(g *echo.Group) {
+	editor := middleware.Authorization(token.ROLE_EDITOR)
+	admin := middleware.Authorization(token.ROLE_ADMIN)
+
+	g.GET("", h.List, middleware.Authentication, editor)
+	g.POST("/run", h.Run, middleware.Authentication, admin)
+	g.GET("/:question_id", h.Get, middleware.Authentication, editor)
+	g.PUT("/:question_id/review", h.Review, middleware.Authentication, editor)
+}
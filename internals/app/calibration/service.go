@@ -0,0 +1,116 @@
+package calibration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"wakuwaku_nihongo/internals/abstraction"
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/middleware"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+type ICalibrationRepo interface {
+	List(ctx context.Context, filter *CalibrationFilter) (out []*calibrationWithQuestion, count int64, err error)
+	Get(ctx context.Context, questionID string) (out *Calibration, err error)
+	Review(ctx context.Context, in *Calibration) (err error)
+}
+
+type ICalibrationJob interface {
+	Calibrate(ctx context.Context, now time.Time) (out *RunResponse, err error)
+}
+
+type service struct {
+	repo ICalibrationRepo
+	job  ICalibrationJob
+}
+
+func NewService(f *factory.Factory) *service {
+	return &service{
+		repo: NewRepo(f.Db),
+		job:  NewJob(f),
+	}
+}
+
+func (s *service) List(ctx echo.Context, filter *CalibrationFilter) (out []*CalibrationResponse, info *abstraction.PaginationInfo, err error) {
+	calibrations, count, err := s.repo.List(ctx.Request().Context(), filter)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	out = []*CalibrationResponse{}
+	for _, c := range calibrations {
+		res := &CalibrationResponse{
+			QuizID:       c.QuizID,
+			QuestionText: c.QuestionText,
+			Section:      c.Section,
+			JlptLevel:    c.JlptLevel,
+		}
+		res.MapFromModel(&c.Calibration)
+		out = append(out, res)
+	}
+	info = filter.Pagination.CreatePageInfo(count)
+	return
+}
+
+func (s *service) Get(ctx echo.Context, questionID string) (out *CalibrationResponse, err error) {
+	calibration, err := s.get(ctx, questionID)
+	if err != nil {
+		return
+	}
+	out = &CalibrationResponse{}
+	out.MapFromModel(calibration)
+	return
+}
+
+// Review marks a flagged question as reviewed, it stays reviewed until its
+// discrimination turns positive.
+func (s *service) Review(ctx echo.Context, questionID string, in *ReviewRequest) (out *CalibrationResponse, err error) {
+	calibration, err := s.get(ctx, questionID)
+	if err != nil {
+		return
+	}
+	if calibration.FlaggedAt == nil {
+		err = response.ErrorWrap(response.ErrBadRequest, fmt.Errorf("question is not flagged"))
+		return
+	}
+
+	now := time.Now().UnixMilli()
+	userID := middleware.GetUserID(ctx)
+	calibration.ReviewedAt = &now
+	calibration.ReviewedBy = &userID
+	calibration.ReviewNote = in.Note
+	if err = s.repo.Review(ctx.Request().Context(), calibration); err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	out = &CalibrationResponse{}
+	out.MapFromModel(calibration)
+	return
+}
+
+func (s *service) Run(ctx echo.Context) (out *RunResponse, err error) {
+	out, err = s.job.Calibrate(ctx.Request().Context(), time.Now())
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	return
+}
+
+func (s *service) get(ctx echo.Context, questionID string) (out *Calibration, err error) {
+	out, err = s.repo.Get(ctx.Request().Context(), questionID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("question is not calibrated"))
+		return
+	}
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	return
+}
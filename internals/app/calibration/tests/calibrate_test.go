@@ -0,0 +1,63 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"wakuwaku_nihongo/internals/app/calibration"
+	"wakuwaku_nihongo/internals/pkg/irt"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFit(t *testing.T) {
+	now := int64(1760000000000)
+	responses := []*calibration.Response{}
+	for c := range 60 {
+		customer := fmt.Sprintf("customer-%d", c)
+		strong := c%2 == 0
+		for q := range 4 {
+			// a few strong customers slip and weak ones guess right
+			slip := (c+q)%7 == 0
+			responses = append(responses, &calibration.Response{CustomerID: customer, QuestionID: fmt.Sprintf("q%d", q), IsCorrect: strong != slip})
+		}
+		responses = append(responses, &calibration.Response{CustomerID: customer, QuestionID: "miskeyed", IsCorrect: !strong})
+		if c < 10 {
+			responses = append(responses, &calibration.Response{CustomerID: customer, QuestionID: "rare", IsCorrect: true})
+		}
+	}
+
+	out, result, err := calibration.Fit(responses, now)
+	assert.NoError(t, err)
+	assert.True(t, result.Converged)
+	assert.Len(t, result.Abilities, 60)
+	assert.Len(t, out, 5)
+
+	byID := map[string]*calibration.Calibration{}
+	for _, c := range out {
+		byID[c.QuestionID] = c
+		assert.Equal(t, 60, c.Responses)
+		assert.Equal(t, now, c.CalibratedAt)
+	}
+	assert.NotContains(t, byID, "rare")
+
+	for q := range 4 {
+		c := byID[fmt.Sprintf("q%d", q)]
+		assert.Positive(t, c.Discrimination)
+		assert.Nil(t, c.FlaggedAt)
+		assert.Equal(t, calibration.STATUS_OK, c.Status())
+	}
+	miskeyed := byID["miskeyed"]
+	assert.Negative(t, miskeyed.Discrimination)
+	assert.InDelta(t, 0.5, miskeyed.PCorrect, 1e-9)
+	assert.Equal(t, now, *miskeyed.FlaggedAt)
+	assert.Equal(t, calibration.STATUS_FLAGGED, miskeyed.Status())
+
+	miskeyed.ReviewedAt = &now
+	assert.Equal(t, calibration.STATUS_REVIEWED, miskeyed.Status())
+}
+
+func TestFitTooFewResponses(t *testing.T) {
+	_, _, err := calibration.Fit([]*calibration.Response{{CustomerID: "c", QuestionID: "q", IsCorrect: true}}, 0)
+	assert.ErrorIs(t, err, irt.ErrNoResponses)
+}
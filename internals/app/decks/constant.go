@@ -0,0 +1,35 @@
+package decks
+
+const (
+	FORMAT_CSV  = "csv"
+	FORMAT_TSV  = "tsv"
+	FORMAT_APKG = "apkg"
+
+	// UPLOAD_FIELD is the multipart form field holding the imported file.
+	UPLOAD_FIELD = "file"
+
+	MAX_IMPORT_SIZE    = 50 << 20
+	MAX_CARDS_PER_DECK = 10000
+
+	// IMPORT_FORM_OVERHEAD is the room left in an import request for the
+	// multipart headers and the other fields around the file.
+	IMPORT_FORM_OVERHEAD = 1 << 20
+
+	DEFAULT_SORT_BY = "modified_at"
+
+	DEFAULT_REVIEW_LIMIT = 20
+	MAX_REVIEW_LIMIT     = 100
+)
+
+var SORTABLE_COLUMNS = map[string]bool{
+	"name":        true,
+	"created_at":  true,
+	"modified_at": true,
+}
+
+// EXPORT_CONTENT_TYPES maps the export formats to their content type.
+var EXPORT_CONTENT_TYPES = map[string]string{
+	FORMAT_CSV:  "text/csv; charset=utf-8",
+	FORMAT_TSV:  "text/tab-separated-values; charset=utf-8",
+	FORMAT_APKG: "application/octet-stream",
+}
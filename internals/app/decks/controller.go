@@ -0,0 +1,477 @@
+package decks
+
+import (
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+
+	"wakuwaku_nihongo/internals/abstraction"
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+type IDeckService interface {
+	List(ctx echo.Context, filter *DeckFilter) (out []*DeckResponse, info *abstraction.PaginationInfo, err error)
+	ListPublic(ctx echo.Context, filter *DeckFilter) (out []*DeckResponse, info *abstraction.PaginationInfo, err error)
+	Get(ctx echo.Context, deckID string) (out *DeckResponse, err error)
+	Create(ctx echo.Context, in *DeckRequest) (out *DeckResponse, err error)
+	Update(ctx echo.Context, deckID string, in *DeckRequest) (out *DeckResponse, err error)
+	Delete(ctx echo.Context, deckID string) (err error)
+	Clone(ctx echo.Context, deckID string) (out *DeckResponse, err error)
+	ListCards(ctx echo.Context, deckID string, p *abstraction.Pagination) (out []*CardResponse, info *abstraction.PaginationInfo, err error)
+	CreateCard(ctx echo.Context, deckID string, in *CardRequest) (out *CardResponse, err error)
+	UpdateCard(ctx echo.Context, deckID string, cardID string, in *CardRequest) (out *CardResponse, err error)
+	DeleteCard(ctx echo.Context, deckID string, cardID string) (err error)
+	Import(ctx echo.Context, file *multipart.FileHeader, in *ImportRequest) (out *ImportResponse, err error)
+	Export(ctx echo.Context, deckID string, format string) (fileName string, data []byte, err error)
+	Reviews(ctx echo.Context, filter *ReviewFilter) (out *ReviewQueueResponse, err error)
+	Review(ctx echo.Context, cardID string, in *ReviewRequest) (out *CardResponse, err error)
+}
+
+type handler struct {
+	service IDeckService
+}
+
+func NewHandler(f *factory.Factory) *handler {
+	return &handler{
+		service: NewService(f),
+	}
+}
+
+// @Summary List My Decks
+// @Description List the flashcard decks of the logged in customer
+// @Tags decks
+// @Produce json
+// @Param q query string false "Search name"
+// @Param page query int false "Page"
+// @Param page_size query int false "Page size"
+// @Param sort_by query string false "Sort by" Enums(name, created_at, modified_at)
+// @Success 200 {object} response.SuccessResponseWithInfo{data=[]DeckResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 401 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/decks [get]
+func (h *handler) List(c echo.Context) error {
+	req := &DeckFilter{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+	req.ChangeDefaultSortingClause(DEFAULT_SORT_BY, nil)
+	req.Pagination.SetDefault()
+
+	res, info, err := h.service.List(c, req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponseInfo(res, info).Send(c)
+}
+
+// @Summary List Public Decks
+// @Description List the decks customers shared publicly
+// @Tags decks
+// @Produce json
+// @Param q query string false "Search name"
+// @Param page query int false "Page"
+// @Param page_size query int false "Page size"
+// @Param sort_by query string false "Sort by" Enums(name, created_at, modified_at)
+// @Success 200 {object} response.SuccessResponseWithInfo{data=[]DeckResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 401 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/decks/public [get]
+func (h *handler) ListPublic(c echo.Context) error {
+	req := &DeckFilter{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+	req.ChangeDefaultSortingClause(DEFAULT_SORT_BY, nil)
+	req.Pagination.SetDefault()
+
+	res, info, err := h.service.ListPublic(c, req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponseInfo(res, info).Send(c)
+}
+
+// @Summary Get Deck
+// @Description Get a deck of the logged in customer or a public deck
+// @Tags decks
+// @Produce json
+// @Param id path string true "Deck ID"
+// @Success 200 {object} response.Success{data=DeckResponse}
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/decks/{id} [get]
+func (h *handler) Get(c echo.Context) error {
+	res, err := h.service.Get(c, c.Param("id"))
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Create Deck
+// @Description Create an empty deck
+// @Tags decks
+// @Accept json
+// @Produce json
+// @Param payload body DeckRequest true "Payload"
+// @Success 200 {object} response.Success{data=DeckResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/decks [post]
+func (h *handler) Create(c echo.Context) error {
+	req := &DeckRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.Create(c, req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Update Deck
+// @Description Update the name, description and sharing of an own deck
+// @Tags decks
+// @Accept json
+// @Produce json
+// @Param id path string true "Deck ID"
+// @Param payload body DeckRequest true "Payload"
+// @Success 200 {object} response.Success{data=DeckResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/decks/{id} [put]
+func (h *handler) Update(c echo.Context) error {
+	req := &DeckRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.Update(c, c.Param("id"), req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Delete Deck
+// @Description Soft delete an own deck with its cards
+// @Tags decks
+// @Produce json
+// @Param id path string true "Deck ID"
+// @Success 200 {object} response.Success{data=string}
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/decks/{id} [delete]
+func (h *handler) Delete(c echo.Context) error {
+	err := h.service.Delete(c, c.Param("id"))
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse("deleted").Send(c)
+}
+
+// @Summary Clone Deck
+// @Description Copy a public deck with its cards into a new private deck of the logged in customer. The cards start unreviewed
+// @Tags decks
+// @Produce json
+// @Param id path string true "Deck ID"
+// @Success 200 {object} response.Success{data=DeckResponse}
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/decks/{id}/clone [post]
+func (h *handler) Clone(c echo.Context) error {
+	res, err := h.service.Clone(c, c.Param("id"))
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Import Deck
+// @Description Create a deck from a CSV or TSV file with front, back and optional space separated tags columns, or from an Anki .apkg package
+// @Tags decks
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "CSV, TSV or apkg file"
+// @Param format formData string false "Format, defaults to the file extension" Enums(csv, tsv, apkg)
+// @Param name formData string false "Deck name"
+// @Param is_public formData bool false "Share the deck"
+// @Success 200 {object} response.Success{data=ImportResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/decks/import [post]
+func (h *handler) Import(c echo.Context) error {
+	// larger bodies are cut off while parsing instead of being spooled to disk
+	r := c.Request()
+	r.Body = http.MaxBytesReader(c.Response(), r.Body, MAX_IMPORT_SIZE+IMPORT_FORM_OVERHEAD)
+
+	file, err := c.FormFile(UPLOAD_FIELD)
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		return response.ErrorWrap(response.ErrValidation, fmt.Errorf("file exceeds the maximum size of %d bytes", MAX_IMPORT_SIZE)).Send(c)
+	}
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, fmt.Errorf("%s is required", UPLOAD_FIELD)).Send(c)
+	}
+
+	req := &ImportRequest{}
+	err = c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.Import(c, file, req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Export Deck
+// @Description Download a deck as CSV, TSV or an Anki .apkg package
+// @Tags decks
+// @Produce text/csv,text/tab-separated-values,application/octet-stream
+// @Param id path string true "Deck ID"
+// @Param format query string false "Format" Enums(csv, tsv, apkg)
+// @Success 200 {file} file
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/decks/{id}/export [get]
+func (h *handler) Export(c echo.Context) error {
+	req := &ExportFilter{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+	if req.Format == "" {
+		req.Format = FORMAT_CSV
+	}
+
+	fileName, data, err := h.service.Export(c, c.Param("id"), req.Format)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", fileName))
+	return c.Blob(http.StatusOK, EXPORT_CONTENT_TYPES[req.Format], data)
+}
+
+// @Summary List Deck Cards
+// @Description List the cards of an own or public deck
+// @Tags decks
+// @Produce json
+// @Param id path string true "Deck ID"
+// @Param page query int false "Page"
+// @Param page_size query int false "Page size"
+// @Success 200 {object} response.SuccessResponseWithInfo{data=[]CardResponse}
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/decks/{id}/cards [get]
+func (h *handler) ListCards(c echo.Context) error {
+	req := &abstraction.Pagination{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+	req.SetDefault()
+
+	res, info, err := h.service.ListCards(c, c.Param("id"), req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponseInfo(res, info).Send(c)
+}
+
+// @Summary Create Card
+// @Description Add a card to an own deck. The card is due for review right away
+// @Tags decks
+// @Accept json
+// @Produce json
+// @Param id path string true "Deck ID"
+// @Param payload body CardRequest true "Payload"
+// @Success 200 {object} response.Success{data=CardResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/decks/{id}/cards [post]
+func (h *handler) CreateCard(c echo.Context) error {
+	req := &CardRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.CreateCard(c, c.Param("id"), req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Update Card
+// @Description Update a card of an own deck, its review schedule is kept
+// @Tags decks
+// @Accept json
+// @Produce json
+// @Param id path string true "Deck ID"
+// @Param card_id path string true "Card ID"
+// @Param payload body CardRequest true "Payload"
+// @Success 200 {object} response.Success{data=CardResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/decks/{id}/cards/{card_id} [put]
+func (h *handler) UpdateCard(c echo.Context) error {
+	req := &CardRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.UpdateCard(c, c.Param("id"), c.Param("card_id"), req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Delete Card
+// @Description Soft delete a card of an own deck
+// @Tags decks
+// @Produce json
+// @Param id path string true "Deck ID"
+// @Param card_id path string true "Card ID"
+// @Success 200 {object} response.Success{data=string}
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/decks/{id}/cards/{card_id} [delete]
+func (h *handler) DeleteCard(c echo.Context) error {
+	err := h.service.DeleteCard(c, c.Param("id"), c.Param("card_id"))
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse("deleted").Send(c)
+}
+
+// @Summary List Due Cards
+// @Description List the cards of the logged in customer that are due for review across their decks, most overdue first
+// @Tags decks
+// @Produce json
+// @Param limit query int false "Number of cards, 20 by default"
+// @Success 200 {object} response.Success{data=ReviewQueueResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 401 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/me/reviews [get]
+func (h *handler) Reviews(c echo.Context) error {
+	req := &ReviewFilter{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.Reviews(c, req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Review Card
+// @Description Rate the recall of a card, 1 again, 2 hard, 3 good or 4 easy, and schedule its next review
+// @Tags decks
+// @Accept json
+// @Produce json
+// @Param card_id path string true "Card ID"
+// @Param payload body ReviewRequest true "Payload"
+// @Success 200 {object} response.Success{data=CardResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/me/reviews/{card_id} [post]
+func (h *handler) Review(c echo.Context) error {
+	req := &ReviewRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.Review(c, c.Param("card_id"), req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
@@ -0,0 +1,109 @@
+package decks
+
+import (
+	"wakuwaku_nihongo/internals/abstraction"
+)
+
+type DeckRequest struct {
+	Name        string  `json:"name" validate:"required,max=200"`
+	Description *string `json:"description" validate:"omitempty,max=2000"`
+	IsPublic    bool    `json:"is_public"`
+}
+
+type DeckFilter struct {
+	Search string `query:"q"`
+	abstraction.Pagination
+}
+
+type CardRequest struct {
+	Front string   `json:"front" validate:"required,max=2000"`
+	Back  string   `json:"back" validate:"max=2000"`
+	Tags  []string `json:"tags" validate:"omitempty,max=20,dive,required,max=50"`
+}
+
+// ImportRequest holds the form fields sent with the imported file.
+type ImportRequest struct {
+	// Format defaults to the extension of the file.
+	Format   string `form:"format" validate:"omitempty,oneof=csv tsv apkg"`
+	Name     string `form:"name" validate:"omitempty,max=200"`
+	IsPublic bool   `form:"is_public"`
+}
+
+type ExportFilter struct {
+	Format string `query:"format" validate:"omitempty,oneof=csv tsv apkg"`
+}
+
+type ReviewFilter struct {
+	Limit int `query:"limit" validate:"omitempty,min=1,max=100"`
+}
+
+type ReviewRequest struct {
+	// Rating is 1 again, 2 hard, 3 good or 4 easy.
+	Rating int `json:"rating" validate:"required,min=1,max=4"`
+}
+
+type DeckResponse struct {
+	DeckID       string  `json:"deck_id"`
+	CustomerID   string  `json:"customer_id"`
+	Name         string  `json:"name"`
+	Description  *string `json:"description"`
+	IsPublic     bool    `json:"is_public"`
+	SourceDeckID *string `json:"source_deck_id"`
+	CardCount    int64   `json:"card_count"`
+	CreatedAt    int64   `json:"created_at"`
+	ModifiedAt   *int64  `json:"modified_at"`
+}
+
+type CardResponse struct {
+	CardID         string   `json:"card_id"`
+	DeckID         string   `json:"deck_id"`
+	Front          string   `json:"front"`
+	Back           string   `json:"back"`
+	Tags           []string `json:"tags"`
+	DueAt          int64    `json:"due_at"`
+	IntervalDays   int      `json:"interval_days"`
+	Ease           float64  `json:"ease"`
+	Repetitions    int      `json:"repetitions"`
+	Lapses         int      `json:"lapses"`
+	LastReviewedAt *int64   `json:"last_reviewed_at"`
+}
+
+type ImportResponse struct {
+	Deck     *DeckResponse `json:"deck"`
+	Imported int           `json:"imported"`
+	Skipped  int           `json:"skipped"`
+}
+
+type ReviewQueueResponse struct {
+	Due   int64           `json:"due"`
+	Cards []*CardResponse `json:"cards"`
+}
+
+func (r *DeckResponse) MapFromModel(m *Deck) {
+	r.DeckID = m.DeckID
+	r.CustomerID = m.CustomerID
+	r.Name = m.Name
+	r.Description = m.Description
+	r.IsPublic = m.IsPublic
+	r.SourceDeckID = m.SourceDeckID
+	r.CardCount = m.CardCount
+	r.CreatedAt = m.CreatedAt
+	r.ModifiedAt = m.ModifiedAt
+}
+
+func (r *CardResponse) MapFromModel(m *Card) {
+	r.CardID = m.CardID
+	r.DeckID = m.DeckID
+	r.Front = m.Front
+	r.Back = m.Back
+	r.Tags = m.Tags
+	if r.Tags == nil {
+		r.Tags = []string{}
+	}
+	r.DueAt = m.DueAt
+	r.IntervalDays = m.IntervalDays
+	r.Ease = m.Ease
+	r.Repetitions = m.Repetitions
+	r.Lapses = m.Lapses
+	r.LastReviewedAt = m.LastReviewedAt
+}
@@ -0,0 +1,103 @@
+package decks
+
+import (
+	"time"
+
+	"wakuwaku_nihongo/internals/pkg/softdelete"
+	"wakuwaku_nihongo/internals/pkg/srs"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// Deck is a customer's flashcard deck. A public deck can be read and cloned
+// by every customer, SourceDeckID is the deck it was cloned from.
+type Deck struct {
+	DeckID       string               `gorm:"column:deck_id;type:uuid;primaryKey" json:"deck_id"`
+	CreatedAt    int64                `gorm:"column:created_at;type:bigint;not null" json:"created_at"`
+	ModifiedAt   *int64               `gorm:"column:modified_at;type:bigint" json:"modified_at"`
+	DeletedAt    softdelete.DeletedAt `gorm:"column:deleted_at;type:bigint" json:"deleted_at"`
+	CreatedBy    string               `gorm:"column:created_by;type:character varying;not null" json:"created_by"`
+	ModifiedBy   *string              `gorm:"column:modified_by;type:character varying" json:"modified_by"`
+	DeletedBy    *string              `gorm:"column:deleted_by;type:character varying" json:"deleted_by"`
+	CustomerID   string               `gorm:"column:customer_id;type:uuid;not null" json:"customer_id"`
+	Name         string               `gorm:"column:name;type:character varying;not null" json:"name"`
+	Description  *string              `gorm:"column:description;type:character varying" json:"description"`
+	IsPublic     bool                 `gorm:"column:is_public;type:boolean;not null" json:"is_public"`
+	SourceDeckID *string              `gorm:"column:source_deck_id;type:uuid" json:"source_deck_id"`
+	CardCount    int64                `gorm:"column:card_count;->" json:"card_count"`
+}
+
+func (*Deck) TableName() string {
+	return "decks"
+}
+
+func (m *Deck) BeforeCreate(tx *gorm.DB) (err error) {
+	m.CreatedAt = time.Now().UnixMilli()
+	if m.DeckID == "" {
+		m.DeckID = uuid.NewString()
+	}
+	return
+}
+
+// Card is a flashcard with its review schedule. Cards belong to the owner of
+// their deck, a clone gets its own cards scheduled from scratch.
+type Card struct {
+	CardID         string                      `gorm:"column:card_id;type:uuid;primaryKey" json:"card_id"`
+	CreatedAt      int64                       `gorm:"column:created_at;type:bigint;not null" json:"created_at"`
+	ModifiedAt     *int64                      `gorm:"column:modified_at;type:bigint" json:"modified_at"`
+	DeletedAt      softdelete.DeletedAt        `gorm:"column:deleted_at;type:bigint" json:"deleted_at"`
+	CreatedBy      string                      `gorm:"column:created_by;type:character varying;not null" json:"created_by"`
+	ModifiedBy     *string                     `gorm:"column:modified_by;type:character varying" json:"modified_by"`
+	DeletedBy      *string                     `gorm:"column:deleted_by;type:character varying" json:"deleted_by"`
+	DeckID         string                      `gorm:"column:deck_id;type:uuid;not null" json:"deck_id"`
+	CustomerID     string                      `gorm:"column:customer_id;type:uuid;not null" json:"customer_id"`
+	Front          string                      `gorm:"column:front;type:character varying;not null" json:"front"`
+	Back           string                      `gorm:"column:back;type:character varying;not null" json:"back"`
+	Tags           datatypes.JSONSlice[string] `gorm:"column:tags;type:jsonb;not null" json:"tags"`
+	DueAt          int64                       `gorm:"column:due_at;type:bigint;not null" json:"due_at"`
+	IntervalDays   int                         `gorm:"column:interval_days;type:integer;not null" json:"interval_days"`
+	Ease           float64                     `gorm:"column:ease;type:double precision;not null" json:"ease"`
+	Repetitions    int                         `gorm:"column:repetitions;type:integer;not null" json:"repetitions"`
+	Lapses         int                         `gorm:"column:lapses;type:integer;not null" json:"lapses"`
+	LastReviewedAt *int64                      `gorm:"column:last_reviewed_at;type:bigint" json:"last_reviewed_at"`
+}
+
+func (*Card) TableName() string {
+	return "cards"
+}
+
+// BeforeCreate makes a new card due right away.
+func (m *Card) BeforeCreate(tx *gorm.DB) (err error) {
+	m.CreatedAt = time.Now().UnixMilli()
+	if m.CardID == "" {
+		m.CardID = uuid.NewString()
+	}
+	if m.DueAt == 0 {
+		m.DueAt = m.CreatedAt
+	}
+	if m.Ease == 0 {
+		m.Ease = srs.DEFAULT_EASE
+	}
+	if m.Tags == nil {
+		m.Tags = []string{}
+	}
+	return
+}
+
+func (m *Card) State() srs.State {
+	return srs.State{Interval: m.IntervalDays, Ease: m.Ease, Repetitions: m.Repetitions, Lapses: m.Lapses}
+}
+
+// Review schedules the card after a review rated r at.
+func (m *Card) Review(r srs.Rating, at time.Time) {
+	s := srs.Next(m.State(), r)
+	m.IntervalDays = s.Interval
+	m.Ease = s.Ease
+	m.Repetitions = s.Repetitions
+	m.Lapses = s.Lapses
+	m.DueAt = srs.Due(at, s).UnixMilli()
+	reviewedAt := at.UnixMilli()
+	m.LastReviewedAt = &reviewedAt
+}
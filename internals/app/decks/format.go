@@ -0,0 +1,108 @@
+package decks
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+
+	"wakuwaku_nihongo/internals/pkg/apkg"
+)
+
+// CardFields is a card as it is imported or exported, without its schedule.
+type CardFields struct {
+	Front string
+	Back  string
+	Tags  []string
+}
+
+// ParseDelimited reads cards from CSV or TSV. The columns are the front, the
+// back and optionally space separated tags, a first row of "front" and
+// "back" is a header. Lines starting with # are comments, which skips the
+// headers of Anki's text exports. Rows without a front are skipped.
+func ParseDelimited(r io.Reader, format string) (out []*CardFields, skipped int, err error) {
+	cr := csv.NewReader(r)
+	if format == FORMAT_TSV {
+		cr.Comma = '\t'
+	}
+	cr.Comment = '#'
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = true
+
+	out = []*CardFields{}
+	for first := true; ; first = false {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if first && len(record) > 0 {
+			record[0] = strings.TrimPrefix(record[0], "\ufeff")
+			if len(record) > 1 && strings.EqualFold(strings.TrimSpace(record[0]), "front") && strings.EqualFold(strings.TrimSpace(record[1]), "back") {
+				continue
+			}
+		}
+
+		card := &CardFields{Front: strings.TrimSpace(record[0])}
+		if len(record) > 1 {
+			card.Back = strings.TrimSpace(record[1])
+		}
+		if len(record) > 2 {
+			card.Tags = strings.Fields(record[2])
+		}
+		if card.Front == "" {
+			skipped++
+			continue
+		}
+		out = append(out, card)
+	}
+	return
+}
+
+// WriteDelimited writes cards as CSV or TSV with a header row.
+func WriteDelimited(w io.Writer, format string, cards []*Card) error {
+	cw := csv.NewWriter(w)
+	if format == FORMAT_TSV {
+		cw.Comma = '\t'
+	}
+	if err := cw.Write([]string{"front", "back", "tags"}); err != nil {
+		return err
+	}
+	for _, c := range cards {
+		if err := cw.Write([]string{c.Front, c.Back, strings.Join(c.Tags, " ")}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// FromNotes takes the first two fields of Anki notes as the front and back,
+// notes without a front are skipped.
+func FromNotes(notes []apkg.Note) (out []*CardFields, skipped int) {
+	out = []*CardFields{}
+	for _, n := range notes {
+		card := &CardFields{Tags: n.Tags}
+		if len(n.Fields) > 0 {
+			card.Front = n.Fields[0]
+		}
+		if len(n.Fields) > 1 {
+			card.Back = n.Fields[1]
+		}
+		if card.Front == "" {
+			skipped++
+			continue
+		}
+		out = append(out, card)
+	}
+	return
+}
+
+func ToNotes(cards []*Card) []apkg.Note {
+	out := make([]apkg.Note, 0, len(cards))
+	for _, c := range cards {
+		out = append(out, apkg.Note{Fields: []string{c.Front, c.Back}, Tags: c.Tags})
+	}
+	return out
+}
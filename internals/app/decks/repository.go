@@ -0,0 +1,215 @@
+package decks
+
+import (
+	"wakuwaku_nihongo/internals/abstraction"
+	"wakuwaku_nihongo/internals/pkg/softdelete"
+	"wakuwaku_nihongo/internals/pkg/sqlutil"
+	"wakuwaku_nihongo/internals/pkg/srs"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// cardCount selects a deck with the number of its cards.
+const cardCount = "decks.*, (SELECT COUNT(*) FROM cards c WHERE c.deck_id = decks.deck_id AND c.deleted_at IS NULL) AS card_count"
+
+type repo struct {
+	db *gorm.DB
+}
+
+func NewRepo(db *gorm.DB) *repo {
+	return &repo{
+		db: db,
+	}
+}
+
+// List returns the customer's own decks, or every public deck when public.
+func (r *repo) List(ctx echo.Context, customerID string, public bool, filter *DeckFilter) (out []*Deck, count int64, err error) {
+	db := r.db.Model(&Deck{})
+	if public {
+		db = db.Where("is_public")
+	} else {
+		db = db.Where("customer_id = ?", customerID)
+	}
+	if filter.Search != "" {
+		like := sqlutil.Contains(filter.Search)
+		db = db.Where("name ILIKE ? OR description ILIKE ?", like, like)
+	}
+	db = db.Session(&gorm.Session{})
+
+	if err = db.Count(&count).Error; err != nil {
+		return
+	}
+
+	out = []*Deck{}
+	sortBy := *filter.SortBy
+	if !SORTABLE_COLUMNS[sortBy] {
+		sortBy = DEFAULT_SORT_BY
+	}
+	err = db.Select(cardCount).
+		Order(clause.OrderByColumn{
+			Column: clause.Column{Name: sortBy},
+			Desc:   filter.GetOrderBy() == "desc",
+		}).
+		Order("deck_id").
+		Limit(filter.Limit()).
+		Offset(filter.Offset()).
+		Find(&out).Error
+	return
+}
+
+// GetVisible returns a deck the customer owns or that is public.
+func (r *repo) GetVisible(ctx echo.Context, deckID string, customerID string) (out *Deck, err error) {
+	out = &Deck{}
+	err = r.db.Select(cardCount).
+		Where("deck_id = ? AND (customer_id = ? OR is_public)", deckID, customerID).
+		First(out).Error
+	return
+}
+
+func (r *repo) Create(ctx echo.Context, in *Deck) (err error) {
+	return r.db.Create(in).Error
+}
+
+// CreateWithCards stores an imported deck with its cards.
+func (r *repo) CreateWithCards(ctx echo.Context, in *Deck, cards []*Card) (err error) {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(in).Error; err != nil {
+			return err
+		}
+		if len(cards) == 0 {
+			return nil
+		}
+		for _, c := range cards {
+			c.DeckID = in.DeckID
+		}
+		return tx.CreateInBatches(cards, 500).Error
+	})
+}
+
+func (r *repo) Update(ctx echo.Context, in *Deck) (err error) {
+	return r.db.Model(&Deck{}).
+		Where("deck_id = ?", in.DeckID).
+		Updates(map[string]any{
+			"name":        in.Name,
+			"description": in.Description,
+			"is_public":   in.IsPublic,
+			"modified_at": in.ModifiedAt,
+			"modified_by": in.ModifiedBy,
+		}).Error
+}
+
+// Delete soft deletes a deck with its cards.
+func (r *repo) Delete(ctx echo.Context, deckID string, deletedBy string) (err error) {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		tx = softdelete.By(tx, deletedBy)
+		if err := tx.Where("deck_id = ?", deckID).Delete(&Card{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("deck_id = ?", deckID).Delete(&Deck{}).Error
+	})
+}
+
+// Clone copies a deck and its cards to in, owned by in.CustomerID. The
+// copied cards are new to their owner.
+func (r *repo) Clone(ctx echo.Context, sourceDeckID string, in *Deck) (err error) {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(in).Error; err != nil {
+			return err
+		}
+		return tx.Exec(`INSERT INTO cards (card_id, created_at, created_by, deck_id, customer_id, front, back, tags, due_at, interval_days, ease, repetitions, lapses)
+			SELECT gen_random_uuid(), ?, ?, ?, ?, front, back, tags, ?, 0, ?, 0, 0
+			FROM cards WHERE deck_id = ? AND deleted_at IS NULL
+			ORDER BY created_at, card_id`,
+			in.CreatedAt, in.CreatedBy, in.DeckID, in.CustomerID, in.CreatedAt, srs.DEFAULT_EASE, sourceDeckID).Error
+	})
+}
+
+func (r *repo) ListCards(ctx echo.Context, deckID string, p *abstraction.Pagination) (out []*Card, count int64, err error) {
+	db := r.db.Model(&Card{}).Where("deck_id = ?", deckID).Session(&gorm.Session{})
+	if err = db.Count(&count).Error; err != nil {
+		return
+	}
+	out = []*Card{}
+	err = db.Order("created_at, card_id").
+		Limit(p.Limit()).
+		Offset(p.Offset()).
+		Find(&out).Error
+	return
+}
+
+// ListAllCards returns every card of a deck, for an export.
+func (r *repo) ListAllCards(ctx echo.Context, deckID string) (out []*Card, err error) {
+	out = []*Card{}
+	err = r.db.Where("deck_id = ?", deckID).
+		Order("created_at, card_id").
+		Find(&out).Error
+	return
+}
+
+func (r *repo) CountCards(ctx echo.Context, deckID string) (out int64, err error) {
+	err = r.db.Model(&Card{}).Where("deck_id = ?", deckID).Count(&out).Error
+	return
+}
+
+// GetOwnedCard returns a card of the customer's deck.
+func (r *repo) GetOwnedCard(ctx echo.Context, cardID string, customerID string) (out *Card, err error) {
+	out = &Card{}
+	err = r.db.Where("card_id = ? AND customer_id = ?", cardID, customerID).First(out).Error
+	return
+}
+
+func (r *repo) CreateCard(ctx echo.Context, in *Card) (err error) {
+	return r.db.Create(in).Error
+}
+
+func (r *repo) UpdateCard(ctx echo.Context, in *Card) (err error) {
+	return r.db.Model(&Card{}).
+		Where("card_id = ?", in.CardID).
+		Updates(map[string]any{
+			"front":       in.Front,
+			"back":        in.Back,
+			"tags":        in.Tags,
+			"modified_at": in.ModifiedAt,
+			"modified_by": in.ModifiedBy,
+		}).Error
+}
+
+func (r *repo) DeleteCard(ctx echo.Context, cardID string, deletedBy string) (err error) {
+	return softdelete.By(r.db, deletedBy).Where("card_id = ?", cardID).Delete(&Card{}).Error
+}
+
+// dueCards are the customer's cards due at now in decks not deleted.
+func (r *repo) dueCards(customerID string, now int64) *gorm.DB {
+	return r.db.Model(&Card{}).
+		Joins("JOIN decks d ON d.deck_id = cards.deck_id AND d.deleted_at IS NULL").
+		Where("cards.customer_id = ? AND cards.due_at <= ?", customerID, now)
+}
+
+// ListDue returns the cards due at now, the longest overdue first.
+func (r *repo) ListDue(ctx echo.Context, customerID string, now int64, limit int) (out []*Card, count int64, err error) {
+	if err = r.dueCards(customerID, now).Count(&count).Error; err != nil {
+		return
+	}
+	out = []*Card{}
+	err = r.dueCards(customerID, now).
+		Select("cards.*").
+		Order("cards.due_at, cards.card_id").
+		Limit(limit).
+		Find(&out).Error
+	return
+}
+
+func (r *repo) SaveReview(ctx echo.Context, in *Card) (err error) {
+	return r.db.Model(&Card{}).
+		Where("card_id = ?", in.CardID).
+		Updates(map[string]any{
+			"due_at":           in.DueAt,
+			"interval_days":    in.IntervalDays,
+			"ease":             in.Ease,
+			"repetitions":      in.Repetitions,
+			"lapses":           in.Lapses,
+			"last_reviewed_at": in.LastReviewedAt,
+		}).Error
+}
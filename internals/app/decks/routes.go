@@ -0,0 +1,27 @@
+package decks
+
+import (
+	"github.com/labstack/echo/v4"
+	"wakuwaku_nihongo/internals/middleware"
+)
+
+func (h *handler) Route(g *echo.Group) {
+	g.GET("", h.List, middleware.Authentication)
+	g.POST("", h.Create, middleware.Authentication)
+	g.GET("/public", h.ListPublic, middleware.Authentication)
+	g.POST("/import", h.Import, middleware.Authentication)
+	g.GET("/:id", h.Get, middleware.Authentication)
+	g.PUT("/:id", h.Update, middleware.Authentication)
+	g.DELETE("/:id", h.Delete, middleware.Authentication)
+	g.POST("/:id/clone", h.Clone, middleware.Authentication)
+	g.GET("/:id/export", h.Export, middleware.Authentication)
+	g.GET("/:id/cards", h.ListCards, middleware.Authentication)
+	g.POST("/:id/cards", h.CreateCard, middleware.Authentication)
+	g.PUT("/:id/cards/:card_id", h.UpdateCard, middleware.Authentication)
+	g.DELETE("/:id/cards/:card_id", h.DeleteCard, middleware.Authentication)
+}
+
+func (h *handler) ReviewRoute(g *echo.Group) {
+	g.GET("", h.Reviews, middleware.Authentication)
+	g.POST("/:card_id", h.Review, middleware.Authentication)
+}
@@ -0,0 +1,479 @@
+package decks
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"path"
+	"strings"
+	"time"
+
+	"wakuwaku_nihongo/internals/abstraction"
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/middleware"
+	"wakuwaku_nihongo/internals/pkg/apkg"
+	"wakuwaku_nihongo/internals/pkg/srs"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+type IDeckRepo interface {
+	List(ctx echo.Context, customerID string, public bool, filter *DeckFilter) (out []*Deck, count int64, err error)
+	GetVisible(ctx echo.Context, deckID string, customerID string) (out *Deck, err error)
+	Create(ctx echo.Context, in *Deck) (err error)
+	CreateWithCards(ctx echo.Context, in *Deck, cards []*Card) (err error)
+	Update(ctx echo.Context, in *Deck) (err error)
+	Delete(ctx echo.Context, deckID string, deletedBy string) (err error)
+	Clone(ctx echo.Context, sourceDeckID string, in *Deck) (err error)
+	ListCards(ctx echo.Context, deckID string, p *abstraction.Pagination) (out []*Card, count int64, err error)
+	ListAllCards(ctx echo.Context, deckID string) (out []*Card, err error)
+	GetOwnedCard(ctx echo.Context, cardID string, customerID string) (out *Card, err error)
+	CreateCard(ctx echo.Context, in *Card) (err error)
+	UpdateCard(ctx echo.Context, in *Card) (err error)
+	DeleteCard(ctx echo.Context, cardID string, deletedBy string) (err error)
+	ListDue(ctx echo.Context, customerID string, now int64, limit int) (out []*Card, count int64, err error)
+	SaveReview(ctx echo.Context, in *Card) (err error)
+}
+
+type service struct {
+	repo IDeckRepo
+}
+
+func NewService(f *factory.Factory) *service {
+	return &service{
+		repo: NewRepo(f.Db),
+	}
+}
+
+func (s *service) List(ctx echo.Context, filter *DeckFilter) (out []*DeckResponse, info *abstraction.PaginationInfo, err error) {
+	return s.list(ctx, false, filter)
+}
+
+func (s *service) ListPublic(ctx echo.Context, filter *DeckFilter) (out []*DeckResponse, info *abstraction.PaginationInfo, err error) {
+	return s.list(ctx, true, filter)
+}
+
+func (s *service) Get(ctx echo.Context, deckID string) (out *DeckResponse, err error) {
+	deck, err := s.getVisible(ctx, deckID)
+	if err != nil {
+		return
+	}
+	out = &DeckResponse{}
+	out.MapFromModel(deck)
+	return
+}
+
+func (s *service) Create(ctx echo.Context, in *DeckRequest) (out *DeckResponse, err error) {
+	userID := middleware.GetUserID(ctx)
+	deck := &Deck{
+		CreatedBy:   userID,
+		CustomerID:  userID,
+		Name:        strings.TrimSpace(in.Name),
+		Description: in.Description,
+		IsPublic:    in.IsPublic,
+	}
+	if err = s.repo.Create(ctx, deck); err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	out = &DeckResponse{}
+	out.MapFromModel(deck)
+	return
+}
+
+func (s *service) Update(ctx echo.Context, deckID string, in *DeckRequest) (out *DeckResponse, err error) {
+	deck, err := s.getOwned(ctx, deckID)
+	if err != nil {
+		return
+	}
+
+	now := time.Now().UnixMilli()
+	userID := middleware.GetUserID(ctx)
+	deck.Name = strings.TrimSpace(in.Name)
+	deck.Description = in.Description
+	deck.IsPublic = in.IsPublic
+	deck.ModifiedAt = &now
+	deck.ModifiedBy = &userID
+	if err = s.repo.Update(ctx, deck); err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	out = &DeckResponse{}
+	out.MapFromModel(deck)
+	return
+}
+
+func (s *service) Delete(ctx echo.Context, deckID string) (err error) {
+	if _, err = s.getOwned(ctx, deckID); err != nil {
+		return
+	}
+	if err = s.repo.Delete(ctx, deckID, middleware.GetUserID(ctx)); err != nil {
+		return response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	return
+}
+
+// Clone copies a public deck, or one of the customer's own, with its cards
+// into a private deck of the customer.
+func (s *service) Clone(ctx echo.Context, deckID string) (out *DeckResponse, err error) {
+	source, err := s.getVisible(ctx, deckID)
+	if err != nil {
+		return
+	}
+
+	userID := middleware.GetUserID(ctx)
+	deck := &Deck{
+		CreatedBy:    userID,
+		CustomerID:   userID,
+		Name:         source.Name,
+		Description:  source.Description,
+		SourceDeckID: &source.DeckID,
+		CardCount:    source.CardCount,
+	}
+	if err = s.repo.Clone(ctx, source.DeckID, deck); err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	out = &DeckResponse{}
+	out.MapFromModel(deck)
+	return
+}
+
+func (s *service) ListCards(ctx echo.Context, deckID string, p *abstraction.Pagination) (out []*CardResponse, info *abstraction.PaginationInfo, err error) {
+	if _, err = s.getVisible(ctx, deckID); err != nil {
+		return
+	}
+	cards, count, err := s.repo.ListCards(ctx, deckID, p)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	out = []*CardResponse{}
+	for _, c := range cards {
+		card := &CardResponse{}
+		card.MapFromModel(c)
+		out = append(out, card)
+	}
+	info = p.CreatePageInfo(count)
+	return
+}
+
+func (s *service) CreateCard(ctx echo.Context, deckID string, in *CardRequest) (out *CardResponse, err error) {
+	deck, err := s.getOwned(ctx, deckID)
+	if err != nil {
+		return
+	}
+	if deck.CardCount >= MAX_CARDS_PER_DECK {
+		err = response.ErrorWrap(response.ErrBadRequest, fmt.Errorf("a deck can hold at most %d cards", MAX_CARDS_PER_DECK))
+		return
+	}
+
+	userID := middleware.GetUserID(ctx)
+	card := &Card{
+		CreatedBy:  userID,
+		DeckID:     deck.DeckID,
+		CustomerID: userID,
+		Front:      strings.TrimSpace(in.Front),
+		Back:       strings.TrimSpace(in.Back),
+		Tags:       NormalizeTags(in.Tags),
+	}
+	if err = s.repo.CreateCard(ctx, card); err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	out = &CardResponse{}
+	out.MapFromModel(card)
+	return
+}
+
+func (s *service) UpdateCard(ctx echo.Context, deckID string, cardID string, in *CardRequest) (out *CardResponse, err error) {
+	card, err := s.getOwnedCard(ctx, deckID, cardID)
+	if err != nil {
+		return
+	}
+
+	now := time.Now().UnixMilli()
+	userID := middleware.GetUserID(ctx)
+	card.Front = strings.TrimSpace(in.Front)
+	card.Back = strings.TrimSpace(in.Back)
+	card.Tags = NormalizeTags(in.Tags)
+	card.ModifiedAt = &now
+	card.ModifiedBy = &userID
+	if err = s.repo.UpdateCard(ctx, card); err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	out = &CardResponse{}
+	out.MapFromModel(card)
+	return
+}
+
+func (s *service) DeleteCard(ctx echo.Context, deckID string, cardID string) (err error) {
+	if _, err = s.getOwnedCard(ctx, deckID, cardID); err != nil {
+		return
+	}
+	if err = s.repo.DeleteCard(ctx, cardID, middleware.GetUserID(ctx)); err != nil {
+		return response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	return
+}
+
+// Import creates a deck from a CSV, TSV or Anki package. An Anki package
+// names the deck unless a name is given, other files are named after the
+// file.
+func (s *service) Import(ctx echo.Context, file *multipart.FileHeader, in *ImportRequest) (out *ImportResponse, err error) {
+	if file.Size > MAX_IMPORT_SIZE {
+		err = response.ErrorWrap(response.ErrValidation, fmt.Errorf("file exceeds the maximum size of %d bytes", MAX_IMPORT_SIZE))
+		return
+	}
+	format := in.Format
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(path.Ext(file.Filename)), ".")
+	}
+	if format == "txt" {
+		format = FORMAT_TSV
+	}
+	if _, ok := EXPORT_CONTENT_TYPES[format]; !ok {
+		err = response.ErrorWrap(response.ErrValidation, fmt.Errorf("unsupported format, allowed are csv, tsv and apkg"))
+		return
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		err = response.ErrorWrap(response.ErrBadRequest, err)
+		return
+	}
+	defer src.Close()
+
+	name := strings.TrimSuffix(path.Base(file.Filename), path.Ext(file.Filename))
+	var description *string
+	var fields []*CardFields
+	var skipped int
+	if format == FORMAT_APKG {
+		pkg, readErr := apkg.Read(src, file.Size)
+		if readErr != nil {
+			err = response.ErrorWrap(response.ErrValidation, readErr)
+			return
+		}
+		if pkg.Name != "" {
+			name = pkg.Name
+		}
+		if pkg.Description != "" {
+			description = &pkg.Description
+		}
+		fields, skipped = FromNotes(pkg.Notes)
+	} else {
+		var parseErr error
+		fields, skipped, parseErr = ParseDelimited(src, format)
+		if parseErr != nil {
+			err = response.ErrorWrap(response.ErrValidation, fmt.Errorf("invalid %s: %w", format, parseErr))
+			return
+		}
+	}
+	if len(fields) > MAX_CARDS_PER_DECK {
+		err = response.ErrorWrap(response.ErrValidation, fmt.Errorf("a deck can hold at most %d cards", MAX_CARDS_PER_DECK))
+		return
+	}
+	if in.Name != "" {
+		name = in.Name
+	}
+	if name = strings.TrimSpace(name); name == "" {
+		name = "Imported deck"
+	}
+
+	userID := middleware.GetUserID(ctx)
+	deck := &Deck{
+		CreatedBy:   userID,
+		CustomerID:  userID,
+		Name:        name,
+		Description: description,
+		IsPublic:    in.IsPublic,
+		CardCount:   int64(len(fields)),
+	}
+	cards := make([]*Card, 0, len(fields))
+	for _, f := range fields {
+		cards = append(cards, &Card{
+			CreatedBy:  userID,
+			CustomerID: userID,
+			Front:      f.Front,
+			Back:       f.Back,
+			Tags:       NormalizeTags(f.Tags),
+		})
+	}
+	if err = s.repo.CreateWithCards(ctx, deck, cards); err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	out = &ImportResponse{Deck: &DeckResponse{}, Imported: len(cards), Skipped: skipped}
+	out.Deck.MapFromModel(deck)
+	return
+}
+
+// Export writes a deck the customer can see as CSV, TSV or an Anki package
+// and returns it with its file name. Review schedules are not exported.
+func (s *service) Export(ctx echo.Context, deckID string, format string) (fileName string, data []byte, err error) {
+	deck, err := s.getVisible(ctx, deckID)
+	if err != nil {
+		return
+	}
+	cards, err := s.repo.ListAllCards(ctx, deckID)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	var buf bytes.Buffer
+	if format == FORMAT_APKG {
+		pkg := &apkg.Deck{Name: deck.Name, Notes: ToNotes(cards)}
+		if deck.Description != nil {
+			pkg.Description = *deck.Description
+		}
+		err = apkg.Write(&buf, pkg)
+	} else {
+		err = WriteDelimited(&buf, format, cards)
+	}
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	return FileName(deck.Name, format), buf.Bytes(), nil
+}
+
+// Reviews returns the customer's cards that are due, across their decks.
+func (s *service) Reviews(ctx echo.Context, filter *ReviewFilter) (out *ReviewQueueResponse, err error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = DEFAULT_REVIEW_LIMIT
+	}
+	cards, count, err := s.repo.ListDue(ctx, middleware.GetUserID(ctx), time.Now().UnixMilli(), limit)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	out = &ReviewQueueResponse{Due: count, Cards: []*CardResponse{}}
+	for _, c := range cards {
+		card := &CardResponse{}
+		card.MapFromModel(c)
+		out.Cards = append(out.Cards, card)
+	}
+	return
+}
+
+// Review schedules a card of the customer after it was answered.
+func (s *service) Review(ctx echo.Context, cardID string, in *ReviewRequest) (out *CardResponse, err error) {
+	card, err := s.repo.GetOwnedCard(ctx, cardID, middleware.GetUserID(ctx))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("card not found"))
+		return
+	}
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	card.Review(srs.Rating(in.Rating), time.Now())
+	if err = s.repo.SaveReview(ctx, card); err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	out = &CardResponse{}
+	out.MapFromModel(card)
+	return
+}
+
+func (s *service) list(ctx echo.Context, public bool, filter *DeckFilter) (out []*DeckResponse, info *abstraction.PaginationInfo, err error) {
+	decks, count, err := s.repo.List(ctx, middleware.GetUserID(ctx), public, filter)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	out = []*DeckResponse{}
+	for _, d := range decks {
+		deck := &DeckResponse{}
+		deck.MapFromModel(d)
+		out = append(out, deck)
+	}
+	info = filter.Pagination.CreatePageInfo(count)
+	return
+}
+
+func (s *service) getVisible(ctx echo.Context, deckID string) (out *Deck, err error) {
+	out, err = s.repo.GetVisible(ctx, deckID, middleware.GetUserID(ctx))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("deck not found"))
+		return
+	}
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	return
+}
+
+// getOwned returns a deck only its owner may change, a public deck of
+// someone else is forbidden.
+func (s *service) getOwned(ctx echo.Context, deckID string) (out *Deck, err error) {
+	out, err = s.getVisible(ctx, deckID)
+	if err != nil {
+		return
+	}
+	if out.CustomerID != middleware.GetUserID(ctx) {
+		return nil, response.ErrorWrap(response.ErrForbidden, fmt.Errorf("only the owner can change a deck"))
+	}
+	return
+}
+
+func (s *service) getOwnedCard(ctx echo.Context, deckID string, cardID string) (out *Card, err error) {
+	if _, err = s.getOwned(ctx, deckID); err != nil {
+		return
+	}
+	out, err = s.repo.GetOwnedCard(ctx, cardID, middleware.GetUserID(ctx))
+	if err == nil && out.DeckID != deckID {
+		err = gorm.ErrRecordNotFound
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("card not found"))
+		return
+	}
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	return
+}
+
+// NormalizeTags trims tags, joins the words of a tag with underscores as
+// Anki does and drops empty and repeated ones.
+func NormalizeTags(tags []string) []string {
+	seen := map[string]bool{}
+	out := []string{}
+	for _, t := range tags {
+		t = strings.Join(strings.Fields(t), "_")
+		if t == "" || seen[t] {
+			continue
+		}
+		seen[t] = true
+		out = append(out, t)
+	}
+	return out
+}
+
+// FileName names an exported deck after it, keeping letters, digits and
+// a few safe characters.
+func FileName(name string, format string) string {
+	safe := strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`/\:*?"<>|`, r) || r < 0x20 {
+			return '_'
+		}
+		return r
+	}, strings.TrimSpace(name))
+	if safe == "" {
+		safe = "deck"
+	}
+	return safe + "." + format
+}
@@ -0,0 +1,90 @@
+package tests
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"wakuwaku_nihongo/internals/app/decks"
+	"wakuwaku_nihongo/internals/pkg/srs"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDelimitedCSV(t *testing.T) {
+	in := "\ufefffront,back,tags\n" +
+		"猫,cat,animal n5\n" +
+		"\"犬, いぬ\",dog\n" +
+		",no front\n" +
+		"水\n"
+
+	out, skipped, err := decks.ParseDelimited(strings.NewReader(in), decks.FORMAT_CSV)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, skipped)
+	assert.Len(t, out, 3)
+	assert.Equal(t, &decks.CardFields{Front: "猫", Back: "cat", Tags: []string{"animal", "n5"}}, out[0])
+	assert.Equal(t, "犬, いぬ", out[1].Front)
+	assert.Equal(t, "dog", out[1].Back)
+	assert.Equal(t, "水", out[2].Front)
+	assert.Empty(t, out[2].Back)
+}
+
+func TestParseDelimitedTSVComments(t *testing.T) {
+	in := "#separator:tab\n#html:false\n食べる\tto eat\n飲む\tto drink\tverb\n"
+
+	out, skipped, err := decks.ParseDelimited(strings.NewReader(in), decks.FORMAT_TSV)
+	assert.NoError(t, err)
+	assert.Zero(t, skipped)
+	assert.Len(t, out, 2)
+	assert.Equal(t, "食べる", out[0].Front)
+	assert.Equal(t, "to eat", out[0].Back)
+	assert.Equal(t, []string{"verb"}, out[1].Tags)
+}
+
+func TestWriteDelimitedRoundTrip(t *testing.T) {
+	cards := []*decks.Card{
+		{Front: "猫", Back: "cat", Tags: []string{"animal"}},
+		{Front: "\"quoted\", front", Back: "line\nbreak"},
+	}
+
+	for _, format := range []string{decks.FORMAT_CSV, decks.FORMAT_TSV} {
+		var buf bytes.Buffer
+		assert.NoError(t, decks.WriteDelimited(&buf, format, cards))
+
+		out, skipped, err := decks.ParseDelimited(&buf, format)
+		assert.NoError(t, err)
+		assert.Zero(t, skipped)
+		assert.Len(t, out, 2)
+		assert.Equal(t, "猫", out[0].Front)
+		assert.Equal(t, []string{"animal"}, out[0].Tags)
+		assert.Equal(t, "\"quoted\", front", out[1].Front)
+		assert.Equal(t, "line\nbreak", out[1].Back)
+	}
+}
+
+func TestNormalizeTags(t *testing.T) {
+	assert.Equal(t, []string{"jlpt_n5", "verb"}, decks.NormalizeTags([]string{" jlpt  n5 ", "verb", "", "verb"}))
+	assert.Equal(t, []string{}, decks.NormalizeTags(nil))
+}
+
+func TestFileName(t *testing.T) {
+	assert.Equal(t, "N5_verbs.csv", decks.FileName("N5/verbs", decks.FORMAT_CSV))
+	assert.Equal(t, "deck.apkg", decks.FileName("  ", decks.FORMAT_APKG))
+}
+
+func TestCardReview(t *testing.T) {
+	now := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	card := &decks.Card{Ease: srs.DEFAULT_EASE, DueAt: now.UnixMilli()}
+
+	card.Review(srs.GOOD, now)
+	assert.Equal(t, 1, card.IntervalDays)
+	assert.Equal(t, 1, card.Repetitions)
+	assert.Equal(t, now.AddDate(0, 0, 1).UnixMilli(), card.DueAt)
+	assert.Equal(t, now.UnixMilli(), *card.LastReviewedAt)
+
+	card.Review(srs.AGAIN, now)
+	assert.Equal(t, 1, card.Lapses)
+	assert.Zero(t, card.Repetitions)
+	assert.Equal(t, now.Add(srs.RELEARN_DELAY).UnixMilli(), card.DueAt)
+}
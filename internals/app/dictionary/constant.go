@@ -0,0 +1,8 @@
+package dictionary
+
+const (
+	DEFAULT_GLOSS_LANG = "eng"
+	IMPORT_BATCH_SIZE  = 500
+	IMPORTER_ACTOR     = "jmdict-importer"
+	TAG_SEPARATOR      = ","
+)
@@ -0,0 +1,57 @@
+package dictionary
+
+import (
+	"wakuwaku_nihongo/internals/abstraction"
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+type IDictionaryService interface {
+	Lookup(ctx echo.Context, in *LookupRequest) (out []*EntryResponse, info *abstraction.PaginationInfo, err error)
+}
+
+type handler struct {
+	service IDictionaryService
+}
+
+func NewHandler(f *factory.Factory) *handler {
+	return &handler{
+		service: NewService(f),
+	}
+}
+
+// @Summary Dictionary Lookup
+// @Description Look up JMdict entries by kanji, kana or gloss
+// @Tags dictionary
+// @Produce json
+// @Param q query string true "Kanji, kana or gloss"
+// @Param lang query string false "Gloss language (default eng)"
+// @Param page query int false "Page"
+// @Param page_size query int false "Page size"
+// @Success 200 {object} response.SuccessResponseWithInfo{data=[]EntryResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/dictionary [get]
+func (h *handler) Lookup(c echo.Context) error {
+	req := &LookupRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+	req.Pagination.SetDefault()
+
+	res, info, err := h.service.Lookup(c, req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+
+	return response.SuccessResponseInfo(res, info).Send(c)
+}
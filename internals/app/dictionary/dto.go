@@ -0,0 +1,104 @@
+package dictionary
+
+import (
+	"strings"
+
+	"wakuwaku_nihongo/internals/abstraction"
+)
+
+type LookupRequest struct {
+	Query string `query:"q" validate:"required"`
+	Lang  string `query:"lang" example:"eng"`
+	abstraction.Pagination
+}
+
+type EntryResponse struct {
+	EntSeq   int64              `json:"ent_seq"`
+	Kanji    []*KanjiResponse   `json:"kanji"`
+	Readings []*ReadingResponse `json:"readings"`
+	Senses   []*SenseResponse   `json:"senses"`
+}
+
+type KanjiResponse struct {
+	Text     string   `json:"text"`
+	Info     []string `json:"info"`
+	Priority []string `json:"priority"`
+}
+
+type ReadingResponse struct {
+	Text      string   `json:"text"`
+	NoKanji   bool     `json:"no_kanji"`
+	AppliesTo []string `json:"applies_to"`
+	Info      []string `json:"info"`
+	Priority  []string `json:"priority"`
+}
+
+type SenseResponse struct {
+	PartsOfSpeech []string `json:"parts_of_speech"`
+	Fields        []string `json:"fields"`
+	Misc          []string `json:"misc"`
+	Dialects      []string `json:"dialects"`
+	Info          *string  `json:"info"`
+	Glosses       []string `json:"glosses"`
+}
+
+func (e *EntryResponse) MapFromModel(entry *DictionaryEntry) {
+	e.EntSeq = entry.EntSeq
+
+	kanjiTexts := map[string]string{}
+	e.Kanji = []*KanjiResponse{}
+	for _, k := range entry.Kanji {
+		kanjiTexts[k.KanjiID] = k.Text
+		e.Kanji = append(e.Kanji, &KanjiResponse{
+			Text:     k.Text,
+			Info:     splitTags(k.Info),
+			Priority: splitTags(k.Priority),
+		})
+	}
+
+	e.Readings = []*ReadingResponse{}
+	for _, r := range entry.Readings {
+		reading := &ReadingResponse{
+			Text:      r.Text,
+			NoKanji:   r.NoKanji,
+			AppliesTo: []string{},
+			Info:      splitTags(r.Info),
+			Priority:  splitTags(r.Priority),
+		}
+		switch {
+		case r.NoKanji:
+		case len(r.Restrictions) > 0:
+			for _, restr := range r.Restrictions {
+				reading.AppliesTo = append(reading.AppliesTo, kanjiTexts[restr.KanjiID])
+			}
+		default:
+			for _, k := range entry.Kanji {
+				reading.AppliesTo = append(reading.AppliesTo, k.Text)
+			}
+		}
+		e.Readings = append(e.Readings, reading)
+	}
+
+	e.Senses = []*SenseResponse{}
+	for _, s := range entry.Senses {
+		sense := &SenseResponse{
+			PartsOfSpeech: splitTags(s.PartsOfSpeech),
+			Fields:        splitTags(s.Fields),
+			Misc:          splitTags(s.Misc),
+			Dialects:      splitTags(s.Dialects),
+			Info:          s.Info,
+			Glosses:       []string{},
+		}
+		for _, g := range s.Glosses {
+			sense.Glosses = append(sense.Glosses, g.Text)
+		}
+		e.Senses = append(e.Senses, sense)
+	}
+}
+
+func splitTags(s *string) []string {
+	if s == nil || *s == "" {
+		return []string{}
+	}
+	return strings.Split(*s, TAG_SEPARATOR)
+}
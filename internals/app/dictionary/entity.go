@@ -0,0 +1,134 @@
+package dictionary
+
+import (
+	"time"
+
+	"wakuwaku_nihongo/internals/pkg/softdelete"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type Release struct {
+	ReleaseID      string               `gorm:"column:release_id;type:uuid;primaryKey" json:"release_id"`
+	CreatedAt      int64                `gorm:"column:created_at;type:bigint;not null" json:"created_at"`
+	ModifiedAt     *int64               `gorm:"column:modified_at;type:bigint" json:"modified_at"`
+	DeletedAt      softdelete.DeletedAt `gorm:"column:deleted_at;type:bigint" json:"deleted_at"`
+	CreatedBy      string               `gorm:"column:created_by;type:character varying;not null" json:"created_by"`
+	ModifiedBy     *string              `gorm:"column:modified_by;type:character varying" json:"modified_by"`
+	DeletedBy      *string              `gorm:"column:deleted_by;type:character varying" json:"deleted_by"`
+	Source         string               `gorm:"column:source;type:character varying;not null" json:"source"`
+	ReleaseDate    *string              `gorm:"column:release_date;type:character varying" json:"release_date"`
+	AddedCount     int                  `gorm:"column:added_count;type:integer;not null" json:"added_count"`
+	UpdatedCount   int                  `gorm:"column:updated_count;type:integer;not null" json:"updated_count"`
+	UnchangedCount int                  `gorm:"column:unchanged_count;type:integer;not null" json:"unchanged_count"`
+	RemovedCount   int                  `gorm:"column:removed_count;type:integer;not null" json:"removed_count"`
+}
+
+func (*Release) TableName() string {
+	return "dictionary_releases"
+}
+
+func (m *Release) BeforeCreate(tx *gorm.DB) (err error) {
+	m.CreatedAt = time.Now().UnixMilli()
+	if m.ReleaseID == "" {
+		m.ReleaseID = uuid.NewString()
+	}
+	return
+}
+
+// Tag is a JMdict entity such as "n" or "v5r", stored by name together with
+// its expansion from the DTD.
+type Tag struct {
+	Name        string `gorm:"column:name;type:character varying;primaryKey" json:"name"`
+	Description string `gorm:"column:description;type:character varying;not null" json:"description"`
+}
+
+func (*Tag) TableName() string {
+	return "dictionary_tags"
+}
+
+type DictionaryEntry struct {
+	EntSeq      int64                `gorm:"column:ent_seq;type:bigint;primaryKey;autoIncrement:false" json:"ent_seq"`
+	CreatedAt   int64                `gorm:"column:created_at;type:bigint;not null" json:"created_at"`
+	ModifiedAt  *int64               `gorm:"column:modified_at;type:bigint" json:"modified_at"`
+	DeletedAt   softdelete.DeletedAt `gorm:"column:deleted_at;type:bigint" json:"deleted_at"`
+	CreatedBy   string               `gorm:"column:created_by;type:character varying;not null" json:"created_by"`
+	ModifiedBy  *string              `gorm:"column:modified_by;type:character varying" json:"modified_by"`
+	DeletedBy   *string              `gorm:"column:deleted_by;type:character varying" json:"deleted_by"`
+	ContentHash string               `gorm:"column:content_hash;type:character varying;not null" json:"-"`
+	Kanji       []*Kanji             `gorm:"foreignKey:ent_seq;references:ent_seq" json:"kanji"`
+	Readings    []*Reading           `gorm:"foreignKey:ent_seq;references:ent_seq" json:"readings"`
+	Senses      []*Sense             `gorm:"foreignKey:ent_seq;references:ent_seq" json:"senses"`
+}
+
+func (*DictionaryEntry) TableName() string {
+	return "dictionary_entries"
+}
+
+type Kanji struct {
+	KanjiID  string  `gorm:"column:kanji_id;type:uuid;primaryKey" json:"kanji_id"`
+	EntSeq   int64   `gorm:"column:ent_seq;type:bigint;not null" json:"ent_seq"`
+	Position int     `gorm:"column:position;type:integer;not null" json:"position"`
+	Text     string  `gorm:"column:text;type:character varying;not null" json:"text"`
+	Info     *string `gorm:"column:info;type:character varying" json:"info"`
+	Priority *string `gorm:"column:priority;type:character varying" json:"priority"`
+}
+
+func (*Kanji) TableName() string {
+	return "dictionary_kanji"
+}
+
+type Reading struct {
+	ReadingID    string                `gorm:"column:reading_id;type:uuid;primaryKey" json:"reading_id"`
+	EntSeq       int64                 `gorm:"column:ent_seq;type:bigint;not null" json:"ent_seq"`
+	Position     int                   `gorm:"column:position;type:integer;not null" json:"position"`
+	Text         string                `gorm:"column:text;type:character varying;not null" json:"text"`
+	NoKanji      bool                  `gorm:"column:no_kanji;type:boolean;not null" json:"no_kanji"`
+	Info         *string               `gorm:"column:info;type:character varying" json:"info"`
+	Priority     *string               `gorm:"column:priority;type:character varying" json:"priority"`
+	Restrictions []*ReadingRestriction `gorm:"foreignKey:reading_id;references:reading_id" json:"restrictions"`
+}
+
+func (*Reading) TableName() string {
+	return "dictionary_readings"
+}
+
+// ReadingRestriction limits a reading to a subset of the entry's kanji forms
+// (JMdict <re_restr>).
+type ReadingRestriction struct {
+	ReadingID string `gorm:"column:reading_id;type:uuid;primaryKey" json:"reading_id"`
+	KanjiID   string `gorm:"column:kanji_id;type:uuid;primaryKey" json:"kanji_id"`
+}
+
+func (*ReadingRestriction) TableName() string {
+	return "dictionary_reading_restrictions"
+}
+
+type Sense struct {
+	SenseID       string   `gorm:"column:sense_id;type:uuid;primaryKey" json:"sense_id"`
+	EntSeq        int64    `gorm:"column:ent_seq;type:bigint;not null" json:"ent_seq"`
+	Position      int      `gorm:"column:position;type:integer;not null" json:"position"`
+	PartsOfSpeech *string  `gorm:"column:parts_of_speech;type:character varying" json:"parts_of_speech"`
+	Fields        *string  `gorm:"column:fields;type:character varying" json:"fields"`
+	Misc          *string  `gorm:"column:misc;type:character varying" json:"misc"`
+	Dialects      *string  `gorm:"column:dialects;type:character varying" json:"dialects"`
+	Info          *string  `gorm:"column:info;type:character varying" json:"info"`
+	Glosses       []*Gloss `gorm:"foreignKey:sense_id;references:sense_id" json:"glosses"`
+}
+
+func (*Sense) TableName() string {
+	return "dictionary_senses"
+}
+
+type Gloss struct {
+	GlossID  string `gorm:"column:gloss_id;type:uuid;primaryKey" json:"gloss_id"`
+	SenseID  string `gorm:"column:sense_id;type:uuid;not null" json:"sense_id"`
+	Position int    `gorm:"column:position;type:integer;not null" json:"position"`
+	Lang     string `gorm:"column:lang;type:character varying;not null" json:"lang"`
+	Text     string `gorm:"column:text;type:character varying;not null" json:"text"`
+}
+
+func (*Gloss) TableName() string {
+	return "dictionary_glosses"
+}
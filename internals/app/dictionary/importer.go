@@ -0,0 +1,238 @@
+package dictionary
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+type IImportRepo interface {
+	GetEntryHashes() (out map[int64]EntryState, err error)
+	SaveEntries(entries []*DictionaryEntry, restrictions []*ReadingRestriction) (err error)
+	SaveTags(tags []*Tag) (err error)
+	RemoveEntries(entSeqs []int64) (err error)
+	CreateRelease(in *Release) (err error)
+}
+
+// EntryState is what the importer needs to know about an already stored entry
+// to decide whether it changed.
+type EntryState struct {
+	ContentHash string
+	Deleted     bool
+}
+
+type ImportResult struct {
+	ReleaseDate string `json:"release_date"`
+	Added       int    `json:"added"`
+	Updated     int    `json:"updated"`
+	Unchanged   int    `json:"unchanged"`
+	Removed     int    `json:"removed"`
+}
+
+// Importer loads a JMdict release into the dictionary tables. Re-running it
+// with a newer release only rewrites the entries whose content changed and
+// soft deletes the entries that disappeared.
+type Importer struct {
+	repo IImportRepo
+}
+
+func NewImporter(db *gorm.DB) *Importer {
+	return &Importer{
+		repo: NewRepo(db),
+	}
+}
+
+func (i *Importer) Import(source string, r io.Reader) (out *ImportResult, err error) {
+	existing, err := i.repo.GetEntryHashes()
+	if err != nil {
+		return
+	}
+
+	out = &ImportResult{}
+	seen := make(map[int64]bool, len(existing))
+	batch := []*JMdictEntry{}
+	hashes := map[int64]string{}
+
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		entries, restrictions := mapEntries(batch, hashes)
+		if err := i.repo.SaveEntries(entries, restrictions); err != nil {
+			return err
+		}
+		batch = batch[:0]
+		hashes = map[int64]string{}
+		return nil
+	}
+
+	parser := NewParser(r)
+	for {
+		entry, perr := parser.Next()
+		if errors.Is(perr, io.EOF) {
+			break
+		}
+		if perr != nil {
+			err = perr
+			return
+		}
+
+		seen[entry.EntSeq] = true
+		hash := entry.Hash()
+		state, ok := existing[entry.EntSeq]
+		switch {
+		case !ok:
+			out.Added++
+		case state.ContentHash == hash && !state.Deleted:
+			out.Unchanged++
+			continue
+		default:
+			out.Updated++
+		}
+
+		batch = append(batch, entry)
+		hashes[entry.EntSeq] = hash
+		if len(batch) >= IMPORT_BATCH_SIZE {
+			if err = flush(); err != nil {
+				return
+			}
+		}
+	}
+	if err = flush(); err != nil {
+		return
+	}
+
+	tags := []*Tag{}
+	for name, description := range parser.Tags() {
+		tags = append(tags, &Tag{Name: name, Description: description})
+	}
+	if err = i.repo.SaveTags(tags); err != nil {
+		return
+	}
+
+	removed := []int64{}
+	for entSeq, state := range existing {
+		if !seen[entSeq] && !state.Deleted {
+			removed = append(removed, entSeq)
+		}
+	}
+	if err = i.repo.RemoveEntries(removed); err != nil {
+		return
+	}
+	out.Removed = len(removed)
+	out.ReleaseDate = parser.ReleaseDate()
+
+	release := &Release{
+		CreatedBy:      IMPORTER_ACTOR,
+		Source:         source,
+		AddedCount:     out.Added,
+		UpdatedCount:   out.Updated,
+		UnchangedCount: out.Unchanged,
+		RemovedCount:   out.Removed,
+	}
+	if out.ReleaseDate != "" {
+		release.ReleaseDate = &out.ReleaseDate
+	}
+	if err = i.repo.CreateRelease(release); err != nil {
+		return
+	}
+
+	log.Info().Str("source", source).Interface("result", out).Msg("jmdict import finished")
+	return out, nil
+}
+
+// mapEntries converts parsed entries into rows, resolving <re_restr> kanji
+// texts into kanji ids of the same entry.
+func mapEntries(in []*JMdictEntry, hashes map[int64]string) (entries []*DictionaryEntry, restrictions []*ReadingRestriction) {
+	for _, e := range in {
+		entry := &DictionaryEntry{
+			EntSeq:      e.EntSeq,
+			CreatedBy:   IMPORTER_ACTOR,
+			ContentHash: hashes[e.EntSeq],
+		}
+
+		kanjiIDs := map[string]string{}
+		for pos, k := range e.Kanji {
+			kanji := &Kanji{
+				KanjiID:  uuid.NewString(),
+				EntSeq:   e.EntSeq,
+				Position: pos,
+				Text:     k.Text,
+				Info:     joinTags(k.Info),
+				Priority: joinTags(k.Priority),
+			}
+			kanjiIDs[k.Text] = kanji.KanjiID
+			entry.Kanji = append(entry.Kanji, kanji)
+		}
+
+		for pos, r := range e.Readings {
+			reading := &Reading{
+				ReadingID: uuid.NewString(),
+				EntSeq:    e.EntSeq,
+				Position:  pos,
+				Text:      r.Text,
+				NoKanji:   r.NoKanji != nil,
+				Info:      joinTags(r.Info),
+				Priority:  joinTags(r.Priority),
+			}
+			for _, restr := range r.Restrictions {
+				kanjiID, ok := kanjiIDs[restr]
+				if !ok {
+					log.Warn().Int64("ent_seq", e.EntSeq).Str("re_restr", restr).Msg("reading restricted to unknown kanji")
+					continue
+				}
+				restrictions = append(restrictions, &ReadingRestriction{
+					ReadingID: reading.ReadingID,
+					KanjiID:   kanjiID,
+				})
+			}
+			entry.Readings = append(entry.Readings, reading)
+		}
+
+		for pos, s := range e.Senses {
+			sense := &Sense{
+				SenseID:       uuid.NewString(),
+				EntSeq:        e.EntSeq,
+				Position:      pos,
+				PartsOfSpeech: joinTags(s.PartsOfSpeech),
+				Fields:        joinTags(s.Fields),
+				Misc:          joinTags(s.Misc),
+				Dialects:      joinTags(s.Dialects),
+				Info:          joinText(s.Info),
+			}
+			for gpos, g := range s.Glosses {
+				sense.Glosses = append(sense.Glosses, &Gloss{
+					GlossID:  uuid.NewString(),
+					SenseID:  sense.SenseID,
+					Position: gpos,
+					Lang:     g.Lang,
+					Text:     g.Text,
+				})
+			}
+			entry.Senses = append(entry.Senses, sense)
+		}
+
+		entries = append(entries, entry)
+	}
+	return
+}
+
+func joinTags(tags []string) *string {
+	if len(tags) == 0 {
+		return nil
+	}
+	s := strings.Join(tags, TAG_SEPARATOR)
+	return &s
+}
+
+func joinText(texts []string) *string {
+	if len(texts) == 0 {
+		return nil
+	}
+	s := strings.Join(texts, "; ")
+	return &s
+}
@@ -0,0 +1,145 @@
+package dictionary
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"regexp"
+	"strings"
+)
+
+var (
+	entityDeclRegex  = regexp.MustCompile(`<!ENTITY\s+(\S+)\s+"([^"]*)"\s*>`)
+	releaseDateRegex = regexp.MustCompile(`JMdict created:\s*(\S+)`)
+)
+
+// JMdictEntry is one <entry> element of the JMdict file. Entity references
+// such as &n; are kept as their bare name ("n"), the expansion lives in Tags.
+type JMdictEntry struct {
+	EntSeq   int64           `xml:"ent_seq"`
+	Kanji    []JMdictKanji   `xml:"k_ele"`
+	Readings []JMdictReading `xml:"r_ele"`
+	Senses   []JMdictSense   `xml:"sense"`
+}
+
+type JMdictKanji struct {
+	Text     string   `xml:"keb"`
+	Info     []string `xml:"ke_inf"`
+	Priority []string `xml:"ke_pri"`
+}
+
+type JMdictReading struct {
+	Text         string    `xml:"reb"`
+	NoKanji      *struct{} `xml:"re_nokanji"`
+	Restrictions []string  `xml:"re_restr"`
+	Info         []string  `xml:"re_inf"`
+	Priority     []string  `xml:"re_pri"`
+}
+
+type JMdictSense struct {
+	PartsOfSpeech []string      `xml:"pos"`
+	Fields        []string      `xml:"field"`
+	Misc          []string      `xml:"misc"`
+	Dialects      []string      `xml:"dial"`
+	Info          []string      `xml:"s_inf"`
+	Glosses       []JMdictGloss `xml:"gloss"`
+}
+
+type JMdictGloss struct {
+	Lang string `xml:"http://www.w3.org/XML/1998/namespace lang,attr"`
+	Text string `xml:",chardata"`
+}
+
+// Hash returns a stable fingerprint of the entry content, used to skip
+// unchanged entries when a new release is imported.
+func (e *JMdictEntry) Hash() string {
+	b, _ := json.Marshal(e)
+	sum := sha1.Sum(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// Parser streams entries out of a JMdict XML document without loading the
+// whole file into memory.
+type Parser struct {
+	decoder     *xml.Decoder
+	tags        map[string]string
+	releaseDate string
+}
+
+func NewParser(r io.Reader) *Parser {
+	d := xml.NewDecoder(r)
+	d.Entity = map[string]string{}
+	return &Parser{
+		decoder: d,
+		tags:    map[string]string{},
+	}
+}
+
+// Tags returns the entity declarations read from the DTD so far.
+func (p *Parser) Tags() map[string]string {
+	return p.tags
+}
+
+// ReleaseDate returns the date from the "JMdict created" comment, if seen.
+func (p *Parser) ReleaseDate() string {
+	return p.releaseDate
+}
+
+// Next returns the next entry, or io.EOF once the document is exhausted.
+func (p *Parser) Next() (*JMdictEntry, error) {
+	for {
+		tok, err := p.decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := tok.(type) {
+		case xml.Directive:
+			p.readEntities(string(t))
+		case xml.Comment:
+			if m := releaseDateRegex.FindStringSubmatch(string(t)); m != nil {
+				p.releaseDate = m[1]
+			}
+		case xml.StartElement:
+			if t.Name.Local != "entry" {
+				continue
+			}
+			entry := &JMdictEntry{}
+			if err := p.decoder.DecodeElement(entry, &t); err != nil {
+				return nil, err
+			}
+			entry.normalize()
+			return entry, nil
+		}
+	}
+}
+
+func (p *Parser) readEntities(directive string) {
+	for _, m := range entityDeclRegex.FindAllStringSubmatch(directive, -1) {
+		p.tags[m[1]] = m[2]
+		p.decoder.Entity[m[1]] = m[1]
+	}
+}
+
+// normalize applies the JMdict conventions that are implicit in the file:
+// a sense without <pos> inherits the parts of speech of the previous sense
+// and a gloss without xml:lang is English.
+func (e *JMdictEntry) normalize() {
+	var lastPOS []string
+	for i := range e.Senses {
+		s := &e.Senses[i]
+		if len(s.PartsOfSpeech) == 0 {
+			s.PartsOfSpeech = lastPOS
+		} else {
+			lastPOS = s.PartsOfSpeech
+		}
+		for j := range s.Glosses {
+			if s.Glosses[j].Lang == "" {
+				s.Glosses[j].Lang = DEFAULT_GLOSS_LANG
+			}
+			s.Glosses[j].Text = strings.TrimSpace(s.Glosses[j].Text)
+		}
+	}
+}
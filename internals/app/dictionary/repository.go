@@ -0,0 +1,180 @@
+package dictionary
+
+import (
+	"strings"
+	"time"
+
+	"wakuwaku_nihongo/internals/abstraction"
+	"wakuwaku_nihongo/internals/pkg/softdelete"
+	"wakuwaku_nihongo/internals/pkg/sqlutil"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type repo struct {
+	*gorm.DB
+}
+
+func NewRepo(db *gorm.DB) *repo {
+	return &repo{DB: db}
+}
+
+func (r *repo) GetEntryHashes() (out map[int64]EntryState, err error) {
+	rows := []struct {
+		EntSeq      int64
+		ContentHash string
+		DeletedAt   *int64
+	}{}
+	res := r.DB.Unscoped().Model(&DictionaryEntry{}).Select("ent_seq", "content_hash", "deleted_at").Find(&rows)
+	if res.Error != nil {
+		err = res.Error
+		return
+	}
+
+	out = make(map[int64]EntryState, len(rows))
+	for _, row := range rows {
+		out[row.EntSeq] = EntryState{
+			ContentHash: row.ContentHash,
+			Deleted:     row.DeletedAt != nil,
+		}
+	}
+	return
+}
+
+// SaveEntries replaces the given entries and all of their children.
+func (r *repo) SaveEntries(entries []*DictionaryEntry, restrictions []*ReadingRestriction) (err error) {
+	now := time.Now().UnixMilli()
+	entSeqs := []int64{}
+	kanji := []*Kanji{}
+	readings := []*Reading{}
+	senses := []*Sense{}
+	glosses := []*Gloss{}
+	for _, e := range entries {
+		entSeqs = append(entSeqs, e.EntSeq)
+		e.CreatedAt = now
+		kanji = append(kanji, e.Kanji...)
+		readings = append(readings, e.Readings...)
+		senses = append(senses, e.Senses...)
+		for _, s := range e.Senses {
+			glosses = append(glosses, s.Glosses...)
+		}
+	}
+
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		for _, model := range []any{&Kanji{}, &Reading{}, &Sense{}} {
+			if err := tx.Where("ent_seq IN ?", entSeqs).Delete(model).Error; err != nil {
+				return err
+			}
+		}
+
+		err := tx.Omit(clause.Associations).Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "ent_seq"}},
+			DoUpdates: clause.Assignments(map[string]any{
+				"content_hash": gorm.Expr("excluded.content_hash"),
+				"modified_at":  now,
+				"modified_by":  IMPORTER_ACTOR,
+				"deleted_at":   nil,
+				"deleted_by":   nil,
+			}),
+		}).Create(&entries).Error
+		if err != nil {
+			return err
+		}
+
+		if len(kanji) > 0 {
+			if err := tx.CreateInBatches(kanji, IMPORT_BATCH_SIZE).Error; err != nil {
+				return err
+			}
+		}
+		if len(readings) > 0 {
+			if err := tx.Omit(clause.Associations).CreateInBatches(readings, IMPORT_BATCH_SIZE).Error; err != nil {
+				return err
+			}
+		}
+		if len(restrictions) > 0 {
+			if err := tx.CreateInBatches(restrictions, IMPORT_BATCH_SIZE).Error; err != nil {
+				return err
+			}
+		}
+		if len(senses) > 0 {
+			if err := tx.Omit(clause.Associations).CreateInBatches(senses, IMPORT_BATCH_SIZE).Error; err != nil {
+				return err
+			}
+		}
+		if len(glosses) > 0 {
+			if err := tx.CreateInBatches(glosses, IMPORT_BATCH_SIZE).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *repo) SaveTags(tags []*Tag) (err error) {
+	if len(tags) == 0 {
+		return
+	}
+	return r.DB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "name"}},
+		DoUpdates: clause.AssignmentColumns([]string{"description"}),
+	}).Create(&tags).Error
+}
+
+func (r *repo) RemoveEntries(entSeqs []int64) (err error) {
+	if len(entSeqs) == 0 {
+		return
+	}
+	return softdelete.By(r.DB, IMPORTER_ACTOR).Where("ent_seq IN ?", entSeqs).Delete(&DictionaryEntry{}).Error
+}
+
+func (r *repo) CreateRelease(in *Release) (err error) {
+	return r.DB.Create(in).Error
+}
+
+// Lookup finds entries whose kanji form or reading starts with the query, or
+// that have a gloss in the given language containing it, served by the
+// trigram index on the glosses. Exact matches on kanji or reading are
+// ranked first.
+func (r *repo) Lookup(ctx echo.Context, query string, lang string, p *abstraction.Pagination) (out []*DictionaryEntry, count int64, err error) {
+	prefix := sqlutil.EscapeLike(query) + "%"
+	contains := sqlutil.Contains(strings.ToLower(query))
+
+	kanji := r.DB.Model(&Kanji{}).Select("ent_seq").Where("text LIKE ?", prefix)
+	readings := r.DB.Model(&Reading{}).Select("ent_seq").Where("text LIKE ?", prefix)
+	glosses := r.DB.Model(&Sense{}).Select("dictionary_senses.ent_seq").
+		Joins("JOIN dictionary_glosses ON dictionary_glosses.sense_id = dictionary_senses.sense_id").
+		Where("dictionary_glosses.lang = ? AND lower(dictionary_glosses.text) LIKE ?", lang, contains)
+
+	db := r.DB.Model(&DictionaryEntry{}).
+		Where(r.DB.Where("ent_seq IN (?)", kanji).
+			Or("ent_seq IN (?)", readings).
+			Or("ent_seq IN (?)", glosses)).
+		Session(&gorm.Session{})
+
+	if err = db.Count(&count).Error; err != nil {
+		return
+	}
+
+	rank := clause.Expr{
+		SQL: "CASE WHEN ent_seq IN (SELECT ent_seq FROM dictionary_kanji WHERE text = ?) " +
+			"OR ent_seq IN (SELECT ent_seq FROM dictionary_readings WHERE text = ?) THEN 0 ELSE 1 END, ent_seq",
+		Vars: []any{query, query},
+	}
+
+	out = []*DictionaryEntry{}
+	err = db.
+		Order(clause.OrderBy{Expression: rank}).
+		Limit(p.Limit()).
+		Offset(p.Offset()).
+		Preload("Kanji", func(db *gorm.DB) *gorm.DB { return db.Order("position") }).
+		Preload("Readings", func(db *gorm.DB) *gorm.DB { return db.Order("position") }).
+		Preload("Readings.Restrictions").
+		Preload("Senses", func(db *gorm.DB) *gorm.DB { return db.Order("position") }).
+		Preload("Senses.Glosses", func(db *gorm.DB) *gorm.DB {
+			return db.Where("lang = ?", lang).Order("position")
+		}).
+		Find(&out).Error
+	return
+}
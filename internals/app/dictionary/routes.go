@@ -0,0 +1,10 @@
+package dictionary
+
+import (
+	"github.com/labstack/echo/v4"
+	"wakuwaku_nihongo/internals/middleware"
+)
+
+func (h *handler) Route(g *echo.Group) {
+	g.GET("", h.Lookup, middleware.Authentication)
+}
@@ -0,0 +1,48 @@
+package dictionary
+
+import (
+	"strings"
+
+	"wakuwaku_nihongo/internals/abstraction"
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+type IDictionaryRepo interface {
+	Lookup(ctx echo.Context, query string, lang string, p *abstraction.Pagination) (out []*DictionaryEntry, count int64, err error)
+}
+
+type service struct {
+	repo IDictionaryRepo
+}
+
+func NewService(f *factory.Factory) *service {
+	return &service{
+		repo: NewRepo(f.Db),
+	}
+}
+
+func (s *service) Lookup(ctx echo.Context, in *LookupRequest) (out []*EntryResponse, info *abstraction.PaginationInfo, err error) {
+	query := strings.TrimSpace(in.Query)
+	lang := in.Lang
+	if lang == "" {
+		lang = DEFAULT_GLOSS_LANG
+	}
+
+	entries, count, err := s.repo.Lookup(ctx, query, lang, &in.Pagination)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	out = []*EntryResponse{}
+	for _, val := range entries {
+		entry := &EntryResponse{}
+		entry.MapFromModel(val)
+		out = append(out, entry)
+	}
+	info = in.Pagination.CreatePageInfo(count)
+	return
+}
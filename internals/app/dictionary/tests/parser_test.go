@@ -0,0 +1,84 @@
+package tests
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"wakuwaku_nihongo/internals/app/dictionary"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const sampleJMdict = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE JMdict [
+<!ELEMENT JMdict (entry*)>
+<!ENTITY n "noun (common) (futsuumeishi)">
+<!ENTITY vs "noun or participle which takes the aux. verb suru">
+<!ENTITY uk "word usually written using kana alone">
+<!ENTITY iK "word containing irregular kanji usage">
+]>
+<!-- JMdict created: 2024-05-01 -->
+<JMdict>
+<entry>
+<ent_seq>1000000</ent_seq>
+<k_ele><keb>勉強</keb><ke_pri>ichi1</ke_pri></k_ele>
+<k_ele><keb>勉彊</keb><ke_inf>&iK;</ke_inf></k_ele>
+<r_ele><reb>べんきょう</reb><re_restr>勉強</re_restr></r_ele>
+<r_ele><reb>ベンキョウ</reb><re_nokanji/></r_ele>
+<sense><pos>&n;</pos><pos>&vs;</pos><gloss>study</gloss><gloss xml:lang="dut">studie</gloss></sense>
+<sense><misc>&uk;</misc><gloss> diligence </gloss></sense>
+</entry>
+<entry>
+<ent_seq>1000001</ent_seq>
+<r_ele><reb>あ</reb></r_ele>
+<sense><pos>&n;</pos><gloss>ah</gloss></sense>
+</entry>
+</JMdict>`
+
+func TestParser(t *testing.T) {
+	p := dictionary.NewParser(strings.NewReader(sampleJMdict))
+
+	first, err := p.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1000000), first.EntSeq)
+
+	t.Run("entities resolve to their names", func(t *testing.T) {
+		assert.Equal(t, []string{"iK"}, first.Kanji[1].Info)
+		assert.Equal(t, "noun (common) (futsuumeishi)", p.Tags()["n"])
+	})
+
+	t.Run("reading restrictions and nokanji", func(t *testing.T) {
+		assert.Equal(t, []string{"勉強"}, first.Readings[0].Restrictions)
+		assert.Nil(t, first.Readings[0].NoKanji)
+		assert.NotNil(t, first.Readings[1].NoKanji)
+	})
+
+	t.Run("senses inherit parts of speech", func(t *testing.T) {
+		assert.Equal(t, []string{"n", "vs"}, first.Senses[0].PartsOfSpeech)
+		assert.Equal(t, []string{"n", "vs"}, first.Senses[1].PartsOfSpeech)
+		assert.Equal(t, []string{"uk"}, first.Senses[1].Misc)
+	})
+
+	t.Run("gloss language defaults to english", func(t *testing.T) {
+		assert.Equal(t, "eng", first.Senses[0].Glosses[0].Lang)
+		assert.Equal(t, "dut", first.Senses[0].Glosses[1].Lang)
+		assert.Equal(t, "diligence", first.Senses[1].Glosses[0].Text)
+	})
+
+	assert.Equal(t, "2024-05-01", p.ReleaseDate())
+
+	second, err := p.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1000001), second.EntSeq)
+	assert.NotEqual(t, first.Hash(), second.Hash())
+
+	_, err = p.Next()
+	assert.True(t, errors.Is(err, io.EOF))
+}
+
+func TestEntryHashIsStable(t *testing.T) {
+	a, _ := dictionary.NewParser(strings.NewReader(sampleJMdict)).Next()
+	b, _ := dictionary.NewParser(strings.NewReader(sampleJMdict)).Next()
+	assert.Equal(t, a.Hash(), b.Hash())
+}
@@ -1,6 +1,9 @@
 package example_feat
 
 import (
+	"wakuwaku_nihongo/internals/model"
+	"wakuwaku_nihongo/internals/utils/token"
+
 	"github.com/labstack/echo/v4"
 	"gorm.io/gorm"
 )
@@ -42,3 +45,16 @@ func (r *userRepo) GetByEmail(ctx echo.Context, email string) (out *UserModel, e
 	}
 	return
 }
+
+// GetRole returns the role of the active customer account linked to the
+// user, learner when there is none.
+func (r *userRepo) GetRole(ctx echo.Context, userID string) (role string, err error) {
+	roles := []string{}
+	err = r.DB.Model(&model.Customer{}).
+		Where("user_id = ? AND is_active", userID).
+		Pluck("role", &roles).Error
+	if err != nil || len(roles) == 0 || roles[0] == "" {
+		return token.ROLE_LEARNER, err
+	}
+	return roles[0], nil
+}
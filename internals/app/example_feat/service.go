@@ -14,6 +14,7 @@ type IUserRepo interface {
 	Get(ctx echo.Context) (out []*UserModel, err error)
 	Create(ctx echo.Context, in *UserModel) (out *UserModel, err error)
 	GetByEmail(ctx echo.Context, email string) (out *UserModel, err error)
+	GetRole(ctx echo.Context, userID string) (role string, err error)
 }
 
 type userService struct {
@@ -21,8 +22,12 @@ type userService struct {
 }
 
 func NewService(f *factory.Factory) *userService {
+	return NewServiceWithRepo(NewRepo(f.Db))
+}
+
+func NewServiceWithRepo(userRepo IUserRepo) *userService {
 	return &userService{
-		userRepo: NewRepo(f.Db),
+		userRepo: userRepo,
 	}
 }
 
@@ -74,8 +79,14 @@ func (s *userService) Login(ctx echo.Context, req *UserLoginRequest) (out *UserL
 		return
 	}
 
+	role, err := s.userRepo.GetRole(ctx, user.UserID)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
 	// generate jwt token
-	stringToken, err := token.GenerateJWT(user.UserID, user.Email)
+	stringToken, err := token.GenerateJWT(user.UserID, user.Email, role)
 	if err != nil {
 		err = response.ErrorWrap(response.ErrInternalServerError, err)
 		return
@@ -0,0 +1,73 @@
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"wakuwaku_nihongo/config"
+	"wakuwaku_nihongo/internals/app/example_feat"
+	"wakuwaku_nihongo/internals/utils/token"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type userRepo struct {
+	user  *example_feat.UserModel
+	roles map[string]string
+}
+
+func (r *userRepo) Get(ctx echo.Context) (out []*example_feat.UserModel, err error) {
+	return []*example_feat.UserModel{r.user}, nil
+}
+
+func (r *userRepo) Create(ctx echo.Context, in *example_feat.UserModel) (out *example_feat.UserModel, err error) {
+	return in, nil
+}
+
+func (r *userRepo) GetByEmail(ctx echo.Context, email string) (out *example_feat.UserModel, err error) {
+	if email != r.user.Email {
+		return &example_feat.UserModel{}, nil
+	}
+	return r.user, nil
+}
+
+func (r *userRepo) GetRole(ctx echo.Context, userID string) (role string, err error) {
+	if role, ok := r.roles[userID]; ok {
+		return role, nil
+	}
+	return token.ROLE_LEARNER, nil
+}
+
+func login(t *testing.T, roles map[string]string) jwt.MapClaims {
+	t.Setenv("JWT_KEY", "secret")
+	hashed, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	assert.NoError(t, err)
+	user := &example_feat.UserModel{UserID: "user"}
+	user.Email = "editor@example.com"
+	user.Password = string(hashed)
+
+	c := echo.New().NewContext(httptest.NewRequest(http.MethodPost, "/", nil), httptest.NewRecorder())
+	out, err := example_feat.NewServiceWithRepo(&userRepo{user: user, roles: roles}).
+		Login(c, &example_feat.UserLoginRequest{Email: user.Email, Password: "password"})
+	assert.NoError(t, err)
+
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(out.TokenString, claims, func(*jwt.Token) (any, error) {
+		return []byte(config.Get().JWT.Key), nil
+	})
+	assert.NoError(t, err)
+	return claims
+}
+
+func TestLoginRole(t *testing.T) {
+	claims := login(t, map[string]string{"user": token.ROLE_EDITOR})
+	assert.Equal(t, "user", claims["user_id"])
+	assert.Equal(t, token.ROLE_EDITOR, claims["role"])
+
+	claims = login(t, nil)
+	assert.Equal(t, token.ROLE_LEARNER, claims["role"])
+}
@@ -0,0 +1,6 @@
+package explanations
+
+const (
+	OWNER_TYPE_QUESTION = "question"
+	OWNER_TYPE_ANSWER   = "answer"
+)
@@ -0,0 +1,155 @@
+package explanations
+
+import (
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+type IExplanationService interface {
+	Get(ctx echo.Context, questionID string) (out *QuestionExplanationsResponse, err error)
+	SetQuestion(ctx echo.Context, questionID string, locale string, in *ExplanationRequest) (out *QuestionExplanationsResponse, err error)
+	DeleteQuestion(ctx echo.Context, questionID string, locale string) (err error)
+	SetAnswer(ctx echo.Context, questionID string, answerID string, locale string, in *ExplanationRequest) (out *QuestionExplanationsResponse, err error)
+	DeleteAnswer(ctx echo.Context, questionID string, answerID string, locale string) (err error)
+}
+
+type handler struct {
+	service IExplanationService
+}
+
+func NewHandler(f *factory.Factory) *handler {
+	return &handler{
+		service: NewService(f),
+	}
+}
+
+// @Summary Get Explanations
+// @Description Get every translation of the explanation of a question and of its answers (editor only)
+// @Tags explanation
+// @Produce json
+// @Param question_id path string true "Question ID"
+// @Success 200 {object} response.Success{data=QuestionExplanationsResponse}
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/questions/{question_id}/explanations [get]
+func (h *handler) Get(c echo.Context) error {
+	res, err := h.service.Get(c, c.Param("question_id"))
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Set Question Explanation
+// @Description Create or replace the explanation of a question in one locale (editor only). Japanese explanations accept furigana markup
+// @Tags explanation
+// @Accept json
+// @Produce json
+// @Param question_id path string true "Question ID"
+// @Param locale path string true "Locale" Enums(en, id, ja)
+// @Param payload body ExplanationRequest true "Payload"
+// @Success 200 {object} response.Success{data=QuestionExplanationsResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/questions/{question_id}/explanations/{locale} [put]
+func (h *handler) SetQuestion(c echo.Context) error {
+	req := &ExplanationRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.SetQuestion(c, c.Param("question_id"), c.Param("locale"), req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Delete Question Explanation
+// @Description Delete the explanation of a question in one locale (editor only)
+// @Tags explanation
+// @Produce json
+// @Param question_id path string true "Question ID"
+// @Param locale path string true "Locale" Enums(en, id, ja)
+// @Success 200 {object} response.Success{data=string}
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/questions/{question_id}/explanations/{locale} [delete]
+func (h *handler) DeleteQuestion(c echo.Context) error {
+	err := h.service.DeleteQuestion(c, c.Param("question_id"), c.Param("locale"))
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse("deleted").Send(c)
+}
+
+// @Summary Set Answer Explanation
+// @Description Create or replace the explanation of an answer in one locale (editor only). Japanese explanations accept furigana markup
+// @Tags explanation
+// @Accept json
+// @Produce json
+// @Param question_id path string true "Question ID"
+// @Param answer_id path string true "Answer ID"
+// @Param locale path string true "Locale" Enums(en, id, ja)
+// @Param payload body ExplanationRequest true "Payload"
+// @Success 200 {object} response.Success{data=QuestionExplanationsResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/questions/{question_id}/answers/{answer_id}/explanations/{locale} [put]
+func (h *handler) SetAnswer(c echo.Context) error {
+	req := &ExplanationRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.SetAnswer(c, c.Param("question_id"), c.Param("answer_id"), c.Param("locale"), req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Delete Answer Explanation
+// @Description Delete the explanation of an answer in one locale (editor only)
+// @Tags explanation
+// @Produce json
+// @Param question_id path string true "Question ID"
+// @Param answer_id path string true "Answer ID"
+// @Param locale path string true "Locale" Enums(en, id, ja)
+// @Success 200 {object} response.Success{data=string}
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/questions/{question_id}/answers/{answer_id}/explanations/{locale} [delete]
+func (h *handler) DeleteAnswer(c echo.Context) error {
+	err := h.service.DeleteAnswer(c, c.Param("question_id"), c.Param("answer_id"), c.Param("locale"))
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse("deleted").Send(c)
+}
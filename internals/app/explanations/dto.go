@@ -0,0 +1,74 @@
+package explanations
+
+import (
+	"wakuwaku_nihongo/internals/model"
+	"wakuwaku_nihongo/internals/pkg/i18n"
+	"wakuwaku_nihongo/internals/pkg/ruby"
+)
+
+type ExplanationRequest struct {
+	Explanation string `json:"explanation" validate:"required"`
+}
+
+// Translations maps a locale to the explanation in that locale.
+type Translations map[string]string
+
+type QuestionExplanationsResponse struct {
+	QuestionID   string                        `json:"question_id"`
+	Explanations Translations                  `json:"explanations"`
+	Answers      []*AnswerExplanationsResponse `json:"answers"`
+}
+
+type AnswerExplanationsResponse struct {
+	AnswerID     string       `json:"answer_id"`
+	AnswerText   string       `json:"answer_text"`
+	Explanations Translations `json:"explanations"`
+}
+
+// Group returns the translations of each owner keyed by owner ID.
+func Group(list []*Explanation) map[string]Translations {
+	out := map[string]Translations{}
+	for _, e := range list {
+		if out[e.OwnerID] == nil {
+			out[e.OwnerID] = Translations{}
+		}
+		out[e.OwnerID][e.Locale] = e.Explanation
+	}
+	return out
+}
+
+// Render renders the furigana of the Japanese explanation with format, the
+// other locales are plain text.
+func (t Translations) Render(format ruby.Format) Translations {
+	if t == nil {
+		return nil
+	}
+	out := Translations{}
+	for locale, text := range t {
+		if locale == string(i18n.Ja) {
+			text = ruby.Render(text, format, nil).Text
+		}
+		out[locale] = text
+	}
+	return out
+}
+
+func (r *QuestionExplanationsResponse) MapFromModel(q *model.Question, translations map[string]Translations) {
+	r.QuestionID = q.QuestionID
+	r.Explanations = translations[q.QuestionID]
+	if r.Explanations == nil {
+		r.Explanations = Translations{}
+	}
+	r.Answers = []*AnswerExplanationsResponse{}
+	for _, a := range q.Answers {
+		answer := &AnswerExplanationsResponse{
+			AnswerID:     a.AnswerID,
+			AnswerText:   a.AnswerText,
+			Explanations: translations[a.AnswerID],
+		}
+		if answer.Explanations == nil {
+			answer.Explanations = Translations{}
+		}
+		r.Answers = append(r.Answers, answer)
+	}
+}
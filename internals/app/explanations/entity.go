@@ -0,0 +1,33 @@
+package explanations
+
+import (
+	"time"
+
+	"wakuwaku_nihongo/internals/pkg/softdelete"
+
+	"gorm.io/gorm"
+)
+
+// Explanation is the translation of a question or answer explanation into
+// one locale. Japanese explanations may contain furigana markup.
+type Explanation struct {
+	OwnerType   string               `gorm:"column:owner_type;type:character varying;primaryKey" json:"owner_type"`
+	OwnerID     string               `gorm:"column:owner_id;type:uuid;primaryKey" json:"owner_id"`
+	Locale      string               `gorm:"column:locale;type:character varying;primaryKey" json:"locale"`
+	CreatedAt   int64                `gorm:"column:created_at;type:bigint;not null" json:"created_at"`
+	ModifiedAt  *int64               `gorm:"column:modified_at;type:bigint" json:"modified_at"`
+	DeletedAt   softdelete.DeletedAt `gorm:"column:deleted_at;type:bigint" json:"deleted_at"`
+	CreatedBy   string               `gorm:"column:created_by;type:character varying;not null" json:"created_by"`
+	ModifiedBy  *string              `gorm:"column:modified_by;type:character varying" json:"modified_by"`
+	DeletedBy   *string              `gorm:"column:deleted_by;type:character varying" json:"deleted_by"`
+	Explanation string               `gorm:"column:explanation;type:text;not null" json:"explanation"`
+}
+
+func (*Explanation) TableName() string {
+	return "explanations"
+}
+
+func (m *Explanation) BeforeCreate(tx *gorm.DB) (err error) {
+	m.CreatedAt = time.Now().UnixMilli()
+	return
+}
@@ -0,0 +1,63 @@
+package explanations
+
+import (
+	"time"
+
+	"wakuwaku_nihongo/internals/model"
+	"wakuwaku_nihongo/internals/pkg/softdelete"
+	"wakuwaku_nihongo/internals/query"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type repo struct {
+	db *gorm.DB
+	*query.Query
+}
+
+func NewRepo(db *gorm.DB) *repo {
+	return &repo{
+		db:    db,
+		Query: query.Use(db),
+	}
+}
+
+func (r *repo) GetQuestion(ctx echo.Context, questionID string) (out *model.Question, err error) {
+	q := r.Question
+	return q.Where(q.QuestionID.Eq(questionID)).
+		Preload(q.Answers).First()
+}
+
+// List returns the explanations of the owners, question and answer IDs
+// never collide so both owner types are read at once.
+func (r *repo) List(ctx echo.Context, ownerIDs []string) (out []*Explanation, err error) {
+	out = []*Explanation{}
+	if len(ownerIDs) == 0 {
+		return
+	}
+	err = r.db.Where("owner_id IN ?", ownerIDs).Find(&out).Error
+	return
+}
+
+// Upsert saves the translation, restoring it when it had been deleted.
+func (r *repo) Upsert(ctx echo.Context, in *Explanation, modifiedBy string) (err error) {
+	return r.db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "owner_type"}, {Name: "owner_id"}, {Name: "locale"}},
+		DoUpdates: clause.Assignments(map[string]any{
+			"explanation": in.Explanation,
+			"modified_at": time.Now().UnixMilli(),
+			"modified_by": modifiedBy,
+			"deleted_at":  nil,
+			"deleted_by":  nil,
+		}),
+	}).Create(in).Error
+}
+
+func (r *repo) Delete(ctx echo.Context, ownerType string, ownerID string, locale string, deletedBy string) (affected int64, err error) {
+	res := softdelete.By(r.db, deletedBy).
+		Where("owner_type = ? AND owner_id = ? AND locale = ?", ownerType, ownerID, locale).
+		Delete(&Explanation{})
+	return res.RowsAffected, res.Error
+}
@@ -0,0 +1,17 @@
+package explanations
+
+import (
+	"github.com/labstack/echo/v4"
+	"wakuwaku_nihongo/internals/middleware"
+	"wakuwaku_nihongo/internals/utils/token"
+)
+
+func (h *handler) QuestionRoute(g *echo.Group) {
+	editor := middleware.Authorization(token.ROLE_EDITOR)
+
+	g.GET("/:question_id/explanations", h.Get, middleware.Authentication, editor)
+	g.PUT("/:question_id/explanations/:locale", h.SetQuestion, middleware.Authentication, editor)
+	g.DELETE("/:question_id/explanations/:locale", h.DeleteQuestion, middleware.Authentication, editor)
+	g.PUT("/:question_id/answers/:answer_id/explanations/:locale", h.SetAnswer, middleware.Authentication, editor)
+	g.DELETE("/:question_id/answers/:answer_id/explanations/:locale", h.DeleteAnswer, middleware.Authentication, editor)
+}
@@ -0,0 +1,204 @@
+package explanations
+
+import (
+	"errors"
+	"fmt"
+
+	"wakuwaku_nihongo/internals/app/search"
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/middleware"
+	"wakuwaku_nihongo/internals/model"
+	"wakuwaku_nihongo/internals/pkg/i18n"
+	"wakuwaku_nihongo/internals/pkg/ruby"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+type IExplanationRepo interface {
+	GetQuestion(ctx echo.Context, questionID string) (out *model.Question, err error)
+	List(ctx echo.Context, ownerIDs []string) (out []*Explanation, err error)
+	Upsert(ctx echo.Context, in *Explanation, modifiedBy string) (err error)
+	Delete(ctx echo.Context, ownerType string, ownerID string, locale string, deletedBy string) (affected int64, err error)
+}
+
+type ISearchIndexer interface {
+	IndexQuestion(ctx echo.Context, questionID string) (err error)
+}
+
+type service struct {
+	repo   IExplanationRepo
+	search ISearchIndexer
+}
+
+func NewService(f *factory.Factory) *service {
+	return &service{
+		repo:   NewRepo(f.Db),
+		search: search.NewService(f),
+	}
+}
+
+// Get returns every translation of the question explanation and of the
+// explanations of its answers.
+func (s *service) Get(ctx echo.Context, questionID string) (out *QuestionExplanationsResponse, err error) {
+	question, err := s.getQuestion(ctx, questionID)
+	if err != nil {
+		return
+	}
+
+	translations, err := s.GetForQuestions(ctx, []*model.Question{question})
+	if err != nil {
+		return
+	}
+
+	out = &QuestionExplanationsResponse{}
+	out.MapFromModel(question, translations)
+	return
+}
+
+func (s *service) SetQuestion(ctx echo.Context, questionID string, locale string, in *ExplanationRequest) (out *QuestionExplanationsResponse, err error) {
+	if err = validate(locale, in); err != nil {
+		return
+	}
+	if _, err = s.getQuestion(ctx, questionID); err != nil {
+		return
+	}
+	if err = s.upsert(ctx, OWNER_TYPE_QUESTION, questionID, locale, in); err != nil {
+		return
+	}
+	s.index(ctx, questionID)
+	return s.Get(ctx, questionID)
+}
+
+func (s *service) DeleteQuestion(ctx echo.Context, questionID string, locale string) (err error) {
+	if _, err = s.getQuestion(ctx, questionID); err != nil {
+		return
+	}
+	if err = s.delete(ctx, OWNER_TYPE_QUESTION, questionID, locale); err != nil {
+		return
+	}
+	s.index(ctx, questionID)
+	return
+}
+
+func (s *service) SetAnswer(ctx echo.Context, questionID string, answerID string, locale string, in *ExplanationRequest) (out *QuestionExplanationsResponse, err error) {
+	if err = validate(locale, in); err != nil {
+		return
+	}
+	if err = s.checkAnswer(ctx, questionID, answerID); err != nil {
+		return
+	}
+	if err = s.upsert(ctx, OWNER_TYPE_ANSWER, answerID, locale, in); err != nil {
+		return
+	}
+	s.index(ctx, questionID)
+	return s.Get(ctx, questionID)
+}
+
+func (s *service) DeleteAnswer(ctx echo.Context, questionID string, answerID string, locale string) (err error) {
+	if err = s.checkAnswer(ctx, questionID, answerID); err != nil {
+		return
+	}
+	if err = s.delete(ctx, OWNER_TYPE_ANSWER, answerID, locale); err != nil {
+		return
+	}
+	s.index(ctx, questionID)
+	return
+}
+
+// GetForQuestions returns the translations of the questions and of their
+// answers keyed by question or answer ID.
+func (s *service) GetForQuestions(ctx echo.Context, questions []*model.Question) (out map[string]Translations, err error) {
+	ownerIDs := []string{}
+	for _, q := range questions {
+		ownerIDs = append(ownerIDs, q.QuestionID)
+		for _, a := range q.Answers {
+			ownerIDs = append(ownerIDs, a.AnswerID)
+		}
+	}
+
+	list, err := s.repo.List(ctx, ownerIDs)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	out = Group(list)
+	return
+}
+
+func (s *service) upsert(ctx echo.Context, ownerType string, ownerID string, locale string, in *ExplanationRequest) (err error) {
+	userID := middleware.GetUserID(ctx)
+	err = s.repo.Upsert(ctx, &Explanation{
+		OwnerType:   ownerType,
+		OwnerID:     ownerID,
+		Locale:      locale,
+		CreatedBy:   userID,
+		Explanation: in.Explanation,
+	}, userID)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	return
+}
+
+func (s *service) delete(ctx echo.Context, ownerType string, ownerID string, locale string) (err error) {
+	affected, err := s.repo.Delete(ctx, ownerType, ownerID, locale, middleware.GetUserID(ctx))
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	if affected == 0 {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("explanation not found"))
+	}
+	return
+}
+
+// index refreshes the search document of the question, which holds its
+// explanations. A failure only makes search results stale until the next
+// reindex, so it does not fail the write.
+func (s *service) index(ctx echo.Context, questionID string) {
+	err := s.search.IndexQuestion(ctx, questionID)
+	if err != nil {
+		log.Error().Err(err).Str("question_id", questionID).Msg("error indexing question")
+	}
+}
+
+func (s *service) checkAnswer(ctx echo.Context, questionID string, answerID string) (err error) {
+	question, err := s.getQuestion(ctx, questionID)
+	if err != nil {
+		return
+	}
+	for _, a := range question.Answers {
+		if a.AnswerID == answerID {
+			return
+		}
+	}
+	return response.ErrorWrap(response.ErrNotFound, fmt.Errorf("answer not found"))
+}
+
+func (s *service) getQuestion(ctx echo.Context, questionID string) (out *model.Question, err error) {
+	out, err = s.repo.GetQuestion(ctx, questionID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("question not found"))
+		return
+	}
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	return
+}
+
+// validate checks the locale and, for Japanese, the furigana markup.
+func validate(locale string, in *ExplanationRequest) error {
+	if !i18n.Locale(locale).IsValid() {
+		return response.ErrorWrap(response.ErrValidation, fmt.Errorf("locale must be one of en, id, ja"))
+	}
+	if locale == string(i18n.Ja) {
+		if err := ruby.Validate(in.Explanation); err != nil {
+			return response.ErrorWrap(response.ErrValidation, err)
+		}
+	}
+	return nil
+}
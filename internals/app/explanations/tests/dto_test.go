@@ -0,0 +1,46 @@
+package tests
+
+import (
+	"testing"
+	"wakuwaku_nihongo/internals/app/explanations"
+	"wakuwaku_nihongo/internals/model"
+	"wakuwaku_nihongo/internals/pkg/ruby"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupAndRender(t *testing.T) {
+	grouped := explanations.Group([]*explanations.Explanation{
+		{OwnerID: "q1", Locale: "en", Explanation: "Use [brackets] freely"},
+		{OwnerID: "q1", Locale: "ja", Explanation: "漢字[かんじ]の読み"},
+		{OwnerID: "a1", Locale: "id", Explanation: "Jawaban benar"},
+	})
+
+	assert.Len(t, grouped, 2)
+	assert.Equal(t, explanations.Translations{"id": "Jawaban benar"}, grouped["a1"])
+
+	rendered := grouped["q1"].Render(ruby.FormatStrip)
+	assert.Equal(t, "Use [brackets] freely", rendered["en"])
+	assert.Equal(t, "漢字の読み", rendered["ja"])
+	assert.Equal(t, "漢字[かんじ]の読み", grouped["q1"]["ja"], "render does not modify the source")
+	assert.Nil(t, grouped["missing"].Render(ruby.FormatStrip))
+}
+
+func TestQuestionExplanationsResponse(t *testing.T) {
+	question := &model.Question{
+		QuestionID: "q1",
+		Answers: []*model.Answer{
+			{AnswerID: "a1", AnswerText: "はい"},
+			{AnswerID: "a2", AnswerText: "いいえ"},
+		},
+	}
+	res := &explanations.QuestionExplanationsResponse{}
+	res.MapFromModel(question, map[string]explanations.Translations{
+		"a2": {"en": "No"},
+	})
+
+	assert.Equal(t, explanations.Translations{}, res.Explanations)
+	assert.Len(t, res.Answers, 2)
+	assert.Equal(t, explanations.Translations{}, res.Answers[0].Explanations)
+	assert.Equal(t, explanations.Translations{"en": "No"}, res.Answers[1].Explanations)
+}
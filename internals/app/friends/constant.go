@@ -0,0 +1,5 @@
+package friends
+
+// MAX_FRIENDS bounds the friends of a customer, the friends leaderboard
+// looks up the score of each of them.
+const MAX_FRIENDS = 500
@@ -0,0 +1,92 @@
+package friends
+
+import (
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+type IFriendService interface {
+	List(ctx echo.Context) (out []*FriendResponse, err error)
+	Add(ctx echo.Context, in *FriendRequest) (err error)
+	Remove(ctx echo.Context, friendID string) (err error)
+}
+
+type handler struct {
+	service IFriendService
+}
+
+func NewHandler(f *factory.Factory) *handler {
+	return &handler{
+		service: NewService(f),
+	}
+}
+
+// @Summary Get List of Friends
+// @Description Get the customers the logged in customer follows, they make up the friends leaderboard
+// @Tags friend
+// @Produce json
+// @Success 200 {object} response.Success{data=[]FriendResponse}
+// @Failure 401 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/me/friends [get]
+func (h *handler) List(c echo.Context) error {
+	res, err := h.service.List(c)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Add Friend
+// @Description Follow another customer, adding a friend twice is a no-op
+// @Tags friend
+// @Accept json
+// @Produce json
+// @Param payload body FriendRequest true "Payload"
+// @Success 200 {object} response.Success{data=string}
+// @Failure 400 {object} response.errorResponse
+// @Failure 401 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/me/friends [post]
+func (h *handler) Add(c echo.Context) error {
+	req := &FriendRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	err = h.service.Add(c, req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse("added").Send(c)
+}
+
+// @Summary Remove Friend
+// @Description Stop following a customer
+// @Tags friend
+// @Produce json
+// @Param friend_id path string true "Friend customer ID"
+// @Success 200 {object} response.Success{data=string}
+// @Failure 401 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/me/friends/{friend_id} [delete]
+func (h *handler) Remove(c echo.Context) error {
+	err := h.service.Remove(c, c.Param("friend_id"))
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse("deleted").Send(c)
+}
@@ -0,0 +1,17 @@
+package friends
+
+type FriendRequest struct {
+	FriendID string `json:"friend_id" validate:"required,uuid"`
+}
+
+type FriendResponse struct {
+	CustomerID string `json:"customer_id"`
+	Username   string `json:"username"`
+	CreatedAt  int64  `json:"created_at"`
+}
+
+func (r *FriendResponse) MapFromModel(m *friendWithName) {
+	r.CustomerID = m.FriendID
+	r.Username = m.Username
+	r.CreatedAt = m.CreatedAt
+}
@@ -0,0 +1,33 @@
+package friends
+
+import (
+	"time"
+
+	"wakuwaku_nihongo/internals/pkg/softdelete"
+
+	"gorm.io/gorm"
+)
+
+// Friend is a customer another customer follows, friendships are one way.
+type Friend struct {
+	CustomerID string               `gorm:"column:customer_id;type:uuid;primaryKey" json:"customer_id"`
+	FriendID   string               `gorm:"column:friend_id;type:uuid;primaryKey" json:"friend_id"`
+	CreatedAt  int64                `gorm:"column:created_at;type:bigint;not null" json:"created_at"`
+	DeletedAt  softdelete.DeletedAt `gorm:"column:deleted_at;type:bigint" json:"deleted_at"`
+	DeletedBy  *string              `gorm:"column:deleted_by;type:character varying" json:"deleted_by"`
+}
+
+func (*Friend) TableName() string {
+	return "customer_friends"
+}
+
+func (m *Friend) BeforeCreate(tx *gorm.DB) (err error) {
+	m.CreatedAt = time.Now().UnixMilli()
+	return
+}
+
+type friendWithName struct {
+	FriendID  string
+	Username  string
+	CreatedAt int64
+}
@@ -0,0 +1,68 @@
+package friends
+
+import (
+	"wakuwaku_nihongo/internals/model"
+	"wakuwaku_nihongo/internals/pkg/softdelete"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type repo struct {
+	db *gorm.DB
+}
+
+func NewRepo(db *gorm.DB) *repo {
+	return &repo{
+		db: db,
+	}
+}
+
+func (r *repo) CustomerExists(ctx echo.Context, customerID string) (exists bool, err error) {
+	var count int64
+	err = r.db.Model(&model.Customer{}).
+		Where("customer_id = ?", customerID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *repo) Count(ctx echo.Context, customerID string) (out int64, err error) {
+	err = r.db.Model(&Friend{}).Where("customer_id = ?", customerID).Count(&out).Error
+	return
+}
+
+func (r *repo) List(ctx echo.Context, customerID string) (out []*friendWithName, err error) {
+	out = []*friendWithName{}
+	err = r.db.Table("customer_friends f").
+		Select("f.friend_id, c.username, f.created_at").
+		Joins("JOIN customers c ON c.customer_id = f.friend_id AND c.deleted_at IS NULL").
+		Where("f.customer_id = ? AND f.deleted_at IS NULL", customerID).
+		Order("c.username").
+		Scan(&out).Error
+	return
+}
+
+func (r *repo) ListIDs(ctx echo.Context, customerID string) (out []string, err error) {
+	out = []string{}
+	err = r.db.Model(&Friend{}).
+		Where("customer_id = ?", customerID).
+		Pluck("friend_id", &out).Error
+	return
+}
+
+// Create adds the friend, adding an existing friend again is a no-op and
+// adding a deleted friend again brings the friendship back as a new one.
+func (r *repo) Create(ctx echo.Context, in *Friend) (err error) {
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "customer_id"}, {Name: "friend_id"}},
+		Where:     clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "customer_friends.deleted_at IS NOT NULL"}}},
+		DoUpdates: clause.AssignmentColumns([]string{"created_at", "deleted_at", "deleted_by"}),
+	}).Create(in).Error
+}
+
+// Delete soft deletes the friendship, the customer deletes their own.
+func (r *repo) Delete(ctx echo.Context, customerID, friendID string) (deleted bool, err error) {
+	res := softdelete.By(r.db, customerID).Where("customer_id = ? AND friend_id = ?", customerID, friendID).Delete(&Friend{})
+	return res.RowsAffected > 0, res.Error
+}
@@ -0,0 +1,12 @@
+package friends
+
+import (
+	"github.com/labstack/echo/v4"
+	"wakuwaku_nihongo/internals/middleware"
+)
+
+func (h *handler) Route(g *echo.Group) {
+	g.GET("", h.List, middleware.Authentication)
+	g.POST("", h.Add, middleware.Authentication)
+	g.DELETE("/:friend_id", h.Remove, middleware.Authentication)
+}
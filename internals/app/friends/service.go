@@ -0,0 +1,91 @@
+package friends
+
+import (
+	"fmt"
+
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/middleware"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+type IFriendRepo interface {
+	CustomerExists(ctx echo.Context, customerID string) (exists bool, err error)
+	Count(ctx echo.Context, customerID string) (out int64, err error)
+	List(ctx echo.Context, customerID string) (out []*friendWithName, err error)
+	ListIDs(ctx echo.Context, customerID string) (out []string, err error)
+	Create(ctx echo.Context, in *Friend) (err error)
+	Delete(ctx echo.Context, customerID, friendID string) (deleted bool, err error)
+}
+
+type service struct {
+	repo IFriendRepo
+}
+
+func NewService(f *factory.Factory) *service {
+	return &service{
+		repo: NewRepo(f.Db),
+	}
+}
+
+func (s *service) List(ctx echo.Context) (out []*FriendResponse, err error) {
+	friends, err := s.repo.List(ctx, middleware.GetUserID(ctx))
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	out = []*FriendResponse{}
+	for _, val := range friends {
+		friend := &FriendResponse{}
+		friend.MapFromModel(val)
+		out = append(out, friend)
+	}
+	return
+}
+
+func (s *service) Add(ctx echo.Context, in *FriendRequest) (err error) {
+	customerID := middleware.GetUserID(ctx)
+	if in.FriendID == customerID {
+		return response.ErrorWrap(response.ErrValidation, fmt.Errorf("you cannot add yourself as a friend"))
+	}
+
+	exists, err := s.repo.CustomerExists(ctx, in.FriendID)
+	if err != nil {
+		return response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	if !exists {
+		return response.ErrorWrap(response.ErrNotFound, fmt.Errorf("customer not found"))
+	}
+
+	count, err := s.repo.Count(ctx, customerID)
+	if err != nil {
+		return response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	if count >= MAX_FRIENDS {
+		return response.ErrorWrap(response.ErrBadRequest, fmt.Errorf("you can have at most %d friends", MAX_FRIENDS))
+	}
+
+	err = s.repo.Create(ctx, &Friend{CustomerID: customerID, FriendID: in.FriendID})
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	return
+}
+
+func (s *service) Remove(ctx echo.Context, friendID string) (err error) {
+	deleted, err := s.repo.Delete(ctx, middleware.GetUserID(ctx), friendID)
+	if err != nil {
+		return response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	if !deleted {
+		return response.ErrorWrap(response.ErrNotFound, fmt.Errorf("friend not found"))
+	}
+	return
+}
+
+// FriendIDs returns the ids of the customers customerID follows.
+func (s *service) FriendIDs(ctx echo.Context, customerID string) (out []string, err error) {
+	return s.repo.ListIDs(ctx, customerID)
+}
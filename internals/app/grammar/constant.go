@@ -0,0 +1,12 @@
+package grammar
+
+const (
+	DEFAULT_SORT_BY = "pattern"
+)
+
+var SORTABLE_COLUMNS = map[string]bool{
+	"pattern":     true,
+	"jlpt_level":  true,
+	"created_at":  true,
+	"modified_at": true,
+}
@@ -0,0 +1,281 @@
+package grammar
+
+import (
+	"wakuwaku_nihongo/internals/abstraction"
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+type IGrammarService interface {
+	List(ctx echo.Context, filter *GrammarPointFilter) (out []*GrammarPointResponse, info *abstraction.PaginationInfo, err error)
+	Get(ctx echo.Context, id string) (out *GrammarPointResponse, err error)
+	Create(ctx echo.Context, in *GrammarPointRequest) (out *GrammarPointResponse, err error)
+	Update(ctx echo.Context, id string, in *GrammarPointRequest) (out *GrammarPointResponse, err error)
+	Delete(ctx echo.Context, id string) (err error)
+	Restore(ctx echo.Context, id string) (out *GrammarPointResponse, err error)
+	GetQuestions(ctx echo.Context, id string, p *abstraction.Pagination) (out []*QuestionResponse, info *abstraction.PaginationInfo, err error)
+	LinkQuestions(ctx echo.Context, id string, in *LinkQuestionsRequest) (err error)
+	UnlinkQuestion(ctx echo.Context, id string, questionID string) (err error)
+	GetByQuestion(ctx echo.Context, questionID string) (out []*GrammarPointResponse, err error)
+}
+
+type handler struct {
+	service IGrammarService
+}
+
+func NewHandler(f *factory.Factory) *handler {
+	return &handler{
+		service: NewService(f),
+	}
+}
+
+// @Summary Get List of Grammar Point
+// @Description Get list of grammar point filtered by JLPT level or pattern
+// @Tags grammar
+// @Produce json
+// @Param jlpt_level query string false "JLPT level" Enums(N5, N4, N3, N2, N1)
+// @Param q query string false "Search pattern or meaning"
+// @Param include_deleted query bool false "Include deleted grammar points (admin only)"
+// @Param page query int false "Page"
+// @Param page_size query int false "Page size"
+// @Success 200 {object} response.SuccessResponseWithInfo{data=[]GrammarPointResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/grammar-points [get]
+func (h *handler) List(c echo.Context) error {
+	req := &GrammarPointFilter{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+	asc := "asc"
+	req.ChangeDefaultSortingClause(DEFAULT_SORT_BY, &asc)
+	req.Pagination.SetDefault()
+
+	res, info, err := h.service.List(c, req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponseInfo(res, info).Send(c)
+}
+
+// @Summary Get Grammar Point
+// @Description Get grammar point with its example sentences
+// @Tags grammar
+// @Produce json
+// @Param id path string true "Grammar point ID"
+// @Success 200 {object} response.Success{data=GrammarPointResponse}
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/grammar-points/{id} [get]
+func (h *handler) Get(c echo.Context) error {
+	res, err := h.service.Get(c, c.Param("id"))
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Create Grammar Point
+// @Description Create new grammar point (editor only)
+// @Tags grammar
+// @Accept json
+// @Produce json
+// @Param payload body GrammarPointRequest true "Payload"
+// @Success 200 {object} response.Success{data=GrammarPointResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/grammar-points [post]
+func (h *handler) Create(c echo.Context) error {
+	req := &GrammarPointRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.Create(c, req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Update Grammar Point
+// @Description Update grammar point and replace its examples (editor only)
+// @Tags grammar
+// @Accept json
+// @Produce json
+// @Param id path string true "Grammar point ID"
+// @Param payload body GrammarPointRequest true "Payload"
+// @Success 200 {object} response.Success{data=GrammarPointResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/grammar-points/{id} [put]
+func (h *handler) Update(c echo.Context) error {
+	req := &GrammarPointRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.Update(c, c.Param("id"), req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Delete Grammar Point
+// @Description Soft delete grammar point (editor only)
+// @Tags grammar
+// @Produce json
+// @Param id path string true "Grammar point ID"
+// @Success 200 {object} response.Success{data=string}
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/grammar-points/{id} [delete]
+func (h *handler) Delete(c echo.Context) error {
+	err := h.service.Delete(c, c.Param("id"))
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse("deleted").Send(c)
+}
+
+// @Summary Restore Grammar Point
+// @Description Restore a deleted grammar point (admin only)
+// @Tags grammar
+// @Produce json
+// @Param id path string true "Grammar point ID"
+// @Success 200 {object} response.Success{data=GrammarPointResponse}
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/grammar-points/{id}/restore [post]
+func (h *handler) Restore(c echo.Context) error {
+	res, err := h.service.Restore(c, c.Param("id"))
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Get Questions of Grammar Point
+// @Description Get questions that test the grammar point (editor only)
+// @Tags grammar
+// @Produce json
+// @Param id path string true "Grammar point ID"
+// @Param page query int false "Page"
+// @Param page_size query int false "Page size"
+// @Success 200 {object} response.SuccessResponseWithInfo{data=[]QuestionResponse}
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/grammar-points/{id}/questions [get]
+func (h *handler) GetQuestions(c echo.Context) error {
+	req := &abstraction.Pagination{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+	req.SetDefault()
+
+	res, info, err := h.service.GetQuestions(c, c.Param("id"), req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponseInfo(res, info).Send(c)
+}
+
+// @Summary Link Questions to Grammar Point
+// @Description Mark questions as testing the grammar point (editor only)
+// @Tags grammar
+// @Accept json
+// @Produce json
+// @Param id path string true "Grammar point ID"
+// @Param payload body LinkQuestionsRequest true "Payload"
+// @Success 200 {object} response.Success{data=string}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/grammar-points/{id}/questions [post]
+func (h *handler) LinkQuestions(c echo.Context) error {
+	req := &LinkQuestionsRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	err = h.service.LinkQuestions(c, c.Param("id"), req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse("linked").Send(c)
+}
+
+// @Summary Unlink Question from Grammar Point
+// @Description Remove the link between a question and the grammar point (editor only)
+// @Tags grammar
+// @Produce json
+// @Param id path string true "Grammar point ID"
+// @Param question_id path string true "Question ID"
+// @Success 200 {object} response.Success{data=string}
+// @Failure 403 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/grammar-points/{id}/questions/{question_id} [delete]
+func (h *handler) UnlinkQuestion(c echo.Context) error {
+	err := h.service.UnlinkQuestion(c, c.Param("id"), c.Param("question_id"))
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse("unlinked").Send(c)
+}
+
+// @Summary Get Grammar Points of Question
+// @Description Get grammar points tested by a question. Learners only get those of questions in published quizzes
+// @Tags grammar
+// @Produce json
+// @Param question_id path string true "Question ID"
+// @Success 200 {object} response.Success{data=[]GrammarPointResponse}
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/questions/{question_id}/grammar-points [get]
+func (h *handler) GetByQuestion(c echo.Context) error {
+	res, err := h.service.GetByQuestion(c, c.Param("question_id"))
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
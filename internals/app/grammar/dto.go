@@ -0,0 +1,101 @@
+package grammar
+
+import (
+	"wakuwaku_nihongo/internals/abstraction"
+	"wakuwaku_nihongo/internals/model"
+)
+
+type GrammarPointRequest struct {
+	Pattern   string            `json:"pattern" validate:"required"`
+	Meaning   string            `json:"meaning" validate:"required"`
+	Formation []string          `json:"formation"`
+	JlptLevel string            `json:"jlpt_level" validate:"required,oneof=N5 N4 N3 N2 N1"`
+	Notes     *string           `json:"notes"`
+	Examples  []*ExampleRequest `json:"examples" validate:"dive"`
+}
+
+type ExampleRequest struct {
+	Sentence    string  `json:"sentence" validate:"required"`
+	Translation *string `json:"translation"`
+}
+
+type GrammarPointFilter struct {
+	JlptLevel string `query:"jlpt_level"`
+	Search    string `query:"q"`
+	abstraction.DeletedFilter
+	abstraction.Pagination
+}
+
+type LinkQuestionsRequest struct {
+	QuestionIDs []string `json:"question_ids" validate:"required,min=1,dive,uuid"`
+}
+
+type GrammarPointResponse struct {
+	GrammarPointID string             `json:"grammar_point_id"`
+	Pattern        string             `json:"pattern"`
+	Meaning        string             `json:"meaning"`
+	Formation      []string           `json:"formation"`
+	JlptLevel      string             `json:"jlpt_level"`
+	Notes          *string            `json:"notes"`
+	Examples       []*ExampleResponse `json:"examples,omitempty"`
+	DeletedAt      *int64             `json:"deleted_at,omitempty"`
+	DeletedBy      *string            `json:"deleted_by,omitempty"`
+}
+
+type ExampleResponse struct {
+	Sentence    string  `json:"sentence"`
+	Translation *string `json:"translation"`
+}
+
+func (r *GrammarPointResponse) MapFromModel(m *GrammarPoint) {
+	r.GrammarPointID = m.GrammarPointID
+	r.Pattern = m.Pattern
+	r.Meaning = m.Meaning
+	r.Formation = []string(m.Formation)
+	if r.Formation == nil {
+		r.Formation = []string{}
+	}
+	r.JlptLevel = m.JlptLevel
+	r.Notes = m.Notes
+	r.DeletedAt = m.DeletedAt.Ptr()
+	r.DeletedBy = m.DeletedBy
+	for _, e := range m.Examples {
+		r.Examples = append(r.Examples, &ExampleResponse{
+			Sentence:    e.Sentence,
+			Translation: e.Translation,
+		})
+	}
+}
+
+func (in *GrammarPointRequest) MapToModel(m *GrammarPoint) {
+	m.Pattern = in.Pattern
+	m.Meaning = in.Meaning
+	m.Formation = in.Formation
+	if m.Formation == nil {
+		m.Formation = []string{}
+	}
+	m.JlptLevel = in.JlptLevel
+	m.Notes = in.Notes
+	m.Examples = []*GrammarExample{}
+	for i, e := range in.Examples {
+		m.Examples = append(m.Examples, &GrammarExample{
+			Position:    i,
+			Sentence:    e.Sentence,
+			Translation: e.Translation,
+		})
+	}
+}
+
+type QuestionResponse struct {
+	QuestionID   string  `json:"question_id"`
+	QuizID       string  `json:"quiz_id"`
+	QuestionText string  `json:"question_text"`
+	QuestionType *string `json:"question_type"`
+}
+
+func (r *QuestionResponse) MapFromModel(m *model.Question) {
+	r.QuestionID = m.QuestionID
+	r.QuizID = m.QuizID
+	r.QuestionText = m.QuestionText
+	r.QuestionType = m.QuestionType
+}
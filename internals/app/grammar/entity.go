@@ -0,0 +1,81 @@
+package grammar
+
+import (
+	"time"
+
+	"wakuwaku_nihongo/internals/pkg/softdelete"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+type GrammarPoint struct {
+	GrammarPointID string                      `gorm:"column:grammar_point_id;type:uuid;primaryKey" json:"grammar_point_id"`
+	CreatedAt      int64                       `gorm:"column:created_at;type:bigint;not null" json:"created_at"`
+	ModifiedAt     *int64                      `gorm:"column:modified_at;type:bigint" json:"modified_at"`
+	DeletedAt      softdelete.DeletedAt        `gorm:"column:deleted_at;type:bigint" json:"deleted_at"`
+	CreatedBy      string                      `gorm:"column:created_by;type:character varying;not null" json:"created_by"`
+	ModifiedBy     *string                     `gorm:"column:modified_by;type:character varying" json:"modified_by"`
+	DeletedBy      *string                     `gorm:"column:deleted_by;type:character varying" json:"deleted_by"`
+	Pattern        string                      `gorm:"column:pattern;type:character varying;not null" json:"pattern"`
+	Meaning        string                      `gorm:"column:meaning;type:character varying;not null" json:"meaning"`
+	Formation      datatypes.JSONSlice[string] `gorm:"column:formation;type:jsonb;not null" json:"formation"`
+	JlptLevel      string                      `gorm:"column:jlpt_level;type:character varying;not null" json:"jlpt_level"`
+	Notes          *string                     `gorm:"column:notes;type:character varying" json:"notes"`
+	Examples       []*GrammarExample           `gorm:"foreignKey:grammar_point_id;references:grammar_point_id" json:"examples"`
+}
+
+func (*GrammarPoint) TableName() string {
+	return "grammar_points"
+}
+
+func (m *GrammarPoint) BeforeCreate(tx *gorm.DB) (err error) {
+	m.CreatedAt = time.Now().UnixMilli()
+	if m.GrammarPointID == "" {
+		m.GrammarPointID = uuid.NewString()
+	}
+	return
+}
+
+func (m *GrammarPoint) BeforeUpdate(tx *gorm.DB) (err error) {
+	now := time.Now().UnixMilli()
+	m.ModifiedAt = &now
+	return
+}
+
+type GrammarExample struct {
+	GrammarExampleID string  `gorm:"column:grammar_example_id;type:uuid;primaryKey" json:"grammar_example_id"`
+	GrammarPointID   string  `gorm:"column:grammar_point_id;type:uuid;not null" json:"grammar_point_id"`
+	Position         int     `gorm:"column:position;type:integer;not null" json:"position"`
+	Sentence         string  `gorm:"column:sentence;type:character varying;not null" json:"sentence"`
+	Translation      *string `gorm:"column:translation;type:character varying" json:"translation"`
+}
+
+func (*GrammarExample) TableName() string {
+	return "grammar_examples"
+}
+
+func (m *GrammarExample) BeforeCreate(tx *gorm.DB) (err error) {
+	if m.GrammarExampleID == "" {
+		m.GrammarExampleID = uuid.NewString()
+	}
+	return
+}
+
+// QuestionGrammarPoint links a model.Question to a grammar point it tests.
+type QuestionGrammarPoint struct {
+	QuestionID     string `gorm:"column:question_id;type:uuid;primaryKey" json:"question_id"`
+	GrammarPointID string `gorm:"column:grammar_point_id;type:uuid;primaryKey" json:"grammar_point_id"`
+	CreatedAt      int64  `gorm:"column:created_at;type:bigint;not null" json:"created_at"`
+	CreatedBy      string `gorm:"column:created_by;type:character varying;not null" json:"created_by"`
+}
+
+func (*QuestionGrammarPoint) TableName() string {
+	return "question_grammar_points"
+}
+
+func (m *QuestionGrammarPoint) BeforeCreate(tx *gorm.DB) (err error) {
+	m.CreatedAt = time.Now().UnixMilli()
+	return
+}
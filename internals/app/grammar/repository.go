@@ -0,0 +1,164 @@
+package grammar
+
+import (
+	"wakuwaku_nihongo/internals/abstraction"
+	"wakuwaku_nihongo/internals/app/quizzes"
+	"wakuwaku_nihongo/internals/model"
+	"wakuwaku_nihongo/internals/pkg/softdelete"
+	"wakuwaku_nihongo/internals/pkg/sqlutil"
+	"wakuwaku_nihongo/internals/query"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type repo struct {
+	db *gorm.DB
+	*query.Query
+}
+
+func NewRepo(db *gorm.DB) *repo {
+	return &repo{
+		db:    db,
+		Query: query.Use(db),
+	}
+}
+
+func (r *repo) List(ctx echo.Context, filter *GrammarPointFilter) (out []*GrammarPoint, count int64, err error) {
+	db := r.db.Model(&GrammarPoint{})
+	if filter.IncludeDeleted {
+		db = db.Unscoped()
+	}
+	if filter.JlptLevel != "" {
+		db = db.Where("jlpt_level = ?", filter.JlptLevel)
+	}
+	if filter.Search != "" {
+		like := sqlutil.Contains(filter.Search)
+		db = db.Where("pattern ILIKE ? OR meaning ILIKE ?", like, like)
+	}
+	db = db.Session(&gorm.Session{})
+
+	if err = db.Count(&count).Error; err != nil {
+		return
+	}
+
+	out = []*GrammarPoint{}
+	sortBy := *filter.SortBy
+	if !SORTABLE_COLUMNS[sortBy] {
+		sortBy = DEFAULT_SORT_BY
+	}
+	err = db.Order(clause.OrderByColumn{
+		Column: clause.Column{Name: sortBy},
+		Desc:   filter.GetOrderBy() == "desc",
+	}).
+		Limit(filter.Limit()).
+		Offset(filter.Offset()).
+		Find(&out).Error
+	return
+}
+
+func (r *repo) GetByID(ctx echo.Context, id string) (out *GrammarPoint, err error) {
+	out = &GrammarPoint{}
+	err = r.db.Where("grammar_point_id = ?", id).
+		Preload("Examples", func(db *gorm.DB) *gorm.DB { return db.Order("position") }).
+		First(out).Error
+	return
+}
+
+func (r *repo) Create(ctx echo.Context, in *GrammarPoint) (err error) {
+	return r.db.Create(in).Error
+}
+
+// Update overwrites the grammar point and replaces its examples.
+func (r *repo) Update(ctx echo.Context, in *GrammarPoint) (err error) {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Omit(clause.Associations).
+			Select("pattern", "meaning", "formation", "jlpt_level", "notes", "modified_at", "modified_by").
+			Updates(in).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Where("grammar_point_id = ?", in.GrammarPointID).Delete(&GrammarExample{}).Error
+		if err != nil {
+			return err
+		}
+
+		if len(in.Examples) == 0 {
+			return nil
+		}
+		for _, e := range in.Examples {
+			e.GrammarPointID = in.GrammarPointID
+		}
+		return tx.Create(in.Examples).Error
+	})
+}
+
+func (r *repo) Delete(ctx echo.Context, id string, deletedBy string) (err error) {
+	return softdelete.By(r.db, deletedBy).Where("grammar_point_id = ?", id).Delete(&GrammarPoint{}).Error
+}
+
+// Restore undeletes the grammar point, restored is false when it was not
+// deleted.
+func (r *repo) Restore(ctx echo.Context, id string) (restored bool, err error) {
+	res := softdelete.Restore(r.db.Model(&GrammarPoint{}).Where("grammar_point_id = ?", id))
+	return res.RowsAffected > 0, res.Error
+}
+
+func (r *repo) GetQuestions(ctx echo.Context, grammarPointID string, p *abstraction.Pagination) (out []*model.Question, count int64, err error) {
+	db := r.db.Model(&model.Question{}).
+		Joins("JOIN question_grammar_points ON question_grammar_points.question_id = questions.question_id").
+		Where("question_grammar_points.grammar_point_id = ?", grammarPointID).
+		Session(&gorm.Session{})
+
+	if err = db.Count(&count).Error; err != nil {
+		return
+	}
+
+	out = []*model.Question{}
+	err = db.Order("questions.created_at asc").
+		Limit(p.Limit()).
+		Offset(p.Offset()).
+		Find(&out).Error
+	return
+}
+
+func (r *repo) GetExistingQuestionIDs(ctx echo.Context, questionIDs []string) (out []string, err error) {
+	q := r.Question
+	questions, err := q.Where(q.QuestionID.In(questionIDs...)).
+		Select(q.QuestionID).Find()
+	if err != nil {
+		return
+	}
+	out = []string{}
+	for _, val := range questions {
+		out = append(out, val.QuestionID)
+	}
+	return
+}
+
+func (r *repo) LinkQuestions(ctx echo.Context, in []*QuestionGrammarPoint) (err error) {
+	return r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&in).Error
+}
+
+func (r *repo) UnlinkQuestion(ctx echo.Context, grammarPointID string, questionID string) (err error) {
+	return r.db.Where("grammar_point_id = ? AND question_id = ?", grammarPointID, questionID).
+		Delete(&QuestionGrammarPoint{}).Error
+}
+
+// GetByQuestionID returns the grammar points a question practices, none
+// when published is set and the question is not in a published quiz.
+func (r *repo) GetByQuestionID(ctx echo.Context, questionID string, published bool) (out []*GrammarPoint, err error) {
+	out = []*GrammarPoint{}
+	db := r.db.Model(&GrammarPoint{}).
+		Joins("JOIN question_grammar_points ON question_grammar_points.grammar_point_id = grammar_points.grammar_point_id").
+		Where("question_grammar_points.question_id = ?", questionID)
+	if published {
+		db = db.Joins("JOIN questions q ON q.question_id = question_grammar_points.question_id AND q.deleted_at IS NULL").
+			Joins("JOIN quizzes z ON z.quiz_id = q.quiz_id AND z.deleted_at IS NULL AND z.status = ?", quizzes.QUIZ_STATUS_PUBLISHED)
+	}
+	err = db.Order("grammar_points.jlpt_level desc, grammar_points.pattern asc").
+		Find(&out).Error
+	return
+}
@@ -0,0 +1,26 @@
+package grammar
+
+import (
+	"github.com/labstack/echo/v4"
+	"wakuwaku_nihongo/internals/middleware"
+	"wakuwaku_nihongo/internals/utils/token"
+)
+
+func (h *handler) Route(g *echo.Group) {
+	editor := middleware.Authorization(token.ROLE_EDITOR)
+	admin := middleware.Authorization(token.ROLE_ADMIN)
+
+	g.GET("", h.List, middleware.Authentication)
+	g.GET("/:id", h.Get, middleware.Authentication)
+	g.POST("", h.Create, middleware.Authentication, editor)
+	g.PUT("/:id", h.Update, middleware.Authentication, editor)
+	g.DELETE("/:id", h.Delete, middleware.Authentication, editor)
+	g.POST("/:id/restore", h.Restore, middleware.Authentication, admin)
+	g.GET("/:id/questions", h.GetQuestions, middleware.Authentication, editor)
+	g.POST("/:id/questions", h.LinkQuestions, middleware.Authentication, editor)
+	g.DELETE("/:id/questions/:question_id", h.UnlinkQuestion, middleware.Authentication, editor)
+}
+
+func (h *handler) QuestionRoute(g *echo.Group) {
+	g.GET("/:question_id/grammar-points", h.GetByQuestion, middleware.Authentication)
+}
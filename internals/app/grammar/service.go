@@ -0,0 +1,230 @@
+package grammar
+
+import (
+	"errors"
+	"fmt"
+
+	"wakuwaku_nihongo/config"
+	"wakuwaku_nihongo/internals/abstraction"
+	"wakuwaku_nihongo/internals/app/quizzes"
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/middleware"
+	"wakuwaku_nihongo/internals/model"
+	"wakuwaku_nihongo/internals/utils/response"
+	"wakuwaku_nihongo/internals/utils/token"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+type IGrammarRepo interface {
+	List(ctx echo.Context, filter *GrammarPointFilter) (out []*GrammarPoint, count int64, err error)
+	GetByID(ctx echo.Context, id string) (out *GrammarPoint, err error)
+	Create(ctx echo.Context, in *GrammarPoint) (err error)
+	Update(ctx echo.Context, in *GrammarPoint) (err error)
+	Delete(ctx echo.Context, id string, deletedBy string) (err error)
+	Restore(ctx echo.Context, id string) (restored bool, err error)
+	GetQuestions(ctx echo.Context, grammarPointID string, p *abstraction.Pagination) (out []*model.Question, count int64, err error)
+	GetExistingQuestionIDs(ctx echo.Context, questionIDs []string) (out []string, err error)
+	LinkQuestions(ctx echo.Context, in []*QuestionGrammarPoint) (err error)
+	UnlinkQuestion(ctx echo.Context, grammarPointID string, questionID string) (err error)
+	GetByQuestionID(ctx echo.Context, questionID string, published bool) (out []*GrammarPoint, err error)
+}
+
+type service struct {
+	repo IGrammarRepo
+}
+
+func NewService(f *factory.Factory) *service {
+	return &service{
+		repo: NewRepo(f.Db),
+	}
+}
+
+func (s *service) List(ctx echo.Context, filter *GrammarPointFilter) (out []*GrammarPointResponse, info *abstraction.PaginationInfo, err error) {
+	if filter.IncludeDeleted && middleware.GetRole(ctx) != token.ROLE_ADMIN {
+		err = response.ErrorWrap(response.ErrForbidden, fmt.Errorf("only admins can include deleted grammar points"))
+		return
+	}
+
+	points, count, err := s.repo.List(ctx, filter)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	out = []*GrammarPointResponse{}
+	for _, val := range points {
+		point := &GrammarPointResponse{}
+		point.MapFromModel(val)
+		out = append(out, point)
+	}
+	info = filter.Pagination.CreatePageInfo(count)
+	return
+}
+
+func (s *service) Get(ctx echo.Context, id string) (out *GrammarPointResponse, err error) {
+	point, err := s.getByID(ctx, id)
+	if err != nil {
+		return
+	}
+	out = &GrammarPointResponse{}
+	out.MapFromModel(point)
+	return
+}
+
+func (s *service) Create(ctx echo.Context, in *GrammarPointRequest) (out *GrammarPointResponse, err error) {
+	point := &GrammarPoint{}
+	in.MapToModel(point)
+	point.CreatedBy = middleware.GetUserID(ctx)
+
+	err = s.repo.Create(ctx, point)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	out = &GrammarPointResponse{}
+	out.MapFromModel(point)
+	return
+}
+
+func (s *service) Update(ctx echo.Context, id string, in *GrammarPointRequest) (out *GrammarPointResponse, err error) {
+	point, err := s.getByID(ctx, id)
+	if err != nil {
+		return
+	}
+
+	in.MapToModel(point)
+	userID := middleware.GetUserID(ctx)
+	point.ModifiedBy = &userID
+
+	err = s.repo.Update(ctx, point)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	out = &GrammarPointResponse{}
+	out.MapFromModel(point)
+	return
+}
+
+func (s *service) Delete(ctx echo.Context, id string) (err error) {
+	if _, err = s.getByID(ctx, id); err != nil {
+		return
+	}
+
+	err = s.repo.Delete(ctx, id, middleware.GetUserID(ctx))
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	return
+}
+
+// Restore undeletes a grammar point (admin only).
+func (s *service) Restore(ctx echo.Context, id string) (out *GrammarPointResponse, err error) {
+	restored, err := s.repo.Restore(ctx, id)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	if !restored {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("deleted grammar point not found"))
+		return
+	}
+	return s.Get(ctx, id)
+}
+
+func (s *service) GetQuestions(ctx echo.Context, id string, p *abstraction.Pagination) (out []*QuestionResponse, info *abstraction.PaginationInfo, err error) {
+	if _, err = s.getByID(ctx, id); err != nil {
+		return
+	}
+
+	questions, count, err := s.repo.GetQuestions(ctx, id, p)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	out = []*QuestionResponse{}
+	for _, val := range questions {
+		question := &QuestionResponse{}
+		question.MapFromModel(val)
+		out = append(out, question)
+	}
+	info = p.CreatePageInfo(count)
+	return
+}
+
+func (s *service) LinkQuestions(ctx echo.Context, id string, in *LinkQuestionsRequest) (err error) {
+	if _, err = s.getByID(ctx, id); err != nil {
+		return
+	}
+
+	questionIDs := config.UniqueStrings(in.QuestionIDs)
+	existing, err := s.repo.GetExistingQuestionIDs(ctx, questionIDs)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	if len(existing) != len(questionIDs) {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("some questions do not exist"))
+		return
+	}
+
+	links := []*QuestionGrammarPoint{}
+	userID := middleware.GetUserID(ctx)
+	for _, questionID := range existing {
+		links = append(links, &QuestionGrammarPoint{
+			QuestionID:     questionID,
+			GrammarPointID: id,
+			CreatedBy:      userID,
+		})
+	}
+
+	err = s.repo.LinkQuestions(ctx, links)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	return
+}
+
+func (s *service) UnlinkQuestion(ctx echo.Context, id string, questionID string) (err error) {
+	err = s.repo.UnlinkQuestion(ctx, id, questionID)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	return
+}
+
+// GetByQuestion returns the grammar points a question practices, learners
+// only get those of questions in published quizzes.
+func (s *service) GetByQuestion(ctx echo.Context, questionID string) (out []*GrammarPointResponse, err error) {
+	published := !quizzes.CanPreview(middleware.GetRole(ctx))
+	points, err := s.repo.GetByQuestionID(ctx, questionID, published)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	out = []*GrammarPointResponse{}
+	for _, val := range points {
+		point := &GrammarPointResponse{}
+		point.MapFromModel(val)
+		out = append(out, point)
+	}
+	return
+}
+
+func (s *service) getByID(ctx echo.Context, id string) (out *GrammarPoint, err error) {
+	out, err = s.repo.GetByID(ctx, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("grammar point not found"))
+		return
+	}
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	return
+}
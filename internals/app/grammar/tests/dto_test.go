@@ -0,0 +1,64 @@
+package tests
+
+import (
+	"testing"
+
+	"wakuwaku_nihongo/internals/app/grammar"
+	"wakuwaku_nihongo/internals/pkg/softdelete"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGrammarPointRequestMapToModel(t *testing.T) {
+	translation := "I eat sushi."
+	in := &grammar.GrammarPointRequest{
+		Pattern:   "〜ている",
+		Meaning:   "ongoing action",
+		JlptLevel: "N5",
+		Examples: []*grammar.ExampleRequest{
+			{Sentence: "すしを食べている。", Translation: &translation},
+			{Sentence: "雨が降っている。"},
+		},
+	}
+
+	m := &grammar.GrammarPoint{}
+	in.MapToModel(m)
+	assert.Equal(t, "〜ている", m.Pattern)
+	assert.NotNil(t, m.Formation, "formation is stored as an empty list")
+	assert.Empty(t, m.Formation)
+	assert.Len(t, m.Examples, 2)
+	assert.Equal(t, 0, m.Examples[0].Position)
+	assert.Equal(t, &translation, m.Examples[0].Translation)
+	assert.Equal(t, 1, m.Examples[1].Position)
+	assert.Nil(t, m.Examples[1].Translation)
+
+	in.Examples = nil
+	in.MapToModel(m)
+	assert.Empty(t, m.Examples, "examples are replaced on update")
+}
+
+func TestGrammarPointResponseMapFromModel(t *testing.T) {
+	m := &grammar.GrammarPoint{
+		GrammarPointID: "gp",
+		Pattern:        "〜たい",
+		Meaning:        "want to",
+		JlptLevel:      "N5",
+		Examples:       []*grammar.GrammarExample{{Sentence: "日本に行きたい。"}},
+	}
+
+	res := &grammar.GrammarPointResponse{}
+	res.MapFromModel(m)
+	assert.Equal(t, "gp", res.GrammarPointID)
+	assert.Equal(t, []string{}, res.Formation)
+	assert.Len(t, res.Examples, 1)
+	assert.Equal(t, "日本に行きたい。", res.Examples[0].Sentence)
+	assert.Nil(t, res.DeletedAt)
+
+	admin := "admin"
+	m.DeletedAt = softdelete.At(1000)
+	m.DeletedBy = &admin
+	res = &grammar.GrammarPointResponse{}
+	res.MapFromModel(m)
+	assert.Equal(t, int64(1000), *res.DeletedAt)
+	assert.Equal(t, &admin, res.DeletedBy)
+}
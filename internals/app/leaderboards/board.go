@@ -0,0 +1,89 @@
+package leaderboards
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// PeriodKey names the period containing t, weeks are ISO weeks starting on
+// Monday.
+func PeriodKey(period string, t time.Time) string {
+	switch period {
+	case PERIOD_WEEKLY:
+		year, week := t.ISOWeek()
+		return fmt.Sprintf("%d-W%02d", year, week)
+	case PERIOD_MONTHLY:
+		return t.Format("2006-01")
+	}
+	return PERIOD_ALL_TIME
+}
+
+// PeriodRange returns the start and the exclusive end of the period
+// containing t, in the location of t.
+func PeriodRange(period string, t time.Time) (from, to time.Time) {
+	y, m, d := t.Date()
+	switch period {
+	case PERIOD_WEEKLY:
+		from = time.Date(y, m, d-(int(t.Weekday())+6)%7, 0, 0, 0, 0, t.Location())
+		return from, from.AddDate(0, 0, 7)
+	case PERIOD_MONTHLY:
+		from = time.Date(y, m, 1, 0, 0, 0, 0, t.Location())
+		return from, from.AddDate(0, 1, 0)
+	}
+	return time.UnixMilli(0), time.UnixMilli(math.MaxInt64)
+}
+
+func Key(period, level, periodKey string) string {
+	return fmt.Sprintf("%s:%s:%s:%s", KEY_PREFIX, period, level, periodKey)
+}
+
+// Keys returns the boards XP of level earned at t counts in, with the ttl of
+// each key in seconds.
+func Keys(level *string, t time.Time) map[string]int {
+	levels := []string{LEVEL_ALL}
+	if level != nil && *level != "" {
+		levels = append(levels, *level)
+	}
+
+	out := map[string]int{}
+	for _, l := range levels {
+		out[Key(PERIOD_WEEKLY, l, PeriodKey(PERIOD_WEEKLY, t))] = WEEKLY_TTL
+		out[Key(PERIOD_MONTHLY, l, PeriodKey(PERIOD_MONTHLY, t))] = MONTHLY_TTL
+		out[Key(PERIOD_ALL_TIME, l, PeriodKey(PERIOD_ALL_TIME, t))] = 0
+	}
+	return out
+}
+
+// Rank orders customerIDs by their score, highest first and ties by id.
+// Customers without a score are ranked with 0 XP.
+func Rank(customerIDs []string, scores map[string]float64) []*EntryResponse {
+	out := make([]*EntryResponse, 0, len(customerIDs))
+	seen := map[string]bool{}
+	for _, id := range customerIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		out = append(out, &EntryResponse{CustomerID: id, XP: int64(scores[id])})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].XP != out[j].XP {
+			return out[i].XP > out[j].XP
+		}
+		return out[i].CustomerID < out[j].CustomerID
+	})
+	for i, e := range out {
+		e.Rank = int64(i + 1)
+	}
+	return out
+}
+
+// PageOf returns the page of pageSize entries holding the 1 based rank.
+func PageOf(rank int64, pageSize int) int {
+	if rank <= 0 || pageSize <= 0 {
+		return 1
+	}
+	return int((rank-1)/int64(pageSize)) + 1
+}
@@ -0,0 +1,33 @@
+package leaderboards
+
+import "time"
+
+const (
+	PERIOD_WEEKLY   = "weekly"
+	PERIOD_MONTHLY  = "monthly"
+	PERIOD_ALL_TIME = "all_time"
+
+	SCOPE_GLOBAL  = "global"
+	SCOPE_FRIENDS = "friends"
+
+	// LEVEL_ALL is the board of the XP earned at every JLPT level.
+	LEVEL_ALL = "all"
+
+	KEY_PREFIX = "leaderboard"
+
+	// a weekly or monthly board outlives its period long enough to be
+	// archived, in seconds
+	WEEKLY_TTL  = 15 * 24 * 60 * 60
+	MONTHLY_TTL = 63 * 24 * 60 * 60
+
+	MAX_PAGE_SIZE = 100
+
+	ARCHIVE_INTERVAL = time.Hour
+	ARCHIVE_JOB_NAME = "leaderboard_archive"
+)
+
+// LEVELS are the boards an XP event of a level is counted in, besides
+// LEVEL_ALL.
+var LEVELS = []string{"N5", "N4", "N3", "N2", "N1"}
+
+var PERIODS = []string{PERIOD_WEEKLY, PERIOD_MONTHLY, PERIOD_ALL_TIME}
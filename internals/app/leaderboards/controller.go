@@ -0,0 +1,115 @@
+package leaderboards
+
+import (
+	"wakuwaku_nihongo/internals/abstraction"
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ILeaderboardService interface {
+	Get(ctx echo.Context, filter *LeaderboardFilter) (out *LeaderboardResponse, info *abstraction.PaginationInfo, err error)
+	Archive(ctx echo.Context, filter *ArchiveFilter) (out *ArchiveResponse, info *abstraction.PaginationInfo, err error)
+	Rebuild(ctx echo.Context) (out *RebuildResponse, err error)
+}
+
+type handler struct {
+	service ILeaderboardService
+}
+
+func NewHandler(f *factory.Factory) *handler {
+	return &handler{
+		service: NewService(f),
+	}
+}
+
+// @Summary Get Leaderboard
+// @Description Get the XP leaderboard of the current week, month or all time for a JLPT level, among everyone or the logged in customer's friends. around_me moves to the page holding the caller's rank. Weeks start on Monday in DB_TZ
+// @Tags leaderboard
+// @Produce json
+// @Param period query string false "Period" Enums(weekly, monthly, all_time)
+// @Param jlpt_level query string false "JLPT level" Enums(N5, N4, N3, N2, N1, all)
+// @Param scope query string false "Scope" Enums(global, friends)
+// @Param around_me query bool false "Show the page of the caller's rank"
+// @Param page query int false "Page"
+// @Param page_size query int false "Page size, at most 100"
+// @Success 200 {object} response.SuccessResponseWithInfo{data=LeaderboardResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 401 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Failure 503 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/leaderboards [get]
+func (h *handler) Get(c echo.Context) error {
+	req := &LeaderboardFilter{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+	req.SetDefault()
+
+	res, info, err := h.service.Get(c, req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponseInfo(res, info).Send(c)
+}
+
+// @Summary Get Archived Leaderboard
+// @Description Get the final ranks of a past week, the last finished week by default. Weeks are archived within an hour after they end
+// @Tags leaderboard
+// @Produce json
+// @Param week query string false "ISO week, e.g. 2026-W07"
+// @Param jlpt_level query string false "JLPT level" Enums(N5, N4, N3, N2, N1, all)
+// @Param page query int false "Page"
+// @Param page_size query int false "Page size"
+// @Success 200 {object} response.SuccessResponseWithInfo{data=ArchiveResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 401 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/leaderboards/archive [get]
+func (h *handler) Archive(c echo.Context) error {
+	req := &ArchiveFilter{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+	req.Pagination.SetDefault()
+
+	res, info, err := h.service.Archive(c, req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponseInfo(res, info).Send(c)
+}
+
+// @Summary Rebuild Leaderboards
+// @Description Rebuild the current weekly, monthly and all time boards in Redis from the XP ledger (admin only)
+// @Tags leaderboard
+// @Produce json
+// @Success 200 {object} response.Success{data=RebuildResponse}
+// @Failure 401 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Failure 503 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/leaderboards/rebuild [post]
+func (h *handler) Rebuild(c echo.Context) error {
+	res, err := h.service.Rebuild(c)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
@@ -0,0 +1,65 @@
+package leaderboards
+
+import "wakuwaku_nihongo/internals/abstraction"
+
+type LeaderboardFilter struct {
+	Period    string `query:"period" validate:"omitempty,oneof=weekly monthly all_time"`
+	JlptLevel string `query:"jlpt_level" validate:"omitempty,oneof=N5 N4 N3 N2 N1 all"`
+	Scope     string `query:"scope" validate:"omitempty,oneof=global friends"`
+	AroundMe  bool   `query:"around_me"`
+	abstraction.Pagination
+}
+
+type ArchiveFilter struct {
+	Week      string `query:"week" validate:"omitempty,len=8"`
+	JlptLevel string `query:"jlpt_level" validate:"omitempty,oneof=N5 N4 N3 N2 N1 all"`
+	abstraction.Pagination
+}
+
+type EntryResponse struct {
+	Rank       int64  `json:"rank"`
+	CustomerID string `json:"customer_id"`
+	Username   string `json:"username"`
+	XP         int64  `json:"xp"`
+}
+
+type LeaderboardResponse struct {
+	Period    string           `json:"period"`
+	PeriodKey string           `json:"period_key"`
+	JlptLevel string           `json:"jlpt_level"`
+	Scope     string           `json:"scope"`
+	Me        *EntryResponse   `json:"me"`
+	Entries   []*EntryResponse `json:"entries"`
+}
+
+type ArchiveResponse struct {
+	Week      string           `json:"week"`
+	JlptLevel string           `json:"jlpt_level"`
+	Entries   []*EntryResponse `json:"entries"`
+}
+
+type RebuildResponse struct {
+	Boards int `json:"boards"`
+}
+
+// SetDefault fills the defaults of the filter and caps the page size.
+func (f *LeaderboardFilter) SetDefault() {
+	if f.Period == "" {
+		f.Period = PERIOD_WEEKLY
+	}
+	if f.JlptLevel == "" {
+		f.JlptLevel = LEVEL_ALL
+	}
+	if f.Scope == "" {
+		f.Scope = SCOPE_GLOBAL
+	}
+	f.Pagination.SetDefault()
+	f.PageSize = min(f.PageSize, MAX_PAGE_SIZE)
+}
+
+func (r *EntryResponse) MapFromModel(m *snapshotWithName) {
+	r.Rank = m.Rank
+	r.CustomerID = m.CustomerID
+	r.Username = m.Username
+	r.XP = m.XP
+}
@@ -0,0 +1,38 @@
+package leaderboards
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Snapshot is the archived rank of a customer on a weekly board.
+type Snapshot struct {
+	PeriodType string `gorm:"column:period_type;type:character varying;primaryKey" json:"period_type"`
+	PeriodKey  string `gorm:"column:period_key;type:character varying;primaryKey" json:"period_key"`
+	JlptLevel  string `gorm:"column:jlpt_level;type:character varying;primaryKey" json:"jlpt_level"`
+	CustomerID string `gorm:"column:customer_id;type:uuid;primaryKey" json:"customer_id"`
+	Rank       int64  `gorm:"column:rank;type:integer;not null" json:"rank"`
+	XP         int64  `gorm:"column:xp;type:bigint;not null" json:"xp"`
+	CreatedAt  int64  `gorm:"column:created_at;type:bigint;not null" json:"created_at"`
+}
+
+func (*Snapshot) TableName() string {
+	return "leaderboard_snapshots"
+}
+
+func (m *Snapshot) BeforeCreate(tx *gorm.DB) (err error) {
+	m.CreatedAt = time.Now().UnixMilli()
+	return
+}
+
+// Score is the XP a customer earned in a period.
+type Score struct {
+	CustomerID string
+	XP         int64
+}
+
+type snapshotWithName struct {
+	Snapshot
+	Username string
+}
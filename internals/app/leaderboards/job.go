@@ -0,0 +1,78 @@
+package leaderboards
+
+import (
+	"context"
+	"time"
+
+	"wakuwaku_nihongo/internals/app/preferences"
+	"wakuwaku_nihongo/internals/factory"
+)
+
+type job struct {
+	repo ILeaderboardRepo
+}
+
+func NewJob(f *factory.Factory) *job {
+	return &job{
+		repo: NewRepo(f.Db),
+	}
+}
+
+// Run archives the last finished week, it is scheduled every
+// ARCHIVE_INTERVAL and does nothing once the week is archived.
+func (j *job) Run(ctx context.Context) (err error) {
+	_, err = j.Archive(ctx, time.Now())
+	return
+}
+
+// Archive snapshots the boards of the week before the one of now from the
+// XP ledger and returns the number of boards archived. The weekly keys in
+// Redis expire on their own, the new week starts on a new key.
+func (j *job) Archive(ctx context.Context, now time.Time) (archived int, err error) {
+	lastWeek := now.In(preferences.LoadLocation(nil)).AddDate(0, 0, -7)
+	from, to := PeriodRange(PERIOD_WEEKLY, lastWeek)
+	periodKey := PeriodKey(PERIOD_WEEKLY, lastWeek)
+
+	for _, level := range append([]string{LEVEL_ALL}, LEVELS...) {
+		exists, err := j.repo.HasSnapshot(ctx, periodKey, level)
+		if err != nil {
+			return archived, err
+		}
+		if exists {
+			continue
+		}
+
+		scores, err := j.repo.GetScores(ctx, level, from.UnixMilli(), to.UnixMilli())
+		if err != nil {
+			return archived, err
+		}
+		if err = j.repo.CreateSnapshot(ctx, Snapshots(periodKey, level, scores)); err != nil {
+			return archived, err
+		}
+		if len(scores) > 0 {
+			archived++
+		}
+	}
+	return
+}
+
+// Snapshots ranks the scores of a board, customers with the same XP share
+// a rank.
+func Snapshots(periodKey, level string, scores []*Score) []*Snapshot {
+	out := make([]*Snapshot, 0, len(scores))
+	for i, sc := range scores {
+		rank := int64(i + 1)
+		if i > 0 && sc.XP == scores[i-1].XP {
+			rank = out[i-1].Rank
+		}
+		out = append(out, &Snapshot{
+			PeriodType: PERIOD_WEEKLY,
+			PeriodKey:  periodKey,
+			JlptLevel:  level,
+			CustomerID: sc.CustomerID,
+			Rank:       rank,
+			XP:         sc.XP,
+		})
+	}
+	return out
+}
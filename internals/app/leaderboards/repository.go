@@ -0,0 +1,94 @@
+package leaderboards
+
+import (
+	"context"
+
+	"wakuwaku_nihongo/internals/abstraction"
+	"wakuwaku_nihongo/internals/app/xp"
+	"wakuwaku_nihongo/internals/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// The methods take a context.Context rather than an echo.Context as they
+// are shared with the archive job, which runs outside of a request.
+type repo struct {
+	db *gorm.DB
+}
+
+func NewRepo(db *gorm.DB) *repo {
+	return &repo{
+		db: db,
+	}
+}
+
+// GetScores sums the XP ledger per customer between from and the exclusive
+// to, in unix milliseconds, highest first.
+func (r *repo) GetScores(ctx context.Context, level string, from, to int64) (out []*Score, err error) {
+	out = []*Score{}
+	db := r.db.WithContext(ctx).Model(&xp.Event{}).
+		Select("customer_id, SUM(amount) AS xp").
+		Where("earned_at >= ? AND earned_at < ?", from, to)
+	if level != LEVEL_ALL {
+		db = db.Where("jlpt_level = ?", level)
+	}
+	err = db.Group("customer_id").
+		Order("xp DESC, customer_id").
+		Scan(&out).Error
+	return
+}
+
+func (r *repo) GetUsernames(ctx context.Context, customerIDs []string) (out map[string]string, err error) {
+	out = map[string]string{}
+	if len(customerIDs) == 0 {
+		return
+	}
+	customers := []*model.Customer{}
+	err = r.db.WithContext(ctx).Select("customer_id, username").
+		Where("customer_id IN ?", customerIDs).
+		Find(&customers).Error
+	for _, c := range customers {
+		out[c.CustomerID] = c.Username
+	}
+	return
+}
+
+func (r *repo) HasSnapshot(ctx context.Context, periodKey, level string) (exists bool, err error) {
+	var count int64
+	err = r.db.WithContext(ctx).Model(&Snapshot{}).
+		Where("period_type = ? AND period_key = ? AND jlpt_level = ?", PERIOD_WEEKLY, periodKey, level).
+		Limit(1).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// CreateSnapshot stores the ranks of a board, a board archived before is
+// kept as it was.
+func (r *repo) CreateSnapshot(ctx context.Context, in []*Snapshot) (err error) {
+	if len(in) == 0 {
+		return
+	}
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		CreateInBatches(in, 500).Error
+}
+
+func (r *repo) ListSnapshot(ctx context.Context, periodKey, level string, p *abstraction.Pagination) (out []*snapshotWithName, count int64, err error) {
+	db := r.db.WithContext(ctx).Table("leaderboard_snapshots s").
+		Where("s.period_type = ? AND s.period_key = ? AND s.jlpt_level = ?", PERIOD_WEEKLY, periodKey, level).
+		Session(&gorm.Session{})
+
+	if err = db.Count(&count).Error; err != nil {
+		return
+	}
+
+	out = []*snapshotWithName{}
+	err = db.Select("s.*, c.username").
+		Joins("LEFT JOIN customers c ON c.customer_id = s.customer_id").
+		Order("s.rank, s.customer_id").
+		Limit(p.Limit()).
+		Offset(p.Offset()).
+		Scan(&out).Error
+	return
+}
@@ -0,0 +1,15 @@
+package leaderboards
+
+import (
+	"github.com/labstack/echo/v4"
+	"wakuwaku_nihongo/internals/middleware"
+	"wakuwaku_nihongo/internals/utils/token"
+)
+
+func (h *handler) Route(g *echo.Group) {
+	admin := middleware.Authorization(token.ROLE_ADMIN)
+
+	g.GET("", h.Get, middleware.Authentication)
+	g.GET("/archive", h.Archive, middleware.Authentication)
+	g.POST("/rebuild", h.Rebuild, middleware.Authentication, admin)
+}
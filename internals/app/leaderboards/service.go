@@ -0,0 +1,233 @@
+package leaderboards
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"wakuwaku_nihongo/internals/abstraction"
+	"wakuwaku_nihongo/internals/app/friends"
+	"wakuwaku_nihongo/internals/app/preferences"
+	"wakuwaku_nihongo/internals/app/xp"
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/middleware"
+	"wakuwaku_nihongo/internals/pkg/redisutil"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ILeaderboardRepo interface {
+	GetScores(ctx context.Context, level string, from, to int64) (out []*Score, err error)
+	GetUsernames(ctx context.Context, customerIDs []string) (out map[string]string, err error)
+	HasSnapshot(ctx context.Context, periodKey, level string) (exists bool, err error)
+	CreateSnapshot(ctx context.Context, in []*Snapshot) (err error)
+	ListSnapshot(ctx context.Context, periodKey, level string, p *abstraction.Pagination) (out []*snapshotWithName, count int64, err error)
+}
+
+type IFriendProvider interface {
+	FriendIDs(ctx echo.Context, customerID string) (out []string, err error)
+}
+
+type service struct {
+	repo    ILeaderboardRepo
+	redis   *redisutil.Redis
+	friends IFriendProvider
+}
+
+func NewService(f *factory.Factory) *service {
+	return &service{
+		repo:    NewRepo(f.Db),
+		redis:   f.Redis,
+		friends: friends.NewService(f),
+	}
+}
+
+// Add counts an awarded XP event on its boards. The ledger stays the source
+// of truth, a failed update is repaired by Rebuild.
+func (s *service) Add(ctx echo.Context, event *xp.Event) (err error) {
+	if s.redis == nil {
+		return fmt.Errorf("redis is not configured")
+	}
+	earnedAt := time.UnixMilli(event.EarnedAt).In(preferences.LoadLocation(nil))
+	return s.redis.ZIncrByMany(event.CustomerID, float64(event.Amount), Keys(event.JlptLevel, earnedAt))
+}
+
+func (s *service) Get(ctx echo.Context, filter *LeaderboardFilter) (out *LeaderboardResponse, info *abstraction.PaginationInfo, err error) {
+	if s.redis == nil {
+		err = response.ErrorWrap(response.ErrServiceUnavailable, fmt.Errorf("leaderboards are not available"))
+		return
+	}
+
+	customerID := middleware.GetUserID(ctx)
+	periodKey := PeriodKey(filter.Period, time.Now().In(preferences.LoadLocation(nil)))
+	key := Key(filter.Period, filter.JlptLevel, periodKey)
+
+	var count int64
+	out = &LeaderboardResponse{
+		Period:    filter.Period,
+		PeriodKey: periodKey,
+		JlptLevel: filter.JlptLevel,
+		Scope:     filter.Scope,
+	}
+	if filter.Scope == SCOPE_FRIENDS {
+		out.Me, out.Entries, count, err = s.friendEntries(ctx, key, customerID, filter)
+	} else {
+		out.Me, out.Entries, count, err = s.globalEntries(key, customerID, filter)
+	}
+	if err != nil {
+		out = nil
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	if err = s.setUsernames(ctx, append(out.Entries, out.Me)); err != nil {
+		out = nil
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	info = filter.Pagination.CreatePageInfo(count)
+	return
+}
+
+// globalEntries reads a page of the board from Redis, moving to the page of
+// the caller when filter asks for it.
+func (s *service) globalEntries(key, customerID string, filter *LeaderboardFilter) (me *EntryResponse, entries []*EntryResponse, count int64, err error) {
+	count, err = s.redis.ZCard(key)
+	if err != nil {
+		return
+	}
+	rank, ranked, err := s.redis.ZRevRank(key, customerID)
+	if err != nil {
+		return
+	}
+	if ranked {
+		score, _, scoreErr := s.redis.ZScore(key, customerID)
+		if scoreErr != nil {
+			err = scoreErr
+			return
+		}
+		me = &EntryResponse{Rank: rank + 1, CustomerID: customerID, XP: int64(score)}
+		if filter.AroundMe {
+			filter.Page = PageOf(me.Rank, filter.PageSize)
+		}
+	}
+
+	start := int64(filter.Offset())
+	members, err := s.redis.ZRevRangeWithScores(key, start, start+int64(filter.Limit())-1)
+	if err != nil {
+		return
+	}
+	entries = []*EntryResponse{}
+	for i, m := range members {
+		entries = append(entries, &EntryResponse{Rank: start + int64(i) + 1, CustomerID: m.Member, XP: int64(m.Score)})
+	}
+	return
+}
+
+// friendEntries ranks the caller among their friends.
+func (s *service) friendEntries(ctx echo.Context, key, customerID string, filter *LeaderboardFilter) (me *EntryResponse, entries []*EntryResponse, count int64, err error) {
+	ids, err := s.friends.FriendIDs(ctx, customerID)
+	if err != nil {
+		return
+	}
+	ids = append(ids, customerID)
+	scores, err := s.redis.ZScores(key, ids)
+	if err != nil {
+		return
+	}
+
+	ranked := Rank(ids, scores)
+	for _, e := range ranked {
+		if e.CustomerID == customerID {
+			me = e
+		}
+	}
+	if filter.AroundMe && me != nil {
+		filter.Page = PageOf(me.Rank, filter.PageSize)
+	}
+
+	count = int64(len(ranked))
+	start := min(filter.Offset(), len(ranked))
+	end := min(start+filter.Limit(), len(ranked))
+	entries = ranked[start:end]
+	return
+}
+
+func (s *service) setUsernames(ctx echo.Context, entries []*EntryResponse) (err error) {
+	ids := []string{}
+	for _, e := range entries {
+		if e != nil {
+			ids = append(ids, e.CustomerID)
+		}
+	}
+	names, err := s.repo.GetUsernames(ctx.Request().Context(), ids)
+	if err != nil {
+		return
+	}
+	for _, e := range entries {
+		if e != nil {
+			e.Username = names[e.CustomerID]
+		}
+	}
+	return
+}
+
+// Archive lists an archived weekly board, the last finished week by default.
+func (s *service) Archive(ctx echo.Context, filter *ArchiveFilter) (out *ArchiveResponse, info *abstraction.PaginationInfo, err error) {
+	if filter.Week == "" {
+		lastWeek := time.Now().In(preferences.LoadLocation(nil)).AddDate(0, 0, -7)
+		filter.Week = PeriodKey(PERIOD_WEEKLY, lastWeek)
+	}
+	if filter.JlptLevel == "" {
+		filter.JlptLevel = LEVEL_ALL
+	}
+
+	snapshots, count, err := s.repo.ListSnapshot(ctx.Request().Context(), filter.Week, filter.JlptLevel, &filter.Pagination)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	out = &ArchiveResponse{Week: filter.Week, JlptLevel: filter.JlptLevel, Entries: []*EntryResponse{}}
+	for _, val := range snapshots {
+		entry := &EntryResponse{}
+		entry.MapFromModel(val)
+		out.Entries = append(out.Entries, entry)
+	}
+	info = filter.Pagination.CreatePageInfo(count)
+	return
+}
+
+// Rebuild replaces the current boards in Redis with the sums of the XP
+// ledger, e.g. after Redis lost its data.
+func (s *service) Rebuild(ctx echo.Context) (out *RebuildResponse, err error) {
+	if s.redis == nil {
+		err = response.ErrorWrap(response.ErrServiceUnavailable, fmt.Errorf("leaderboards are not available"))
+		return
+	}
+
+	now := time.Now().In(preferences.LoadLocation(nil))
+	out = &RebuildResponse{}
+	for _, period := range PERIODS {
+		from, to := PeriodRange(period, now)
+		ttl := map[string]int{PERIOD_WEEKLY: WEEKLY_TTL, PERIOD_MONTHLY: MONTHLY_TTL}[period]
+		for _, level := range append([]string{LEVEL_ALL}, LEVELS...) {
+			scores, scoreErr := s.repo.GetScores(ctx.Request().Context(), level, from.UnixMilli(), to.UnixMilli())
+			if scoreErr != nil {
+				err = response.ErrorWrap(response.ErrInternalServerError, scoreErr)
+				return
+			}
+			members := make([]redisutil.Member, 0, len(scores))
+			for _, sc := range scores {
+				members = append(members, redisutil.Member{Member: sc.CustomerID, Score: float64(sc.XP)})
+			}
+			if err = s.redis.ZReplace(Key(period, level, PeriodKey(period, now)), members, ttl); err != nil {
+				err = response.ErrorWrap(response.ErrInternalServerError, err)
+				return
+			}
+			out.Boards++
+		}
+	}
+	return
+}
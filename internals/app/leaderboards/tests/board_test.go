@@ -0,0 +1,95 @@
+package tests
+
+import (
+	"testing"
+	"time"
+	"wakuwaku_nihongo/internals/app/leaderboards"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeriodKey(t *testing.T) {
+	jakarta, _ := time.LoadLocation("Asia/Jakarta")
+	sunday := time.Date(2026, 1, 4, 23, 0, 0, 0, jakarta)
+
+	assert.Equal(t, "2026-W01", leaderboards.PeriodKey(leaderboards.PERIOD_WEEKLY, sunday))
+	assert.Equal(t, "2026-W02", leaderboards.PeriodKey(leaderboards.PERIOD_WEEKLY, sunday.Add(time.Hour)))
+	assert.Equal(t, "2025-W01", leaderboards.PeriodKey(leaderboards.PERIOD_WEEKLY, time.Date(2024, 12, 30, 0, 0, 0, 0, jakarta)), "ISO years")
+	assert.Equal(t, "2026-01", leaderboards.PeriodKey(leaderboards.PERIOD_MONTHLY, sunday))
+	assert.Equal(t, "all_time", leaderboards.PeriodKey(leaderboards.PERIOD_ALL_TIME, sunday))
+}
+
+func TestPeriodRange(t *testing.T) {
+	jakarta, _ := time.LoadLocation("Asia/Jakarta")
+	wednesday := time.Date(2026, 3, 4, 15, 30, 0, 0, jakarta)
+
+	from, to := leaderboards.PeriodRange(leaderboards.PERIOD_WEEKLY, wednesday)
+	assert.Equal(t, time.Date(2026, 3, 2, 0, 0, 0, 0, jakarta), from)
+	assert.Equal(t, time.Date(2026, 3, 9, 0, 0, 0, 0, jakarta), to)
+
+	sunday := time.Date(2026, 3, 8, 23, 59, 0, 0, jakarta)
+	from, _ = leaderboards.PeriodRange(leaderboards.PERIOD_WEEKLY, sunday)
+	assert.Equal(t, time.Date(2026, 3, 2, 0, 0, 0, 0, jakarta), from, "sunday ends the week")
+
+	from, to = leaderboards.PeriodRange(leaderboards.PERIOD_MONTHLY, wednesday)
+	assert.Equal(t, time.Date(2026, 3, 1, 0, 0, 0, 0, jakarta), from)
+	assert.Equal(t, time.Date(2026, 4, 1, 0, 0, 0, 0, jakarta), to)
+
+	from, to = leaderboards.PeriodRange(leaderboards.PERIOD_ALL_TIME, wednesday)
+	assert.True(t, from.Before(wednesday) && to.After(wednesday))
+}
+
+func TestKeys(t *testing.T) {
+	level := "N4"
+	at := time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+
+	keys := leaderboards.Keys(&level, at)
+	assert.Equal(t, map[string]int{
+		"leaderboard:weekly:all:2026-W10":   leaderboards.WEEKLY_TTL,
+		"leaderboard:monthly:all:2026-03":   leaderboards.MONTHLY_TTL,
+		"leaderboard:all_time:all:all_time": 0,
+		"leaderboard:weekly:N4:2026-W10":    leaderboards.WEEKLY_TTL,
+		"leaderboard:monthly:N4:2026-03":    leaderboards.MONTHLY_TTL,
+		"leaderboard:all_time:N4:all_time":  0,
+	}, keys)
+
+	assert.Len(t, leaderboards.Keys(nil, at), 3, "a quiz without a level only counts on the all boards")
+}
+
+func TestRank(t *testing.T) {
+	entries := leaderboards.Rank([]string{"b", "a", "c", "d", "a"}, map[string]float64{"a": 50, "b": 50, "c": 80})
+	assert.Len(t, entries, 4)
+
+	got := []string{}
+	for i, e := range entries {
+		assert.Equal(t, int64(i+1), e.Rank)
+		got = append(got, e.CustomerID)
+	}
+	assert.Equal(t, []string{"c", "a", "b", "d"}, got)
+	assert.Equal(t, int64(0), entries[3].XP, "friends without XP are ranked last")
+}
+
+func TestPageOf(t *testing.T) {
+	assert.Equal(t, 1, leaderboards.PageOf(1, 20))
+	assert.Equal(t, 1, leaderboards.PageOf(20, 20))
+	assert.Equal(t, 2, leaderboards.PageOf(21, 20))
+	assert.Equal(t, 1, leaderboards.PageOf(0, 20))
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshots := leaderboards.Snapshots("2026-W10", "N5", []*leaderboards.Score{
+		{CustomerID: "a", XP: 90},
+		{CustomerID: "b", XP: 70},
+		{CustomerID: "c", XP: 70},
+		{CustomerID: "d", XP: 10},
+	})
+
+	ranks := []int64{}
+	for _, s := range snapshots {
+		assert.Equal(t, leaderboards.PERIOD_WEEKLY, s.PeriodType)
+		assert.Equal(t, "2026-W10", s.PeriodKey)
+		assert.Equal(t, "N5", s.JlptLevel)
+		ranks = append(ranks, s.Rank)
+	}
+	assert.Equal(t, []int64{1, 2, 2, 4}, ranks)
+}
@@ -0,0 +1,32 @@
+package media
+
+import "wakuwaku_nihongo/internals/pkg/audio"
+
+const (
+	OWNER_TYPE_QUESTION = "question"
+	OWNER_TYPE_PASSAGE  = "passage"
+
+	// UPLOAD_FIELD is the multipart form field holding the uploaded file.
+	UPLOAD_FIELD = "file"
+
+	// UPLOAD_FORM_OVERHEAD is the room left in an upload request for the
+	// multipart headers around the file.
+	UPLOAD_FORM_OVERHEAD = 1 << 20
+
+	AUDIO_KEY_PREFIX = "audio"
+	STREAM_URL       = "/api/v1/media/%s/stream"
+
+	// stream URLs are signed with these query parameters so players can
+	// fetch them without an Authorization header
+	STREAM_EXPIRES_PARAM   = "expires"
+	STREAM_SIGNATURE_PARAM = "signature"
+)
+
+// ALLOWED_MIME_TYPES maps the accepted audio types, as detected from the file
+// content, to the extension used for the stored object.
+var ALLOWED_MIME_TYPES = map[string]string{
+	audio.MIMEMpeg: ".mp3",
+	audio.MIMEWav:  ".wav",
+	audio.MIMEOgg:  ".ogg",
+	audio.MIMEMp4:  ".m4a",
+}
@@ -0,0 +1,264 @@
+package media
+
+import (
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+
+	"wakuwaku_nihongo/config"
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/pkg/storage"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+type IMediaService interface {
+	Upload(ctx echo.Context, file *multipart.FileHeader) (out *MediaResponse, err error)
+	Get(ctx echo.Context, mediaID string) (out *MediaResponse, err error)
+	Open(ctx echo.Context, mediaID string) (media *Media, obj storage.Object, err error)
+	Delete(ctx echo.Context, mediaID string) (err error)
+	Restore(ctx echo.Context, mediaID string) (out *MediaResponse, err error)
+	AttachToQuestion(ctx echo.Context, questionID string, in *AttachRequest) (out *MediaResponse, err error)
+	DetachFromQuestion(ctx echo.Context, questionID string) (err error)
+	AttachToPassage(ctx echo.Context, passageID string, in *AttachRequest) (out *MediaResponse, err error)
+	DetachFromPassage(ctx echo.Context, passageID string) (err error)
+}
+
+type handler struct {
+	service IMediaService
+	cfg     config.MediaConfig
+}
+
+func NewHandler(f *factory.Factory) *handler {
+	return &handler{
+		service: NewService(f),
+		cfg:     config.Get().Media,
+	}
+}
+
+// @Summary Upload Audio
+// @Description Upload an mp3, wav, ogg or m4a audio file for listening questions (editor only). The type is detected from the content and the duration is checked against the configured maximum
+// @Tags media
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "Audio file"
+// @Success 200 {object} response.Success{data=MediaResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/media [post]
+func (h *handler) Upload(c echo.Context) error {
+	// larger bodies are cut off while parsing instead of being spooled to disk
+	req := c.Request()
+	req.Body = http.MaxBytesReader(c.Response(), req.Body, h.cfg.MaxUploadSize+UPLOAD_FORM_OVERHEAD)
+
+	file, err := c.FormFile(UPLOAD_FIELD)
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		return response.ErrorWrap(response.ErrValidation, fmt.Errorf("file exceeds the maximum size of %d bytes", h.cfg.MaxUploadSize)).Send(c)
+	}
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, fmt.Errorf("%s is required", UPLOAD_FIELD)).Send(c)
+	}
+
+	res, err := h.service.Upload(c, file)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Get Media
+// @Description Get media metadata
+// @Tags media
+// @Produce json
+// @Param id path string true "Media ID"
+// @Success 200 {object} response.Success{data=MediaResponse}
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/media/{id} [get]
+func (h *handler) Get(c echo.Context) error {
+	res, err := h.service.Get(c, c.Param("id"))
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Stream Media
+// @Description Stream the media content. Range requests are supported so players can seek. The stream_url of the media is signed and short-lived so it can be used as an audio src without an Authorization header
+// @Tags media
+// @Produce audio/mpeg,audio/wav,audio/ogg,audio/mp4
+// @Param id path string true "Media ID"
+// @Param expires query int false "Expiry of the signed URL, unix seconds"
+// @Param signature query string false "Signature of the signed URL"
+// @Param Range header string false "Byte range, e.g. bytes=0-1023"
+// @Success 200 {file} file
+// @Success 206 {file} file
+// @Failure 401 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 416 {string} string
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string false "Bearer Token, not needed with a signed URL"
+// @Router /api/v1/media/{id}/stream [get]
+func (h *handler) Stream(c echo.Context) error {
+	media, obj, err := h.service.Open(c, c.Param("id"))
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	defer obj.Close()
+
+	header := c.Response().Header()
+	header.Set(echo.HeaderContentType, media.MimeType)
+	header.Set(echo.HeaderContentDisposition, fmt.Sprintf("inline; filename=%q", media.FileName))
+	header.Set("Cache-Control", "private, max-age=3600")
+	http.ServeContent(c.Response(), c.Request(), media.FileName, obj.ModTime(), obj)
+	return nil
+}
+
+// @Summary Delete Media
+// @Description Soft delete media and detach it from its questions and passages (editor only)
+// @Tags media
+// @Produce json
+// @Param id path string true "Media ID"
+// @Success 200 {object} response.Success{data=string}
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/media/{id} [delete]
+func (h *handler) Delete(c echo.Context) error {
+	err := h.service.Delete(c, c.Param("id"))
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse("deleted").Send(c)
+}
+
+// @Summary Restore Media
+// @Description Restore deleted media, it is not attached again to its questions and passages (admin only)
+// @Tags media
+// @Produce json
+// @Param id path string true "Media ID"
+// @Success 200 {object} response.Success{data=MediaResponse}
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/media/{id}/restore [post]
+func (h *handler) Restore(c echo.Context) error {
+	res, err := h.service.Restore(c, c.Param("id"))
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Attach Audio to Question
+// @Description Attach uploaded audio to a listening question, replacing its previous audio (editor only)
+// @Tags media
+// @Accept json
+// @Produce json
+// @Param question_id path string true "Question ID"
+// @Param payload body AttachRequest true "Payload"
+// @Success 200 {object} response.Success{data=MediaResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/questions/{question_id}/audio [put]
+func (h *handler) AttachToQuestion(c echo.Context) error {
+	req := &AttachRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.AttachToQuestion(c, c.Param("question_id"), req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Detach Audio from Question
+// @Description Remove the audio of a question, the media itself is kept (editor only)
+// @Tags media
+// @Produce json
+// @Param question_id path string true "Question ID"
+// @Success 200 {object} response.Success{data=string}
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/questions/{question_id}/audio [delete]
+func (h *handler) DetachFromQuestion(c echo.Context) error {
+	err := h.service.DetachFromQuestion(c, c.Param("question_id"))
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse("detached").Send(c)
+}
+
+// @Summary Attach Audio to Passage
+// @Description Attach uploaded audio to a passage shared by listening questions, replacing its previous audio (editor only)
+// @Tags media
+// @Accept json
+// @Produce json
+// @Param passage_id path string true "Passage ID"
+// @Param payload body AttachRequest true "Payload"
+// @Success 200 {object} response.Success{data=MediaResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/passages/{passage_id}/audio [put]
+func (h *handler) AttachToPassage(c echo.Context) error {
+	req := &AttachRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.AttachToPassage(c, c.Param("passage_id"), req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Detach Audio from Passage
+// @Description Remove the audio of a passage, the media itself is kept (editor only)
+// @Tags media
+// @Produce json
+// @Param passage_id path string true "Passage ID"
+// @Success 200 {object} response.Success{data=string}
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/passages/{passage_id}/audio [delete]
+func (h *handler) DetachFromPassage(c echo.Context) error {
+	err := h.service.DetachFromPassage(c, c.Param("passage_id"))
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse("detached").Send(c)
+}
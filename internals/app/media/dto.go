@@ -0,0 +1,25 @@
+package media
+
+type AttachRequest struct {
+	MediaID string `json:"media_id" validate:"required,uuid"`
+}
+
+type MediaResponse struct {
+	MediaID    string `json:"media_id"`
+	FileName   string `json:"file_name"`
+	MimeType   string `json:"mime_type"`
+	SizeBytes  int64  `json:"size_bytes"`
+	DurationMs int64  `json:"duration_ms"`
+	StreamURL  string `json:"stream_url"`
+	CreatedAt  int64  `json:"created_at"`
+}
+
+func (r *MediaResponse) MapFromModel(m *Media) {
+	r.MediaID = m.MediaID
+	r.FileName = m.FileName
+	r.MimeType = m.MimeType
+	r.SizeBytes = m.SizeBytes
+	r.DurationMs = m.DurationMs
+	r.StreamURL = StreamURL(m.MediaID)
+	r.CreatedAt = m.CreatedAt
+}
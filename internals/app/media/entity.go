@@ -0,0 +1,62 @@
+package media
+
+import (
+	"time"
+
+	"wakuwaku_nihongo/internals/pkg/softdelete"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type Media struct {
+	MediaID    string               `gorm:"column:media_id;type:uuid;primaryKey" json:"media_id"`
+	CreatedAt  int64                `gorm:"column:created_at;type:bigint;not null" json:"created_at"`
+	ModifiedAt *int64               `gorm:"column:modified_at;type:bigint" json:"modified_at"`
+	DeletedAt  softdelete.DeletedAt `gorm:"column:deleted_at;type:bigint" json:"deleted_at"`
+	CreatedBy  string               `gorm:"column:created_by;type:character varying;not null" json:"created_by"`
+	ModifiedBy *string              `gorm:"column:modified_by;type:character varying" json:"modified_by"`
+	DeletedBy  *string              `gorm:"column:deleted_by;type:character varying" json:"deleted_by"`
+	StorageKey string               `gorm:"column:storage_key;type:character varying;not null" json:"storage_key"`
+	FileName   string               `gorm:"column:file_name;type:character varying;not null" json:"file_name"`
+	MimeType   string               `gorm:"column:mime_type;type:character varying;not null" json:"mime_type"`
+	SizeBytes  int64                `gorm:"column:size_bytes;type:bigint;not null" json:"size_bytes"`
+	DurationMs int64                `gorm:"column:duration_ms;type:bigint;not null" json:"duration_ms"`
+}
+
+func (*Media) TableName() string {
+	return "media"
+}
+
+func (m *Media) BeforeCreate(tx *gorm.DB) (err error) {
+	m.CreatedAt = time.Now().UnixMilli()
+	if m.MediaID == "" {
+		m.MediaID = uuid.NewString()
+	}
+	return
+}
+
+func (m *Media) BeforeUpdate(tx *gorm.DB) (err error) {
+	now := time.Now().UnixMilli()
+	m.ModifiedAt = &now
+	return
+}
+
+// Attachment links an audio file to the question or passage it belongs to.
+type Attachment struct {
+	OwnerType string `gorm:"column:owner_type;type:character varying;primaryKey" json:"owner_type"`
+	OwnerID   string `gorm:"column:owner_id;type:uuid;primaryKey" json:"owner_id"`
+	MediaID   string `gorm:"column:media_id;type:uuid;not null" json:"media_id"`
+	CreatedAt int64  `gorm:"column:created_at;type:bigint;not null" json:"created_at"`
+	CreatedBy string `gorm:"column:created_by;type:character varying;not null" json:"created_by"`
+	Media     *Media `gorm:"foreignKey:MediaID;references:MediaID" json:"media"`
+}
+
+func (*Attachment) TableName() string {
+	return "media_attachments"
+}
+
+func (m *Attachment) BeforeCreate(tx *gorm.DB) (err error) {
+	m.CreatedAt = time.Now().UnixMilli()
+	return
+}
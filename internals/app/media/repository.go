@@ -0,0 +1,99 @@
+package media
+
+import (
+	"time"
+
+	"wakuwaku_nihongo/internals/pkg/softdelete"
+	"wakuwaku_nihongo/internals/query"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type repo struct {
+	db *gorm.DB
+	*query.Query
+}
+
+func NewRepo(db *gorm.DB) *repo {
+	return &repo{
+		db:    db,
+		Query: query.Use(db),
+	}
+}
+
+func (r *repo) Create(ctx echo.Context, in *Media) (err error) {
+	return r.db.Create(in).Error
+}
+
+func (r *repo) GetByID(ctx echo.Context, mediaID string) (out *Media, err error) {
+	out = &Media{}
+	err = r.db.Where("media_id = ?", mediaID).First(out).Error
+	return
+}
+
+// Delete soft deletes the media and detaches it from every owner.
+func (r *repo) Delete(ctx echo.Context, mediaID string, deletedBy string) (err error) {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		err := softdelete.By(tx, deletedBy).Where("media_id = ?", mediaID).Delete(&Media{}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Where("media_id = ?", mediaID).Delete(&Attachment{}).Error
+	})
+}
+
+// Restore undeletes the media, restored is false when it was not deleted.
+// The owners it was detached from are not attached again.
+func (r *repo) Restore(ctx echo.Context, mediaID string) (restored bool, err error) {
+	res := softdelete.Restore(r.db.Model(&Media{}).Where("media_id = ?", mediaID))
+	return res.RowsAffected > 0, res.Error
+}
+
+func (r *repo) QuestionExists(ctx echo.Context, questionID string) (ok bool, err error) {
+	q := r.Question
+	count, err := q.Where(q.QuestionID.Eq(questionID)).Count()
+	return count > 0, err
+}
+
+// PassageExists reads the passages table directly, the passages package
+// depends on this one for its audio.
+func (r *repo) PassageExists(ctx echo.Context, passageID string) (ok bool, err error) {
+	var count int64
+	err = r.db.Table("passages").
+		Where("passage_id = ? AND deleted_at IS NULL", passageID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// Attach links the media to the owner, replacing its previous audio.
+func (r *repo) Attach(ctx echo.Context, in *Attachment) (err error) {
+	return r.db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "owner_type"}, {Name: "owner_id"}},
+		DoUpdates: clause.Assignments(map[string]any{
+			"media_id":   in.MediaID,
+			"created_at": time.Now().UnixMilli(),
+			"created_by": in.CreatedBy,
+		}),
+	}).Omit(clause.Associations).Create(in).Error
+}
+
+func (r *repo) Detach(ctx echo.Context, ownerType string, ownerID string) (affected int64, err error) {
+	res := r.db.Where("owner_type = ? AND owner_id = ?", ownerType, ownerID).Delete(&Attachment{})
+	return res.RowsAffected, res.Error
+}
+
+// GetAttachments returns the attachments of the owners whose media has not
+// been deleted.
+func (r *repo) GetAttachments(ctx echo.Context, ownerType string, ownerIDs []string) (out []*Attachment, err error) {
+	out = []*Attachment{}
+	if len(ownerIDs) == 0 {
+		return
+	}
+	err = r.db.Joins("Media").
+		Where("media_attachments.owner_type = ? AND media_attachments.owner_id IN ?", ownerType, ownerIDs).
+		Where(`"Media".deleted_at IS NULL`).
+		Find(&out).Error
+	return
+}
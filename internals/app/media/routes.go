@@ -0,0 +1,32 @@
+package media
+
+import (
+	"github.com/labstack/echo/v4"
+	"wakuwaku_nihongo/internals/middleware"
+	"wakuwaku_nihongo/internals/utils/token"
+)
+
+func (h *handler) Route(g *echo.Group) {
+	editor := middleware.Authorization(token.ROLE_EDITOR)
+	admin := middleware.Authorization(token.ROLE_ADMIN)
+
+	g.POST("", h.Upload, middleware.Authentication, editor)
+	g.GET("/:id", h.Get, middleware.Authentication)
+	g.GET("/:id/stream", h.Stream, streamAuthentication)
+	g.DELETE("/:id", h.Delete, middleware.Authentication, editor)
+	g.POST("/:id/restore", h.Restore, middleware.Authentication, admin)
+}
+
+func (h *handler) QuestionRoute(g *echo.Group) {
+	editor := middleware.Authorization(token.ROLE_EDITOR)
+
+	g.PUT("/:question_id/audio", h.AttachToQuestion, middleware.Authentication, editor)
+	g.DELETE("/:question_id/audio", h.DetachFromQuestion, middleware.Authentication, editor)
+}
+
+func (h *handler) PassageRoute(g *echo.Group) {
+	editor := middleware.Authorization(token.ROLE_EDITOR)
+
+	g.PUT("/:passage_id/audio", h.AttachToPassage, middleware.Authentication, editor)
+	g.DELETE("/:passage_id/audio", h.DetachFromPassage, middleware.Authentication, editor)
+}
@@ -0,0 +1,267 @@
+package media
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"path"
+	"time"
+
+	"wakuwaku_nihongo/config"
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/middleware"
+	"wakuwaku_nihongo/internals/pkg/audio"
+	"wakuwaku_nihongo/internals/pkg/storage"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+type IMediaRepo interface {
+	Create(ctx echo.Context, in *Media) (err error)
+	GetByID(ctx echo.Context, mediaID string) (out *Media, err error)
+	Delete(ctx echo.Context, mediaID string, deletedBy string) (err error)
+	Restore(ctx echo.Context, mediaID string) (restored bool, err error)
+	QuestionExists(ctx echo.Context, questionID string) (ok bool, err error)
+	PassageExists(ctx echo.Context, passageID string) (ok bool, err error)
+	Attach(ctx echo.Context, in *Attachment) (err error)
+	Detach(ctx echo.Context, ownerType string, ownerID string) (affected int64, err error)
+	GetAttachments(ctx echo.Context, ownerType string, ownerIDs []string) (out []*Attachment, err error)
+}
+
+type service struct {
+	repo    IMediaRepo
+	storage storage.Storage
+	cfg     config.MediaConfig
+}
+
+func NewService(f *factory.Factory) *service {
+	return &service{
+		repo:    NewRepo(f.Db),
+		storage: f.Storage,
+		cfg:     config.Get().Media,
+	}
+}
+
+// Upload validates the audio file against its detected MIME type and
+// duration before storing it.
+func (s *service) Upload(ctx echo.Context, file *multipart.FileHeader) (out *MediaResponse, err error) {
+	if file.Size > s.cfg.MaxUploadSize {
+		err = response.ErrorWrap(response.ErrValidation, fmt.Errorf("file exceeds the maximum size of %d bytes", s.cfg.MaxUploadSize))
+		return
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		err = response.ErrorWrap(response.ErrBadRequest, err)
+		return
+	}
+	defer src.Close()
+
+	info, err := audio.Probe(src)
+	if errors.Is(err, audio.ErrUnsupportedFormat) {
+		err = response.ErrorWrap(response.ErrValidation, fmt.Errorf("unsupported audio format, allowed are mp3, wav, ogg and m4a"))
+		return
+	}
+	if err != nil {
+		err = response.ErrorWrap(response.ErrValidation, fmt.Errorf("file is not a valid audio file"))
+		return
+	}
+	ext, ok := ALLOWED_MIME_TYPES[info.MIMEType]
+	if !ok {
+		err = response.ErrorWrap(response.ErrValidation, fmt.Errorf("audio type %s is not allowed", info.MIMEType))
+		return
+	}
+	maxDuration := time.Duration(s.cfg.MaxAudioDuration) * time.Second
+	if info.Duration <= 0 || info.Duration > maxDuration {
+		err = response.ErrorWrap(response.ErrValidation, fmt.Errorf("audio duration must be between 0 and %s", maxDuration))
+		return
+	}
+
+	if _, err = src.Seek(0, io.SeekStart); err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	media := &Media{
+		MediaID:    uuid.NewString(),
+		CreatedBy:  middleware.GetUserID(ctx),
+		FileName:   path.Base(file.Filename),
+		MimeType:   info.MIMEType,
+		DurationMs: info.Duration.Milliseconds(),
+	}
+	media.StorageKey = path.Join(AUDIO_KEY_PREFIX, media.MediaID+ext)
+
+	media.SizeBytes, err = s.storage.Put(ctx.Request().Context(), media.StorageKey, src)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	err = s.repo.Create(ctx, media)
+	if err != nil {
+		if delErr := s.storage.Delete(ctx.Request().Context(), media.StorageKey); delErr != nil {
+			log.Error().Err(delErr).Msg("failed to remove orphaned media " + media.StorageKey)
+		}
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	out = &MediaResponse{}
+	out.MapFromModel(media)
+	return
+}
+
+func (s *service) Get(ctx echo.Context, mediaID string) (out *MediaResponse, err error) {
+	media, err := s.getMedia(ctx, mediaID)
+	if err != nil {
+		return
+	}
+	out = &MediaResponse{}
+	out.MapFromModel(media)
+	return
+}
+
+// Open returns the media and its content, the caller closes the object.
+func (s *service) Open(ctx echo.Context, mediaID string) (media *Media, obj storage.Object, err error) {
+	media, err = s.getMedia(ctx, mediaID)
+	if err != nil {
+		return
+	}
+	obj, err = s.storage.Open(ctx.Request().Context(), media.StorageKey)
+	if errors.Is(err, storage.ErrNotFound) {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("media content not found"))
+		return
+	}
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	return
+}
+
+// Delete soft deletes the media, the stored file is kept.
+func (s *service) Delete(ctx echo.Context, mediaID string) (err error) {
+	if _, err = s.getMedia(ctx, mediaID); err != nil {
+		return
+	}
+	err = s.repo.Delete(ctx, mediaID, middleware.GetUserID(ctx))
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	return
+}
+
+// Restore undeletes media (admin only), it has to be attached again.
+func (s *service) Restore(ctx echo.Context, mediaID string) (out *MediaResponse, err error) {
+	restored, err := s.repo.Restore(ctx, mediaID)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	if !restored {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("deleted media not found"))
+		return
+	}
+	return s.Get(ctx, mediaID)
+}
+
+func (s *service) AttachToQuestion(ctx echo.Context, questionID string, in *AttachRequest) (out *MediaResponse, err error) {
+	ok, err := s.repo.QuestionExists(ctx, questionID)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	if !ok {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("question not found"))
+		return
+	}
+	return s.attach(ctx, OWNER_TYPE_QUESTION, questionID, in)
+}
+
+func (s *service) DetachFromQuestion(ctx echo.Context, questionID string) (err error) {
+	return s.detach(ctx, OWNER_TYPE_QUESTION, questionID)
+}
+
+func (s *service) AttachToPassage(ctx echo.Context, passageID string, in *AttachRequest) (out *MediaResponse, err error) {
+	ok, err := s.repo.PassageExists(ctx, passageID)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	if !ok {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("passage not found"))
+		return
+	}
+	return s.attach(ctx, OWNER_TYPE_PASSAGE, passageID, in)
+}
+
+func (s *service) DetachFromPassage(ctx echo.Context, passageID string) (err error) {
+	return s.detach(ctx, OWNER_TYPE_PASSAGE, passageID)
+}
+
+// GetAudio returns the audio of each owner keyed by owner ID, owners without
+// audio are left out.
+func (s *service) GetAudio(ctx echo.Context, ownerType string, ownerIDs []string) (out map[string]*MediaResponse, err error) {
+	attachments, err := s.repo.GetAttachments(ctx, ownerType, ownerIDs)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	out = map[string]*MediaResponse{}
+	for _, a := range attachments {
+		if a.Media == nil {
+			continue
+		}
+		media := &MediaResponse{}
+		media.MapFromModel(a.Media)
+		out[a.OwnerID] = media
+	}
+	return
+}
+
+func (s *service) attach(ctx echo.Context, ownerType string, ownerID string, in *AttachRequest) (out *MediaResponse, err error) {
+	media, err := s.getMedia(ctx, in.MediaID)
+	if err != nil {
+		return
+	}
+	err = s.repo.Attach(ctx, &Attachment{
+		OwnerType: ownerType,
+		OwnerID:   ownerID,
+		MediaID:   media.MediaID,
+		CreatedBy: middleware.GetUserID(ctx),
+	})
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	out = &MediaResponse{}
+	out.MapFromModel(media)
+	return
+}
+
+func (s *service) detach(ctx echo.Context, ownerType string, ownerID string) (err error) {
+	affected, err := s.repo.Detach(ctx, ownerType, ownerID)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	if affected == 0 {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("no audio is attached"))
+	}
+	return
+}
+
+func (s *service) getMedia(ctx echo.Context, mediaID string) (out *Media, err error) {
+	out, err = s.repo.GetByID(ctx, mediaID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("media not found"))
+		return
+	}
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	return
+}
@@ -0,0 +1,67 @@
+package media
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"wakuwaku_nihongo/config"
+	"wakuwaku_nihongo/internals/middleware"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+// StreamURL returns the stream URL of the media signed for the configured
+// time to live.
+func StreamURL(mediaID string) string {
+	ttl := time.Duration(config.Get().Media.StreamURLTTL) * time.Second
+	return SignStreamURL(mediaID, time.Now().Add(ttl))
+}
+
+// SignStreamURL returns the stream URL of the media, usable without an
+// Authorization header until expires.
+func SignStreamURL(mediaID string, expires time.Time) string {
+	at := strconv.FormatInt(expires.Unix(), 10)
+	query := url.Values{}
+	query.Set(STREAM_EXPIRES_PARAM, at)
+	query.Set(STREAM_SIGNATURE_PARAM, streamSignature(mediaID, at))
+	return fmt.Sprintf(STREAM_URL, mediaID) + "?" + query.Encode()
+}
+
+// VerifyStreamURL reports whether signature was made by SignStreamURL for
+// the media and expires, and whether it is still valid at now.
+func VerifyStreamURL(mediaID string, expires string, signature string, now time.Time) bool {
+	at, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || now.Unix() > at {
+		return false
+	}
+	return hmac.Equal([]byte(signature), []byte(streamSignature(mediaID, expires)))
+}
+
+func streamSignature(mediaID string, expires string) string {
+	mac := hmac.New(sha256.New, []byte(config.Get().JWT.Key))
+	mac.Write([]byte(mediaID + ":" + expires))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// streamAuthentication lets signed stream URLs through, since audio players
+// cannot send an Authorization header, and authenticates the others with
+// their bearer token.
+func streamAuthentication(next echo.HandlerFunc) echo.HandlerFunc {
+	authenticated := middleware.Authentication(next)
+	return func(c echo.Context) error {
+		signature := c.QueryParam(STREAM_SIGNATURE_PARAM)
+		if signature == "" {
+			return authenticated(c)
+		}
+		if !VerifyStreamURL(c.Param("id"), c.QueryParam(STREAM_EXPIRES_PARAM), signature, time.Now()) {
+			return response.ErrorWrap(response.ErrForbidden, fmt.Errorf("stream url is invalid or expired")).Send(c)
+		}
+		return next(c)
+	}
+}
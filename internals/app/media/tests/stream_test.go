@@ -0,0 +1,31 @@
+package tests
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"wakuwaku_nihongo/internals/app/media"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignStreamURL(t *testing.T) {
+	t.Setenv("JWT_KEY", "secret")
+	now := time.Unix(1_700_000_000, 0)
+	signed := media.SignStreamURL("m1", now.Add(time.Minute))
+	assert.True(t, strings.HasPrefix(signed, "/api/v1/media/m1/stream?"))
+
+	u, err := url.Parse(signed)
+	assert.NoError(t, err)
+	expires := u.Query().Get(media.STREAM_EXPIRES_PARAM)
+	signature := u.Query().Get(media.STREAM_SIGNATURE_PARAM)
+	assert.Equal(t, "1700000060", expires)
+
+	assert.True(t, media.VerifyStreamURL("m1", expires, signature, now))
+	assert.False(t, media.VerifyStreamURL("m1", expires, signature, now.Add(2*time.Minute)), "expired")
+	assert.False(t, media.VerifyStreamURL("m2", expires, signature, now), "other media")
+	assert.False(t, media.VerifyStreamURL("m1", "1700009999", signature, now), "extended expiry")
+	assert.False(t, media.VerifyStreamURL("m1", "soon", signature, now))
+}
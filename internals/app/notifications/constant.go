@@ -0,0 +1,15 @@
+package notifications
+
+const (
+	// TYPE_REPORT_RESOLVED tells a learner an editor fixed or rejected the
+	// error they reported.
+	TYPE_REPORT_RESOLVED = "report_resolved"
+	// TYPE_QUIZ_REVIEW_REQUESTED tells an editor a quiz was submitted for
+	// them to review.
+	TYPE_QUIZ_REVIEW_REQUESTED = "quiz_review_requested"
+	// TYPE_QUIZ_REVIEWED tells the editor who submitted a quiz the reviewer
+	// approved or rejected it.
+	TYPE_QUIZ_REVIEWED = "quiz_reviewed"
+
+	DEFAULT_SORT_BY = "created_at"
+)
@@ -0,0 +1,106 @@
+package notifications
+
+import (
+	"wakuwaku_nihongo/internals/abstraction"
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+type INotificationService interface {
+	List(ctx echo.Context, filter *NotificationFilter) (out []*NotificationResponse, info *abstraction.PaginationInfo, err error)
+	Unread(ctx echo.Context) (out *UnreadResponse, err error)
+	Read(ctx echo.Context, notificationID string) (out *NotificationResponse, err error)
+	ReadAll(ctx echo.Context) (out *ReadAllResponse, err error)
+}
+
+type handler struct {
+	service INotificationService
+}
+
+func NewHandler(f *factory.Factory) *handler {
+	return &handler{
+		service: NewService(f),
+	}
+}
+
+// @Summary List My Notifications
+// @Description List the notifications of the logged in customer, the latest first
+// @Tags notifications
+// @Produce json
+// @Param unread query bool false "Only unread notifications"
+// @Param page query int false "Page"
+// @Param page_size query int false "Page size"
+// @Success 200 {object} response.SuccessResponseWithInfo{data=[]NotificationResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 401 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/me/notifications [get]
+func (h *handler) List(c echo.Context) error {
+	req := &NotificationFilter{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+	req.ChangeDefaultSortingClause(DEFAULT_SORT_BY, nil)
+	req.Pagination.SetDefault()
+
+	res, info, err := h.service.List(c, req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponseInfo(res, info).Send(c)
+}
+
+// @Summary Count Unread Notifications
+// @Description Count the unread notifications of the logged in customer
+// @Tags notifications
+// @Produce json
+// @Success 200 {object} response.Success{data=UnreadResponse}
+// @Failure 401 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/me/notifications/unread [get]
+func (h *handler) Unread(c echo.Context) error {
+	res, err := h.service.Unread(c)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Read Notification
+// @Description Mark a notification of the logged in customer read
+// @Tags notifications
+// @Produce json
+// @Param id path string true "Notification ID"
+// @Success 200 {object} response.Success{data=NotificationResponse}
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/me/notifications/{id}/read [put]
+func (h *handler) Read(c echo.Context) error {
+	res, err := h.service.Read(c, c.Param("id"))
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Read All Notifications
+// @Description Mark every notification of the logged in customer read
+// @Tags notifications
+// @Produce json
+// @Success 200 {object} response.Success{data=ReadAllResponse}
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/me/notifications/read [put]
+func (h *handler) ReadAll(c echo.Context) error {
+	res, err := h.service.ReadAll(c)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
@@ -0,0 +1,36 @@
+package notifications
+
+import (
+	"wakuwaku_nihongo/internals/abstraction"
+)
+
+type NotificationFilter struct {
+	Unread bool `query:"unread"`
+	abstraction.Pagination
+}
+
+type NotificationResponse struct {
+	NotificationID string         `json:"notification_id"`
+	Type           string         `json:"type"`
+	SourceID       string         `json:"source_id"`
+	Data           map[string]any `json:"data"`
+	CreatedAt      int64          `json:"created_at"`
+	ReadAt         *int64         `json:"read_at"`
+}
+
+func (r *NotificationResponse) MapFromModel(m *Notification) {
+	r.NotificationID = m.NotificationID
+	r.Type = m.Type
+	r.SourceID = m.SourceID
+	r.Data = m.Data
+	r.CreatedAt = m.CreatedAt
+	r.ReadAt = m.ReadAt
+}
+
+type UnreadResponse struct {
+	Unread int64 `json:"unread"`
+}
+
+type ReadAllResponse struct {
+	Read int64 `json:"read"`
+}
@@ -0,0 +1,36 @@
+package notifications
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// Notification is an in-app message to a customer. Data holds what the
+// client needs to render a notification of its Type.
+type Notification struct {
+	NotificationID string            `gorm:"column:notification_id;type:uuid;primaryKey" json:"notification_id"`
+	CreatedAt      int64             `gorm:"column:created_at;type:bigint;not null" json:"created_at"`
+	CustomerID     string            `gorm:"column:customer_id;type:uuid;not null" json:"customer_id"`
+	Type           string            `gorm:"column:type;type:character varying;not null" json:"type"`
+	SourceID       string            `gorm:"column:source_id;type:character varying;not null" json:"source_id"`
+	Data           datatypes.JSONMap `gorm:"column:data;type:jsonb;not null" json:"data"`
+	ReadAt         *int64            `gorm:"column:read_at;type:bigint" json:"read_at"`
+}
+
+func (*Notification) TableName() string {
+	return "notifications"
+}
+
+func (m *Notification) BeforeCreate(tx *gorm.DB) (err error) {
+	m.CreatedAt = time.Now().UnixMilli()
+	if m.NotificationID == "" {
+		m.NotificationID = uuid.NewString()
+	}
+	if m.Data == nil {
+		m.Data = datatypes.JSONMap{}
+	}
+	return
+}
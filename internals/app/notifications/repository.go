@@ -0,0 +1,66 @@
+package notifications
+
+import (
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+type repo struct {
+	db *gorm.DB
+}
+
+func NewRepo(db *gorm.DB) *repo {
+	return &repo{
+		db: db,
+	}
+}
+
+func (r *repo) Create(ctx echo.Context, in *Notification) (err error) {
+	return r.db.Create(in).Error
+}
+
+// List returns the notifications of a customer, the latest first.
+func (r *repo) List(ctx echo.Context, customerID string, filter *NotificationFilter) (out []*Notification, count int64, err error) {
+	db := r.db.Model(&Notification{}).Where("customer_id = ?", customerID)
+	if filter.Unread {
+		db = db.Where("read_at IS NULL")
+	}
+	db = db.Session(&gorm.Session{})
+
+	if err = db.Count(&count).Error; err != nil {
+		return
+	}
+
+	out = []*Notification{}
+	err = db.Order("created_at DESC, notification_id").
+		Limit(filter.Limit()).
+		Offset(filter.Offset()).
+		Find(&out).Error
+	return
+}
+
+func (r *repo) CountUnread(ctx echo.Context, customerID string) (count int64, err error) {
+	err = r.db.Model(&Notification{}).
+		Where("customer_id = ? AND read_at IS NULL", customerID).
+		Count(&count).Error
+	return
+}
+
+func (r *repo) Get(ctx echo.Context, customerID, notificationID string) (out *Notification, err error) {
+	out = &Notification{}
+	err = r.db.Where("notification_id = ? AND customer_id = ?", notificationID, customerID).First(out).Error
+	return
+}
+
+// MarkRead marks the unread notifications of a customer read, all of them
+// when ids is empty, and returns how many were.
+func (r *repo) MarkRead(ctx echo.Context, customerID string, ids []string) (read int64, err error) {
+	db := r.db.Model(&Notification{}).Where("customer_id = ? AND read_at IS NULL", customerID)
+	if len(ids) > 0 {
+		db = db.Where("notification_id IN ?", ids)
+	}
+	res := db.Update("read_at", time.Now().UnixMilli())
+	return res.RowsAffected, res.Error
+}
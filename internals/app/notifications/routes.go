@@ -0,0 +1,13 @@
+package notifications
+
+import (
+	"github.com/labstack/echo/v4"
+	"wakuwaku_nihongo/internals/middleware"
+)
+
+func (h *handler) Route(g *echo.Group) {
+	g.GET("", h.List, middleware.Authentication)
+	g.GET("/unread", h.Unread, middleware.Authentication)
+	g.PUT("/read", h.ReadAll, middleware.Authentication)
+	g.PUT("/:id/read", h.Read, middleware.Authentication)
+}
@@ -0,0 +1,93 @@
+package notifications
+
+import (
+	"errors"
+	"fmt"
+
+	"wakuwaku_nihongo/internals/abstraction"
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/middleware"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+type INotificationRepo interface {
+	List(ctx echo.Context, customerID string, filter *NotificationFilter) (out []*Notification, count int64, err error)
+	CountUnread(ctx echo.Context, customerID string) (count int64, err error)
+	Get(ctx echo.Context, customerID, notificationID string) (out *Notification, err error)
+	MarkRead(ctx echo.Context, customerID string, ids []string) (read int64, err error)
+}
+
+type service struct {
+	repo INotificationRepo
+}
+
+func NewService(f *factory.Factory) *service {
+	return &service{
+		repo: NewRepo(f.Db),
+	}
+}
+
+// Notify stores in for its customer within db, so it is only sent when
+// the change it tells about commits.
+func Notify(ctx echo.Context, db *gorm.DB, in *Notification) (err error) {
+	return NewRepo(db).Create(ctx, in)
+}
+
+func (s *service) List(ctx echo.Context, filter *NotificationFilter) (out []*NotificationResponse, info *abstraction.PaginationInfo, err error) {
+	notifications, count, err := s.repo.List(ctx, middleware.GetUserID(ctx), filter)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	out = []*NotificationResponse{}
+	for _, n := range notifications {
+		res := &NotificationResponse{}
+		res.MapFromModel(n)
+		out = append(out, res)
+	}
+	info = filter.Pagination.CreatePageInfo(count)
+	return
+}
+
+func (s *service) Unread(ctx echo.Context) (out *UnreadResponse, err error) {
+	unread, err := s.repo.CountUnread(ctx, middleware.GetUserID(ctx))
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	return &UnreadResponse{Unread: unread}, nil
+}
+
+func (s *service) Read(ctx echo.Context, notificationID string) (out *NotificationResponse, err error) {
+	userID := middleware.GetUserID(ctx)
+	if _, err = s.repo.MarkRead(ctx, userID, []string{notificationID}); err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	notification, err := s.repo.Get(ctx, userID, notificationID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("notification not found"))
+		return
+	}
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	out = &NotificationResponse{}
+	out.MapFromModel(notification)
+	return
+}
+
+func (s *service) ReadAll(ctx echo.Context) (out *ReadAllResponse, err error) {
+	read, err := s.repo.MarkRead(ctx, middleware.GetUserID(ctx), nil)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	return &ReadAllResponse{Read: read}, nil
+}
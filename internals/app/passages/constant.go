@@ -0,0 +1,12 @@
+package passages
+
+const (
+	DEFAULT_SORT_BY = "created_at"
+)
+
+var SORTABLE_COLUMNS = map[string]bool{
+	"title":       true,
+	"jlpt_level":  true,
+	"created_at":  true,
+	"modified_at": true,
+}
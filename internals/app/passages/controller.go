@@ -0,0 +1,194 @@
+package passages
+
+import (
+	"wakuwaku_nihongo/internals/abstraction"
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/pkg/ruby"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+type IPassageService interface {
+	List(ctx echo.Context, filter *PassageFilter) (out []*PassageResponse, info *abstraction.PaginationInfo, err error)
+	Get(ctx echo.Context, id string, format ruby.Format) (out *PassageResponse, err error)
+	Create(ctx echo.Context, in *PassageRequest) (out *PassageResponse, err error)
+	Update(ctx echo.Context, id string, in *PassageRequest) (out *PassageResponse, err error)
+	Delete(ctx echo.Context, id string) (err error)
+	Restore(ctx echo.Context, id string) (out *PassageResponse, err error)
+}
+
+type handler struct {
+	service IPassageService
+}
+
+func NewHandler(f *factory.Factory) *handler {
+	return &handler{
+		service: NewService(f),
+	}
+}
+
+// @Summary Get List of Passage
+// @Description Get list of reading passages filtered by JLPT level or text (editor only)
+// @Tags passage
+// @Produce json
+// @Param jlpt_level query string false "JLPT level" Enums(N5, N4, N3, N2, N1)
+// @Param q query string false "Search title, text or source"
+// @Param include_deleted query bool false "Include deleted passages (admin only)"
+// @Param page query int false "Page"
+// @Param page_size query int false "Page size"
+// @Success 200 {object} response.SuccessResponseWithInfo{data=[]PassageResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/passages [get]
+func (h *handler) List(c echo.Context) error {
+	req := &PassageFilter{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+	req.ChangeDefaultSortingClause(DEFAULT_SORT_BY, nil)
+	req.Pagination.SetDefault()
+
+	res, info, err := h.service.List(c, req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponseInfo(res, info).Send(c)
+}
+
+// @Summary Get Passage
+// @Description Get a reading passage with the IDs of its questions and its audio (editor only)
+// @Tags passage
+// @Produce json
+// @Param id path string true "Passage ID"
+// @Param ruby query string false "Furigana rendering" Enums(markup, html, segments, strip)
+// @Success 200 {object} response.Success{data=PassageResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/passages/{id} [get]
+func (h *handler) Get(c echo.Context) error {
+	req := &RubyFilter{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.Get(c, c.Param("id"), req.Format())
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Create Passage
+// @Description Create a reading passage that questions can reference (editor only)
+// @Tags passage
+// @Accept json
+// @Produce json
+// @Param payload body PassageRequest true "Payload"
+// @Success 200 {object} response.Success{data=PassageResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/passages [post]
+func (h *handler) Create(c echo.Context) error {
+	req := &PassageRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.Create(c, req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Update Passage
+// @Description Update a reading passage (editor only)
+// @Tags passage
+// @Accept json
+// @Produce json
+// @Param id path string true "Passage ID"
+// @Param payload body PassageRequest true "Payload"
+// @Success 200 {object} response.Success{data=PassageResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/passages/{id} [put]
+func (h *handler) Update(c echo.Context) error {
+	req := &PassageRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.Update(c, c.Param("id"), req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Delete Passage
+// @Description Soft delete a reading passage, its questions are shown without it (editor only)
+// @Tags passage
+// @Produce json
+// @Param id path string true "Passage ID"
+// @Success 200 {object} response.Success{data=string}
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/passages/{id} [delete]
+func (h *handler) Delete(c echo.Context) error {
+	err := h.service.Delete(c, c.Param("id"))
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse("deleted").Send(c)
+}
+
+// @Summary Restore Passage
+// @Description Restore a deleted reading passage (admin only)
+// @Tags passage
+// @Produce json
+// @Param id path string true "Passage ID"
+// @Success 200 {object} response.Success{data=PassageResponse}
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/passages/{id}/restore [post]
+func (h *handler) Restore(c echo.Context) error {
+	res, err := h.service.Restore(c, c.Param("id"))
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
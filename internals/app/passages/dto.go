@@ -0,0 +1,73 @@
+package passages
+
+import (
+	"wakuwaku_nihongo/internals/abstraction"
+	"wakuwaku_nihongo/internals/app/media"
+	"wakuwaku_nihongo/internals/pkg/ruby"
+)
+
+type PassageRequest struct {
+	Title       *string `json:"title"`
+	PassageText string  `json:"passage_text" validate:"required,ruby"`
+	Source      *string `json:"source"`
+	ImageURL    *string `json:"image_url" validate:"omitempty,url"`
+	JlptLevel   *string `json:"jlpt_level" validate:"omitempty,oneof=N5 N4 N3 N2 N1"`
+}
+
+type PassageFilter struct {
+	JlptLevel string `query:"jlpt_level"`
+	Search    string `query:"q"`
+	abstraction.DeletedFilter
+	abstraction.Pagination
+}
+
+// RubyFilter selects how furigana markup is rendered in the response.
+type RubyFilter struct {
+	Ruby string `query:"ruby" validate:"omitempty,oneof=markup html segments strip"`
+}
+
+func (f *RubyFilter) Format() ruby.Format {
+	if f.Ruby == "" {
+		return ruby.FormatMarkup
+	}
+	return ruby.Format(f.Ruby)
+}
+
+type PassageResponse struct {
+	PassageID       string               `json:"passage_id"`
+	Title           *string              `json:"title"`
+	PassageText     string               `json:"passage_text"`
+	PassageSegments []ruby.Segment       `json:"passage_segments,omitempty"`
+	Source          *string              `json:"source"`
+	ImageURL        *string              `json:"image_url"`
+	JlptLevel       *string              `json:"jlpt_level"`
+	QuestionIDs     []string             `json:"question_ids,omitempty"`
+	Audio           *media.MediaResponse `json:"audio,omitempty"`
+	DeletedAt       *int64               `json:"deleted_at,omitempty"`
+	DeletedBy       *string              `json:"deleted_by,omitempty"`
+}
+
+func (r *PassageRequest) MapToModel(m *Passage) {
+	m.Title = r.Title
+	m.PassageText = r.PassageText
+	m.Source = r.Source
+	m.ImageURL = r.ImageURL
+	m.JlptLevel = r.JlptLevel
+}
+
+func (r *PassageResponse) MapFromModel(m *Passage) {
+	r.MapFromModelWithFormat(m, ruby.FormatMarkup)
+}
+
+func (r *PassageResponse) MapFromModelWithFormat(m *Passage, format ruby.Format) {
+	text := ruby.Render(m.PassageText, format, nil)
+	r.PassageID = m.PassageID
+	r.Title = m.Title
+	r.PassageText = text.Text
+	r.PassageSegments = text.Segments
+	r.Source = m.Source
+	r.ImageURL = m.ImageURL
+	r.JlptLevel = m.JlptLevel
+	r.DeletedAt = m.DeletedAt.Ptr()
+	r.DeletedBy = m.DeletedBy
+}
@@ -0,0 +1,45 @@
+package passages
+
+import (
+	"time"
+
+	"wakuwaku_nihongo/internals/pkg/softdelete"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Passage is a reading text shared by several questions, as in the JLPT
+// reading section.
+type Passage struct {
+	PassageID   string               `gorm:"column:passage_id;type:uuid;primaryKey" json:"passage_id"`
+	CreatedAt   int64                `gorm:"column:created_at;type:bigint;not null" json:"created_at"`
+	ModifiedAt  *int64               `gorm:"column:modified_at;type:bigint" json:"modified_at"`
+	DeletedAt   softdelete.DeletedAt `gorm:"column:deleted_at;type:bigint" json:"deleted_at"`
+	CreatedBy   string               `gorm:"column:created_by;type:character varying;not null" json:"created_by"`
+	ModifiedBy  *string              `gorm:"column:modified_by;type:character varying" json:"modified_by"`
+	DeletedBy   *string              `gorm:"column:deleted_by;type:character varying" json:"deleted_by"`
+	Title       *string              `gorm:"column:title;type:character varying" json:"title"`
+	PassageText string               `gorm:"column:passage_text;type:text;not null" json:"passage_text"`
+	Source      *string              `gorm:"column:source;type:character varying" json:"source"`
+	ImageURL    *string              `gorm:"column:image_url;type:character varying" json:"image_url"`
+	JlptLevel   *string              `gorm:"column:jlpt_level;type:character varying" json:"jlpt_level"`
+}
+
+func (*Passage) TableName() string {
+	return "passages"
+}
+
+func (m *Passage) BeforeCreate(tx *gorm.DB) (err error) {
+	m.CreatedAt = time.Now().UnixMilli()
+	if m.PassageID == "" {
+		m.PassageID = uuid.NewString()
+	}
+	return
+}
+
+func (m *Passage) BeforeUpdate(tx *gorm.DB) (err error) {
+	now := time.Now().UnixMilli()
+	m.ModifiedAt = &now
+	return
+}
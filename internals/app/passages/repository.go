@@ -0,0 +1,101 @@
+package passages
+
+import (
+	"wakuwaku_nihongo/internals/pkg/softdelete"
+	"wakuwaku_nihongo/internals/pkg/sqlutil"
+	"wakuwaku_nihongo/internals/query"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type repo struct {
+	db *gorm.DB
+	*query.Query
+}
+
+func NewRepo(db *gorm.DB) *repo {
+	return &repo{
+		db:    db,
+		Query: query.Use(db),
+	}
+}
+
+func (r *repo) List(ctx echo.Context, filter *PassageFilter) (out []*Passage, count int64, err error) {
+	db := r.db.Model(&Passage{})
+	if filter.IncludeDeleted {
+		db = db.Unscoped()
+	}
+	if filter.JlptLevel != "" {
+		db = db.Where("jlpt_level = ?", filter.JlptLevel)
+	}
+	if filter.Search != "" {
+		like := sqlutil.Contains(filter.Search)
+		db = db.Where("title ILIKE ? OR passage_text ILIKE ? OR source ILIKE ?", like, like, like)
+	}
+	db = db.Session(&gorm.Session{})
+
+	if err = db.Count(&count).Error; err != nil {
+		return
+	}
+
+	out = []*Passage{}
+	sortBy := *filter.SortBy
+	if !SORTABLE_COLUMNS[sortBy] {
+		sortBy = DEFAULT_SORT_BY
+	}
+	err = db.Order(clause.OrderByColumn{
+		Column: clause.Column{Name: sortBy},
+		Desc:   filter.GetOrderBy() == "desc",
+	}).
+		Limit(filter.Limit()).
+		Offset(filter.Offset()).
+		Find(&out).Error
+	return
+}
+
+func (r *repo) GetByID(ctx echo.Context, id string) (out *Passage, err error) {
+	out = &Passage{}
+	err = r.db.Where("passage_id = ?", id).First(out).Error
+	return
+}
+
+func (r *repo) GetByIDs(ctx echo.Context, ids []string) (out []*Passage, err error) {
+	out = []*Passage{}
+	if len(ids) == 0 {
+		return
+	}
+	err = r.db.Where("passage_id IN ?", ids).Find(&out).Error
+	return
+}
+
+func (r *repo) GetQuestionIDs(ctx echo.Context, passageID string) (out []string, err error) {
+	q := r.Question
+	err = q.Where(q.PassageID.Eq(passageID)).
+		Order(q.CreatedAt).
+		Pluck(q.QuestionID, &out)
+	return
+}
+
+func (r *repo) Create(ctx echo.Context, in *Passage) (err error) {
+	return r.db.Create(in).Error
+}
+
+func (r *repo) Update(ctx echo.Context, in *Passage) (err error) {
+	return r.db.Select("title", "passage_text", "source", "image_url", "jlpt_level",
+		"modified_at", "modified_by").
+		Updates(in).Error
+}
+
+// Delete soft deletes the passage. Its questions keep the reference and are
+// shown without the passage until it is restored.
+func (r *repo) Delete(ctx echo.Context, id string, deletedBy string) (err error) {
+	return softdelete.By(r.db, deletedBy).Where("passage_id = ?", id).Delete(&Passage{}).Error
+}
+
+// Restore undeletes the passage, restored is false when it was not deleted.
+func (r *repo) Restore(ctx echo.Context, id string) (restored bool, err error) {
+	res := softdelete.Restore(r.db.Model(&Passage{}).Where("passage_id = ?", id))
+	return res.RowsAffected > 0, res.Error
+}
@@ -0,0 +1,19 @@
+package passages
+
+import (
+	"github.com/labstack/echo/v4"
+	"wakuwaku_nihongo/internals/middleware"
+	"wakuwaku_nihongo/internals/utils/token"
+)
+
+func (h *handler) Route(g *echo.Group) {
+	editor := middleware.Authorization(token.ROLE_EDITOR)
+	admin := middleware.Authorization(token.ROLE_ADMIN)
+
+	g.GET("", h.List, middleware.Authentication, editor)
+	g.GET("/:id", h.Get, middleware.Authentication, editor)
+	g.POST("", h.Create, middleware.Authentication, editor)
+	g.PUT("/:id", h.Update, middleware.Authentication, editor)
+	g.DELETE("/:id", h.Delete, middleware.Authentication, editor)
+	g.POST("/:id/restore", h.Restore, middleware.Authentication, admin)
+}
@@ -0,0 +1,173 @@
+package passages
+
+import (
+	"errors"
+	"fmt"
+
+	"wakuwaku_nihongo/internals/abstraction"
+	"wakuwaku_nihongo/internals/app/media"
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/middleware"
+	"wakuwaku_nihongo/internals/pkg/ruby"
+	"wakuwaku_nihongo/internals/utils/response"
+	"wakuwaku_nihongo/internals/utils/token"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+type IPassageRepo interface {
+	List(ctx echo.Context, filter *PassageFilter) (out []*Passage, count int64, err error)
+	GetByID(ctx echo.Context, id string) (out *Passage, err error)
+	GetQuestionIDs(ctx echo.Context, passageID string) (out []string, err error)
+	Create(ctx echo.Context, in *Passage) (err error)
+	Update(ctx echo.Context, in *Passage) (err error)
+	Delete(ctx echo.Context, id string, deletedBy string) (err error)
+	Restore(ctx echo.Context, id string) (restored bool, err error)
+}
+
+type IAudioProvider interface {
+	GetAudio(ctx echo.Context, ownerType string, ownerIDs []string) (out map[string]*media.MediaResponse, err error)
+}
+
+type service struct {
+	repo  IPassageRepo
+	audio IAudioProvider
+}
+
+func NewService(f *factory.Factory) *service {
+	return &service{
+		repo:  NewRepo(f.Db),
+		audio: media.NewService(f),
+	}
+}
+
+func (s *service) List(ctx echo.Context, filter *PassageFilter) (out []*PassageResponse, info *abstraction.PaginationInfo, err error) {
+	if filter.IncludeDeleted && middleware.GetRole(ctx) != token.ROLE_ADMIN {
+		err = response.ErrorWrap(response.ErrForbidden, fmt.Errorf("only admins can include deleted passages"))
+		return
+	}
+
+	passages, count, err := s.repo.List(ctx, filter)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	out = []*PassageResponse{}
+	for _, val := range passages {
+		passage := &PassageResponse{}
+		passage.MapFromModel(val)
+		out = append(out, passage)
+	}
+	info = filter.Pagination.CreatePageInfo(count)
+	return
+}
+
+// Get returns the passage with the questions referencing it and its audio.
+func (s *service) Get(ctx echo.Context, id string, format ruby.Format) (out *PassageResponse, err error) {
+	passage, err := s.getByID(ctx, id)
+	if err != nil {
+		return
+	}
+
+	questionIDs, err := s.repo.GetQuestionIDs(ctx, id)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	audio, err := s.audio.GetAudio(ctx, media.OWNER_TYPE_PASSAGE, []string{id})
+	if err != nil {
+		return
+	}
+
+	out = &PassageResponse{}
+	out.MapFromModelWithFormat(passage, format)
+	out.QuestionIDs = questionIDs
+	out.Audio = audio[id]
+	return
+}
+
+func (s *service) Create(ctx echo.Context, in *PassageRequest) (out *PassageResponse, err error) {
+	passage := &Passage{}
+	in.MapToModel(passage)
+	passage.CreatedBy = middleware.GetUserID(ctx)
+
+	err = s.repo.Create(ctx, passage)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	out = &PassageResponse{}
+	out.MapFromModel(passage)
+	return
+}
+
+func (s *service) Update(ctx echo.Context, id string, in *PassageRequest) (out *PassageResponse, err error) {
+	passage, err := s.getByID(ctx, id)
+	if err != nil {
+		return
+	}
+
+	in.MapToModel(passage)
+	userID := middleware.GetUserID(ctx)
+	passage.ModifiedBy = &userID
+
+	err = s.repo.Update(ctx, passage)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	out = &PassageResponse{}
+	out.MapFromModel(passage)
+	return
+}
+
+func (s *service) Delete(ctx echo.Context, id string) (err error) {
+	if _, err = s.getByID(ctx, id); err != nil {
+		return
+	}
+
+	err = s.repo.Delete(ctx, id, middleware.GetUserID(ctx))
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	return
+}
+
+// Restore undeletes a passage (admin only).
+func (s *service) Restore(ctx echo.Context, id string) (out *PassageResponse, err error) {
+	restored, err := s.repo.Restore(ctx, id)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	if !restored {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("deleted passage not found"))
+		return
+	}
+	return s.Get(ctx, id, ruby.FormatMarkup)
+}
+
+// Exists reports whether a passage that has not been deleted has the ID.
+func (s *service) Exists(ctx echo.Context, id string) (ok bool, err error) {
+	_, err = s.repo.GetByID(ctx, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (s *service) getByID(ctx echo.Context, id string) (out *Passage, err error) {
+	out, err = s.repo.GetByID(ctx, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("passage not found"))
+		return
+	}
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	return
+}
@@ -0,0 +1,21 @@
+package plans
+
+const (
+	TASK_KANJI      = "kanji"
+	TASK_VOCABULARY = "vocabulary"
+	TASK_GRAMMAR    = "grammar"
+	TASK_MOCK_EXAM  = "mock_exam"
+
+	// a mock exam closes every this many weeks of study
+	MOCK_EXAM_EVERY_WEEKS = 4
+	// the last weeks before the exam hold a mock exam each and no new
+	// material, short plans keep fewer of them
+	REVIEW_WEEKS = 2
+
+	// an exam date must leave at least a week to study and at most two years
+	MIN_PLAN_DAYS = 7
+	MAX_PLAN_DAYS = 731
+)
+
+// TASK_TYPES is the order tasks of a day are listed in.
+var TASK_TYPES = []string{TASK_MOCK_EXAM, TASK_GRAMMAR, TASK_VOCABULARY, TASK_KANJI}
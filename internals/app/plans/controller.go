@@ -0,0 +1,138 @@
+package plans
+
+import (
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+type IPlanService interface {
+	Create(ctx echo.Context, in *PlanRequest) (out *PlanResponse, err error)
+	Get(ctx echo.Context, filter *PlanFilter) (out *PlanResponse, err error)
+	UpdateTask(ctx echo.Context, taskID string, in *TaskRequest) (out *TaskResponse, err error)
+	Delete(ctx echo.Context) (err error)
+}
+
+type handler struct {
+	service IPlanService
+}
+
+func NewHandler(f *factory.Factory) *handler {
+	return &handler{
+		service: NewService(f),
+	}
+}
+
+// @Summary Create Study Plan
+// @Description Plan the kanji, vocabulary, grammar points and mock exams of a JLPT level week by week from today to the exam, replacing the current plan. exam_date must be in July or December and defaults to the next sitting
+// @Tags plan
+// @Accept json
+// @Produce json
+// @Param payload body PlanRequest true "Payload"
+// @Success 200 {object} response.Success{data=PlanResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 401 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/me/plan [put]
+func (h *handler) Create(c echo.Context) error {
+	req := &PlanRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.Create(c, req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Get Study Plan
+// @Description Get the study plan of the logged in customer with the tasks of a day and an overview of every week. When tasks of past days are not completed the remaining work is spread again over the days left
+// @Tags plan
+// @Produce json
+// @Param date query string false "Day of the tasks (YYYY-MM-DD), defaults to today"
+// @Success 200 {object} response.Success{data=PlanResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 401 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/me/plan [get]
+func (h *handler) Get(c echo.Context) error {
+	req := &PlanFilter{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.Get(c, req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Update Study Task
+// @Description Mark a task of the study plan as completed or not. Mock exams are also completed by submitting an attempt of their quiz
+// @Tags plan
+// @Accept json
+// @Produce json
+// @Param task_id path string true "Task ID"
+// @Param payload body TaskRequest true "Payload"
+// @Success 200 {object} response.Success{data=TaskResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 401 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/me/plan/tasks/{task_id} [put]
+func (h *handler) UpdateTask(c echo.Context) error {
+	req := &TaskRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.UpdateTask(c, c.Param("task_id"), req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Delete Study Plan
+// @Description Delete the study plan of the logged in customer
+// @Tags plan
+// @Produce json
+// @Success 200 {object} response.Success{data=string}
+// @Failure 401 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/me/plan [delete]
+func (h *handler) Delete(c echo.Context) error {
+	err := h.service.Delete(c)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse("deleted").Send(c)
+}
@@ -0,0 +1,127 @@
+package plans
+
+import (
+	"time"
+
+	"wakuwaku_nihongo/internals/app/stats"
+)
+
+type PlanRequest struct {
+	JlptLevel string `json:"jlpt_level" validate:"required,oneof=N5 N4 N3 N2 N1"`
+	// ExamDate defaults to the next sitting that leaves a week to study.
+	ExamDate string `json:"exam_date" validate:"omitempty,is-date"`
+}
+
+type PlanFilter struct {
+	Date string `query:"date" validate:"omitempty,is-date"`
+}
+
+type TaskRequest struct {
+	Completed *bool `json:"completed" validate:"required"`
+}
+
+type TaskResponse struct {
+	TaskID      string `json:"task_id"`
+	TaskType    string `json:"task_type"`
+	RefID       string `json:"ref_id"`
+	Title       string `json:"title"`
+	Day         string `json:"day"`
+	Completed   bool   `json:"completed"`
+	CompletedAt *int64 `json:"completed_at"`
+}
+
+type WeekResponse struct {
+	Week       int    `json:"week"`
+	From       string `json:"from"`
+	To         string `json:"to"`
+	Kanji      int    `json:"kanji"`
+	Vocabulary int    `json:"vocabulary"`
+	Grammar    int    `json:"grammar"`
+	MockExams  int    `json:"mock_exams"`
+	Total      int    `json:"total"`
+	Completed  int    `json:"completed"`
+}
+
+type PlanResponse struct {
+	JlptLevel   string          `json:"jlpt_level"`
+	ExamDate    string          `json:"exam_date"`
+	StartDate   string          `json:"start_date"`
+	Today       string          `json:"today"`
+	DaysLeft    int             `json:"days_left"`
+	Total       int             `json:"total"`
+	Completed   int             `json:"completed"`
+	ReplannedAt *int64          `json:"replanned_at"`
+	Date        string          `json:"date"`
+	Tasks       []*TaskResponse `json:"tasks"`
+	Weeks       []*WeekResponse `json:"weeks"`
+}
+
+func (r *TaskResponse) MapFromModel(m *Task) {
+	r.TaskID = m.TaskID
+	r.TaskType = m.TaskType
+	r.RefID = m.RefID
+	r.Title = m.Title
+	r.Day = m.Day.Format(stats.DATE_LAYOUT)
+	r.Completed = m.CompletedAt != nil
+	r.CompletedAt = m.CompletedAt
+}
+
+// MapFromModel maps plan with the tasks of date and an overview of every
+// week.
+func (r *PlanResponse) MapFromModel(m *Plan, tasks []*Task, today, date time.Time) {
+	r.JlptLevel = m.JlptLevel
+	r.ExamDate = m.ExamDate.Format(stats.DATE_LAYOUT)
+	r.StartDate = m.StartDate.Format(stats.DATE_LAYOUT)
+	r.Today = today.Format(stats.DATE_LAYOUT)
+	r.DaysLeft = max(daysBetween(today, m.ExamDate), 0)
+	r.ReplannedAt = m.ReplannedAt
+	r.Date = date.Format(stats.DATE_LAYOUT)
+	r.Tasks = []*TaskResponse{}
+
+	weeks := (daysBetween(m.StartDate, m.ExamDate) + 6) / 7
+	r.Weeks = make([]*WeekResponse, 0, weeks)
+	for w := 0; w < weeks; w++ {
+		from := m.StartDate.AddDate(0, 0, w*7)
+		to := from.AddDate(0, 0, 6)
+		if last := m.ExamDate.AddDate(0, 0, -1); to.After(last) {
+			to = last
+		}
+		r.Weeks = append(r.Weeks, &WeekResponse{
+			Week: w + 1,
+			From: from.Format(stats.DATE_LAYOUT),
+			To:   to.Format(stats.DATE_LAYOUT),
+		})
+	}
+
+	for _, t := range tasks {
+		r.Total++
+		if t.CompletedAt != nil {
+			r.Completed++
+		}
+		if t.Day.Equal(date) {
+			task := &TaskResponse{}
+			task.MapFromModel(t)
+			r.Tasks = append(r.Tasks, task)
+		}
+
+		w := Week(m.StartDate, t.Day)
+		if w < 0 || w >= len(r.Weeks) {
+			continue
+		}
+		week := r.Weeks[w]
+		week.Total++
+		if t.CompletedAt != nil {
+			week.Completed++
+		}
+		switch t.TaskType {
+		case TASK_KANJI:
+			week.Kanji++
+		case TASK_VOCABULARY:
+			week.Vocabulary++
+		case TASK_GRAMMAR:
+			week.Grammar++
+		case TASK_MOCK_EXAM:
+			week.MockExams++
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package plans
+
+import (
+	"time"
+
+	"wakuwaku_nihongo/internals/pkg/softdelete"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Plan is the customer's study plan, a customer has at most one. Days are
+// calendar dates of the customer's timezone stored at midnight UTC.
+type Plan struct {
+	CustomerID  string               `gorm:"column:customer_id;type:uuid;primaryKey" json:"customer_id"`
+	CreatedAt   int64                `gorm:"column:created_at;type:bigint;not null" json:"created_at"`
+	ModifiedAt  *int64               `gorm:"column:modified_at;type:bigint" json:"modified_at"`
+	JlptLevel   string               `gorm:"column:jlpt_level;type:character varying;not null" json:"jlpt_level"`
+	ExamDate    time.Time            `gorm:"column:exam_date;type:date;not null" json:"exam_date"`
+	StartDate   time.Time            `gorm:"column:start_date;type:date;not null" json:"start_date"`
+	ReplannedAt *int64               `gorm:"column:replanned_at;type:bigint" json:"replanned_at"`
+	DeletedAt   softdelete.DeletedAt `gorm:"column:deleted_at;type:bigint" json:"deleted_at"`
+	DeletedBy   *string              `gorm:"column:deleted_by;type:character varying" json:"deleted_by"`
+}
+
+func (*Plan) TableName() string {
+	return "study_plans"
+}
+
+func (m *Plan) BeforeCreate(tx *gorm.DB) (err error) {
+	m.CreatedAt = time.Now().UnixMilli()
+	return
+}
+
+// Task is one thing to study on a day. RefID points at what is studied, the
+// kanji itself, a vocabulary, a grammar point or the quiz taken as a mock
+// exam, and Title is a copy of its text at planning time.
+type Task struct {
+	TaskID      string               `gorm:"column:task_id;type:uuid;primaryKey" json:"task_id"`
+	CreatedAt   int64                `gorm:"column:created_at;type:bigint;not null" json:"created_at"`
+	CustomerID  string               `gorm:"column:customer_id;type:uuid;not null" json:"customer_id"`
+	TaskType    string               `gorm:"column:task_type;type:character varying;not null" json:"task_type"`
+	RefID       string               `gorm:"column:ref_id;type:character varying;not null" json:"ref_id"`
+	Title       string               `gorm:"column:title;type:character varying;not null" json:"title"`
+	Day         time.Time            `gorm:"column:day;type:date;not null" json:"day"`
+	Position    int                  `gorm:"column:position;type:integer;not null" json:"position"`
+	CompletedAt *int64               `gorm:"column:completed_at;type:bigint" json:"completed_at"`
+	DeletedAt   softdelete.DeletedAt `gorm:"column:deleted_at;type:bigint" json:"deleted_at"`
+	DeletedBy   *string              `gorm:"column:deleted_by;type:character varying" json:"deleted_by"`
+}
+
+func (*Task) TableName() string {
+	return "study_plan_tasks"
+}
+
+func (m *Task) BeforeCreate(tx *gorm.DB) (err error) {
+	m.CreatedAt = time.Now().UnixMilli()
+	if m.TaskID == "" {
+		m.TaskID = uuid.NewString()
+	}
+	return
+}
+
+// Item is something of the level to study, before it is given a day.
+type Item struct {
+	RefID string
+	Title string
+}
+
+// Content is what a level has to study, in the order it is learnt.
+type Content struct {
+	Kanji      []Item
+	Vocabulary []Item
+	Grammar    []Item
+	MockExams  []Item
+}
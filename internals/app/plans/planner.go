@@ -0,0 +1,249 @@
+package plans
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+const day = 24 * time.Hour
+
+// Sitting returns the JLPT sitting of a month, held on its first Sunday.
+func Sitting(year int, month time.Month) time.Time {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	return first.AddDate(0, 0, (7-int(first.Weekday()))%7)
+}
+
+// NextSitting returns the first July or December sitting that leaves at
+// least MIN_PLAN_DAYS to study from today.
+func NextSitting(today time.Time) time.Time {
+	for year := today.Year(); ; year++ {
+		for _, month := range []time.Month{time.July, time.December} {
+			if s := Sitting(year, month); daysBetween(today, s) >= MIN_PLAN_DAYS {
+				return s
+			}
+		}
+	}
+}
+
+// CheckExamDate accepts any day of July or December, the sittings abroad
+// are not always held on the first Sunday.
+func CheckExamDate(exam, today time.Time) error {
+	if exam.Month() != time.July && exam.Month() != time.December {
+		return fmt.Errorf("the JLPT is only held in July and December")
+	}
+	days := daysBetween(today, exam)
+	if days < MIN_PLAN_DAYS {
+		return fmt.Errorf("exam date must be at least %d days away", MIN_PLAN_DAYS)
+	}
+	if days > MAX_PLAN_DAYS {
+		return fmt.Errorf("exam date must be at most %d days away", MAX_PLAN_DAYS)
+	}
+	return nil
+}
+
+// Week returns the week of the plan day falls in, counted from 0.
+func Week(start, d time.Time) int {
+	return daysBetween(start, d) / 7
+}
+
+// Behind reports whether a task of a day before today is not completed.
+func Behind(tasks []*Task, today time.Time) bool {
+	for _, t := range tasks {
+		if t.CompletedAt == nil && t.Day.Before(today) {
+			return true
+		}
+	}
+	return false
+}
+
+// Build spreads content over the days from start to the day before the
+// exam. Mock exams close every MOCK_EXAM_EVERY_WEEKS weeks and open each
+// review week, a level with fewer quizzes leaves the last slots empty. The
+// rest of the content is spread evenly over the other days of the weeks
+// before the review.
+func Build(customerID string, content *Content, start, exam time.Time) []*Task {
+	l := newLayout(start, exam)
+	tasks := []*Task{}
+	for i, item := range content.MockExams {
+		if i >= len(l.mock) {
+			break
+		}
+		tasks = append(tasks, &Task{CustomerID: customerID, TaskType: TASK_MOCK_EXAM, RefID: item.RefID, Title: item.Title, Day: l.mock[i]})
+	}
+
+	add := func(taskType string, items []Item) {
+		for _, item := range items {
+			tasks = append(tasks, &Task{CustomerID: customerID, TaskType: taskType, RefID: item.RefID, Title: item.Title})
+		}
+		spread(tasks[len(tasks)-len(items):], l.learning)
+	}
+	add(TASK_GRAMMAR, content.Grammar)
+	add(TASK_VOCABULARY, content.Vocabulary)
+	add(TASK_KANJI, content.Kanji)
+
+	number(tasks)
+	return tasks
+}
+
+// Replan moves the tasks a learner fell behind on to the days left. Overdue
+// mock exams take the next free mock exam slot, or today when there is
+// none. The material not yet studied, overdue or not, is spread again over
+// the study days left so the load stays even. It returns the tasks whose
+// day or position changed, none when the learner is not behind or the exam
+// has passed.
+func Replan(plan *Plan, tasks []*Task, today time.Time) (changed []*Task) {
+	if !Behind(tasks, today) || !today.Before(plan.ExamDate) {
+		return
+	}
+	before := map[*Task]Task{}
+	for _, t := range tasks {
+		before[t] = *t
+	}
+	sortTasks(tasks)
+
+	l := newLayout(plan.StartDate, plan.ExamDate)
+	taken := map[int64]bool{}
+	overdue := []*Task{}
+	for _, t := range tasks {
+		if t.TaskType != TASK_MOCK_EXAM {
+			continue
+		}
+		if t.CompletedAt == nil && t.Day.Before(today) {
+			overdue = append(overdue, t)
+			continue
+		}
+		taken[t.Day.Unix()] = true
+	}
+	for _, t := range overdue {
+		t.Day = today
+		for _, d := range l.mock {
+			if !d.Before(today) && !taken[d.Unix()] {
+				t.Day = d
+				break
+			}
+		}
+		taken[t.Day.Unix()] = true
+	}
+
+	days := free(l.learning, today, taken)
+	if len(days) == 0 {
+		remaining := []time.Time{}
+		for d := today; d.Before(plan.ExamDate); d = d.Add(day) {
+			remaining = append(remaining, d)
+		}
+		if days = free(remaining, today, taken); len(days) == 0 {
+			days = remaining
+		}
+	}
+	for _, taskType := range TASK_TYPES {
+		if taskType == TASK_MOCK_EXAM {
+			continue
+		}
+		pending := []*Task{}
+		for _, t := range tasks {
+			if t.TaskType == taskType && t.CompletedAt == nil {
+				pending = append(pending, t)
+			}
+		}
+		spread(pending, days)
+	}
+
+	number(tasks)
+	for _, t := range tasks {
+		if b := before[t]; !b.Day.Equal(t.Day) || b.Position != t.Position {
+			changed = append(changed, t)
+		}
+	}
+	return
+}
+
+// layout splits the days from start to the day before exam into the days
+// new material is studied on and the days of mock exams.
+type layout struct {
+	learning []time.Time
+	mock     []time.Time
+}
+
+func newLayout(start, exam time.Time) *layout {
+	n := daysBetween(start, exam)
+	weeks := (n + 6) / 7
+	review := min(REVIEW_WEEKS, (weeks-1)/2)
+
+	mocks := map[int]bool{}
+	for w := 0; w < weeks; w++ {
+		switch {
+		case w >= weeks-review:
+			mocks[w*7] = true
+		case (w+1)%MOCK_EXAM_EVERY_WEEKS == 0:
+			mocks[min(w*7+6, n-1)] = true
+		}
+	}
+
+	l := &layout{}
+	for i := 0; i < n; i++ {
+		d := start.AddDate(0, 0, i)
+		switch {
+		case mocks[i]:
+			l.mock = append(l.mock, d)
+		case i < (weeks-review)*7:
+			l.learning = append(l.learning, d)
+		}
+	}
+	return l
+}
+
+// spread gives tasks days evenly, in order.
+func spread(tasks []*Task, days []time.Time) {
+	if len(days) == 0 {
+		return
+	}
+	for i, t := range tasks {
+		t.Day = days[i*len(days)/len(tasks)]
+	}
+}
+
+// free returns the days from today on that are not taken.
+func free(days []time.Time, today time.Time, taken map[int64]bool) []time.Time {
+	out := []time.Time{}
+	for _, d := range days {
+		if !d.Before(today) && !taken[d.Unix()] {
+			out = append(out, d)
+		}
+	}
+	return out
+}
+
+// number sorts tasks by day and type and numbers them within their day.
+func number(tasks []*Task) {
+	rank := map[string]int{}
+	for i, taskType := range TASK_TYPES {
+		rank[taskType] = i
+	}
+	sort.SliceStable(tasks, func(i, j int) bool {
+		if !tasks[i].Day.Equal(tasks[j].Day) {
+			return tasks[i].Day.Before(tasks[j].Day)
+		}
+		return rank[tasks[i].TaskType] < rank[tasks[j].TaskType]
+	})
+	for i, t := range tasks {
+		t.Position = 0
+		if i > 0 && tasks[i-1].Day.Equal(t.Day) {
+			t.Position = tasks[i-1].Position + 1
+		}
+	}
+}
+
+// sortTasks restores the planned order, by day and position.
+func sortTasks(tasks []*Task) {
+	sort.SliceStable(tasks, func(i, j int) bool {
+		if !tasks[i].Day.Equal(tasks[j].Day) {
+			return tasks[i].Day.Before(tasks[j].Day)
+		}
+		return tasks[i].Position < tasks[j].Position
+	})
+}
+
+func daysBetween(from, to time.Time) int {
+	return int(to.Sub(from) / day)
+}
@@ -0,0 +1,166 @@
+package plans
+
+import (
+	"fmt"
+
+	"wakuwaku_nihongo/internals/app/grammar"
+	"wakuwaku_nihongo/internals/app/quizzes"
+	"wakuwaku_nihongo/internals/app/vocabulary"
+	"wakuwaku_nihongo/internals/model"
+	"wakuwaku_nihongo/internals/pkg/softdelete"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type repo struct {
+	db *gorm.DB
+}
+
+func NewRepo(db *gorm.DB) *repo {
+	return &repo{
+		db: db,
+	}
+}
+
+func (r *repo) Get(ctx echo.Context, customerID string) (out *Plan, err error) {
+	out = &Plan{}
+	err = r.db.Where("customer_id = ?", customerID).First(out).Error
+	return
+}
+
+// Replace stores plan and its tasks in place of the customer's current or
+// deleted plan, soft deleting the tasks of the plan it replaces.
+func (r *repo) Replace(ctx echo.Context, plan *Plan, tasks []*Task) (err error) {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		err := softdelete.By(tx, plan.CustomerID).Where("customer_id = ?", plan.CustomerID).Delete(&Task{}).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "customer_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"created_at", "modified_at", "jlpt_level", "exam_date", "start_date", "replanned_at", "deleted_at", "deleted_by"}),
+		}).Create(plan).Error
+		if err != nil || len(tasks) == 0 {
+			return err
+		}
+		return tx.CreateInBatches(tasks, 500).Error
+	})
+}
+
+// Delete soft deletes the customer's plan with its tasks, the customer
+// deletes their own.
+func (r *repo) Delete(ctx echo.Context, customerID string) (deleted bool, err error) {
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		tx = softdelete.By(tx, customerID)
+		if err := tx.Where("customer_id = ?", customerID).Delete(&Task{}).Error; err != nil {
+			return err
+		}
+		res := tx.Where("customer_id = ?", customerID).Delete(&Plan{})
+		deleted = res.RowsAffected > 0
+		return res.Error
+	})
+	return
+}
+
+func (r *repo) ListTasks(ctx echo.Context, customerID string) (out []*Task, err error) {
+	out = []*Task{}
+	err = r.db.Where("customer_id = ?", customerID).
+		Order("day, position").
+		Find(&out).Error
+	return
+}
+
+// Reschedule saves the new days and positions of tasks and when the plan
+// was changed.
+func (r *repo) Reschedule(ctx echo.Context, plan *Plan, tasks []*Task) (err error) {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		for _, t := range tasks {
+			err := tx.Model(&Task{}).
+				Where("task_id = ?", t.TaskID).
+				Updates(map[string]any{"day": t.Day, "position": t.Position}).Error
+			if err != nil {
+				return err
+			}
+		}
+		return tx.Model(&Plan{}).
+			Where("customer_id = ?", plan.CustomerID).
+			Updates(map[string]any{"replanned_at": plan.ReplannedAt, "modified_at": plan.ModifiedAt}).Error
+	})
+}
+
+func (r *repo) SetCompleted(ctx echo.Context, customerID string, taskID string, completedAt *int64) (out *Task, err error) {
+	res := r.db.Model(&Task{}).
+		Where("task_id = ? AND customer_id = ?", taskID, customerID).
+		Update("completed_at", completedAt)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	out = &Task{}
+	err = r.db.Where("task_id = ?", taskID).First(out).Error
+	return
+}
+
+// CompleteMockExams completes the mock exams whose quiz the customer
+// submitted an attempt of since the plan was made.
+func (r *repo) CompleteMockExams(ctx echo.Context, plan *Plan) (err error) {
+	return r.db.Exec(`UPDATE study_plan_tasks t SET completed_at = a.submitted_at
+		FROM (
+			SELECT quiz_id::text AS quiz_id, MIN(submitted_at) AS submitted_at FROM attempts
+			WHERE customer_id = ? AND status = 'submitted' AND deleted_at IS NULL AND submitted_at >= ?
+			GROUP BY quiz_id
+		) a
+		WHERE t.customer_id = ? AND t.task_type = ? AND t.completed_at IS NULL AND t.deleted_at IS NULL AND t.ref_id = a.quiz_id`,
+		plan.CustomerID, plan.CreatedAt, plan.CustomerID, TASK_MOCK_EXAM).Error
+}
+
+// GetContent returns what a level has to study, the oldest first. The kanji
+// come from the level's list rather than the database.
+func (r *repo) GetContent(ctx echo.Context, level string) (out *Content, err error) {
+	out = &Content{}
+
+	vocabularies := []*vocabulary.Vocabulary{}
+	err = r.db.Select("vocabulary_id, word, reading").
+		Where("jlpt_level = ?", level).
+		Order("created_at, vocabulary_id").
+		Find(&vocabularies).Error
+	if err != nil {
+		return
+	}
+	for _, v := range vocabularies {
+		title := v.Word
+		if v.Reading != "" && v.Reading != v.Word {
+			title = fmt.Sprintf("%s (%s)", v.Word, v.Reading)
+		}
+		out.Vocabulary = append(out.Vocabulary, Item{RefID: v.VocabularyID, Title: title})
+	}
+
+	points := []*grammar.GrammarPoint{}
+	err = r.db.Select("grammar_point_id, pattern").
+		Where("jlpt_level = ?", level).
+		Order("created_at, grammar_point_id").
+		Find(&points).Error
+	if err != nil {
+		return
+	}
+	for _, p := range points {
+		out.Grammar = append(out.Grammar, Item{RefID: p.GrammarPointID, Title: p.Pattern})
+	}
+
+	exams := []*model.Quiz{}
+	err = r.db.Select("quiz_id, title").
+		Where("jlpt_level = ? AND status = ?", level, quizzes.QUIZ_STATUS_PUBLISHED).
+		Order("created_at, quiz_id").
+		Find(&exams).Error
+	if err != nil {
+		return
+	}
+	for _, q := range exams {
+		out.MockExams = append(out.MockExams, Item{RefID: q.QuizID, Title: q.Title})
+	}
+	return
+}
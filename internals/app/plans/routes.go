@@ -0,0 +1,13 @@
+package plans
+
+import (
+	"github.com/labstack/echo/v4"
+	"wakuwaku_nihongo/internals/middleware"
+)
+
+func (h *handler) Route(g *echo.Group) {
+	g.GET("", h.Get, middleware.Authentication)
+	g.PUT("", h.Create, middleware.Authentication)
+	g.DELETE("", h.Delete, middleware.Authentication)
+	g.PUT("/tasks/:task_id", h.UpdateTask, middleware.Authentication)
+}
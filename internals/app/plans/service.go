@@ -0,0 +1,180 @@
+package plans
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"wakuwaku_nihongo/internals/app/preferences"
+	"wakuwaku_nihongo/internals/app/stats"
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/middleware"
+	"wakuwaku_nihongo/internals/pkg/kana"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+type IPlanRepo interface {
+	Get(ctx echo.Context, customerID string) (out *Plan, err error)
+	Replace(ctx echo.Context, plan *Plan, tasks []*Task) (err error)
+	Delete(ctx echo.Context, customerID string) (deleted bool, err error)
+	ListTasks(ctx echo.Context, customerID string) (out []*Task, err error)
+	Reschedule(ctx echo.Context, plan *Plan, tasks []*Task) (err error)
+	SetCompleted(ctx echo.Context, customerID string, taskID string, completedAt *int64) (out *Task, err error)
+	CompleteMockExams(ctx echo.Context, plan *Plan) (err error)
+	GetContent(ctx echo.Context, level string) (out *Content, err error)
+}
+
+type ILocationProvider interface {
+	Location(ctx echo.Context, customerID string) *time.Location
+}
+
+type service struct {
+	repo      IPlanRepo
+	locations ILocationProvider
+}
+
+func NewService(f *factory.Factory) *service {
+	return &service{
+		repo:      NewRepo(f.Db),
+		locations: preferences.NewService(f),
+	}
+}
+
+// Create plans the level from today to the exam, replacing the customer's
+// current plan and its progress.
+func (s *service) Create(ctx echo.Context, in *PlanRequest) (out *PlanResponse, err error) {
+	customerID := middleware.GetUserID(ctx)
+	today := s.today(ctx, customerID)
+
+	exam := NextSitting(today)
+	if in.ExamDate != "" {
+		if exam, err = time.Parse(stats.DATE_LAYOUT, in.ExamDate); err != nil {
+			err = response.ErrorWrap(response.ErrBadRequest, err)
+			return
+		}
+	}
+	if err = CheckExamDate(exam, today); err != nil {
+		err = response.ErrorWrap(response.ErrBadRequest, err)
+		return
+	}
+
+	content, err := s.repo.GetContent(ctx, in.JlptLevel)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	for _, k := range kana.JLPTKanji(in.JlptLevel) {
+		content.Kanji = append(content.Kanji, Item{RefID: k, Title: k})
+	}
+
+	plan := &Plan{
+		CustomerID: customerID,
+		JlptLevel:  in.JlptLevel,
+		ExamDate:   exam,
+		StartDate:  today,
+	}
+	tasks := Build(customerID, content, today, exam)
+	if err = s.repo.Replace(ctx, plan, tasks); err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	out = &PlanResponse{}
+	out.MapFromModel(plan, tasks, today, today)
+	return
+}
+
+// Get returns the plan with the tasks of a day, today by default. Mock
+// exams taken since are completed first and a learner who fell behind gets
+// the plan spread again over the days left.
+func (s *service) Get(ctx echo.Context, filter *PlanFilter) (out *PlanResponse, err error) {
+	customerID := middleware.GetUserID(ctx)
+	today := s.today(ctx, customerID)
+	date := today
+	if filter.Date != "" {
+		if date, err = time.Parse(stats.DATE_LAYOUT, filter.Date); err != nil {
+			err = response.ErrorWrap(response.ErrBadRequest, err)
+			return
+		}
+	}
+
+	plan, err := s.get(ctx, customerID)
+	if err != nil {
+		return
+	}
+	if err = s.repo.CompleteMockExams(ctx, plan); err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	tasks, err := s.repo.ListTasks(ctx, customerID)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	if changed := Replan(plan, tasks, today); len(changed) > 0 {
+		now := time.Now().UnixMilli()
+		plan.ReplannedAt = &now
+		plan.ModifiedAt = &now
+		if err = s.repo.Reschedule(ctx, plan, changed); err != nil {
+			err = response.ErrorWrap(response.ErrInternalServerError, err)
+			return
+		}
+	}
+
+	out = &PlanResponse{}
+	out.MapFromModel(plan, tasks, today, date)
+	return
+}
+
+func (s *service) UpdateTask(ctx echo.Context, taskID string, in *TaskRequest) (out *TaskResponse, err error) {
+	var completedAt *int64
+	if *in.Completed {
+		now := time.Now().UnixMilli()
+		completedAt = &now
+	}
+
+	task, err := s.repo.SetCompleted(ctx, middleware.GetUserID(ctx), taskID, completedAt)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("task not found"))
+		return
+	}
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	out = &TaskResponse{}
+	out.MapFromModel(task)
+	return
+}
+
+func (s *service) Delete(ctx echo.Context) (err error) {
+	deleted, err := s.repo.Delete(ctx, middleware.GetUserID(ctx))
+	if err != nil {
+		return response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	if !deleted {
+		return response.ErrorWrap(response.ErrNotFound, fmt.Errorf("study plan not found"))
+	}
+	return
+}
+
+func (s *service) get(ctx echo.Context, customerID string) (out *Plan, err error) {
+	out, err = s.repo.Get(ctx, customerID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("study plan not found"))
+		return
+	}
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	return
+}
+
+func (s *service) today(ctx echo.Context, customerID string) time.Time {
+	return stats.Day(time.Now().UnixMilli(), s.locations.Location(ctx, customerID))
+}
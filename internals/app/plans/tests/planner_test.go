@@ -0,0 +1,158 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+	"time"
+	"wakuwaku_nihongo/internals/app/plans"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func items(prefix string, n int) []plans.Item {
+	out := []plans.Item{}
+	for i := 0; i < n; i++ {
+		id := fmt.Sprintf("%s%d", prefix, i)
+		out = append(out, plans.Item{RefID: id, Title: id})
+	}
+	return out
+}
+
+func byType(tasks []*plans.Task, taskType string) []*plans.Task {
+	out := []*plans.Task{}
+	for _, t := range tasks {
+		if t.TaskType == taskType {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+func TestSitting(t *testing.T) {
+	assert.Equal(t, date(2026, 7, 5), plans.Sitting(2026, time.July))
+	assert.Equal(t, date(2026, 12, 6), plans.Sitting(2026, time.December))
+	assert.Equal(t, date(2024, 12, 1), plans.Sitting(2024, time.December), "a month starting on a sunday")
+
+	assert.Equal(t, date(2026, 12, 6), plans.NextSitting(date(2026, 10, 19)))
+	assert.Equal(t, date(2027, 7, 4), plans.NextSitting(date(2026, 12, 1)), "less than a week left")
+}
+
+func TestCheckExamDate(t *testing.T) {
+	today := date(2026, 10, 19)
+	assert.NoError(t, plans.CheckExamDate(date(2026, 12, 6), today))
+	assert.NoError(t, plans.CheckExamDate(date(2027, 7, 4), today))
+	assert.Error(t, plans.CheckExamDate(date(2026, 11, 1), today), "no sitting in november")
+	assert.Error(t, plans.CheckExamDate(date(2025, 12, 7), today), "in the past")
+	assert.Error(t, plans.CheckExamDate(date(2029, 7, 1), today), "too far")
+}
+
+func TestBuild(t *testing.T) {
+	start, exam := date(2026, 10, 12), date(2026, 12, 6)
+	content := &plans.Content{
+		Kanji:      items("k", 92),
+		Vocabulary: items("v", 300),
+		Grammar:    items("g", 40),
+		MockExams:  items("q", 5),
+	}
+
+	tasks := plans.Build("c", content, start, exam)
+	assert.Len(t, tasks, 92+300+40+3)
+
+	// 8 weeks: a mock exam closes week 4 and opens each of the 2 review weeks
+	mocks := byType(tasks, plans.TASK_MOCK_EXAM)
+	assert.Equal(t, []time.Time{date(2026, 11, 8), date(2026, 11, 23), date(2026, 11, 30)},
+		[]time.Time{mocks[0].Day, mocks[1].Day, mocks[2].Day})
+	assert.Equal(t, "q0", mocks[0].RefID)
+
+	reviewFrom := date(2026, 11, 23)
+	perDay := map[time.Time]int{}
+	for _, task := range tasks {
+		assert.False(t, task.Day.Before(start))
+		assert.True(t, task.Day.Before(exam))
+		if task.TaskType != plans.TASK_MOCK_EXAM {
+			assert.True(t, task.Day.Before(reviewFrom), "no new material in the review weeks")
+			assert.NotEqual(t, date(2026, 11, 8), task.Day, "no new material on a mock exam day")
+			perDay[task.Day]++
+		}
+	}
+	assert.Len(t, perDay, 6*7-1)
+	for _, n := range perDay {
+		assert.InDelta(t, 432/41, n, 2, "spread evenly")
+	}
+
+	grammar := byType(tasks, plans.TASK_GRAMMAR)
+	assert.Equal(t, "g0", grammar[0].RefID)
+	assert.Equal(t, start, grammar[0].Day)
+	assert.Equal(t, "g39", grammar[39].RefID, "kept in order")
+
+	for i, task := range tasks {
+		if i > 0 && tasks[i-1].Day.Equal(task.Day) {
+			assert.Equal(t, tasks[i-1].Position+1, task.Position)
+		} else {
+			assert.Equal(t, 0, task.Position)
+		}
+	}
+}
+
+func TestBuildShortPlan(t *testing.T) {
+	start, exam := date(2026, 11, 29), date(2026, 12, 6)
+	tasks := plans.Build("c", &plans.Content{Grammar: items("g", 3), MockExams: items("q", 2)}, start, exam)
+
+	assert.Len(t, byType(tasks, plans.TASK_GRAMMAR), 3)
+	assert.Empty(t, byType(tasks, plans.TASK_MOCK_EXAM), "one week has no review and no fourth week")
+}
+
+func TestReplan(t *testing.T) {
+	start, exam := date(2026, 10, 12), date(2026, 12, 6)
+	plan := &plans.Plan{StartDate: start, ExamDate: exam}
+	content := &plans.Content{Vocabulary: items("v", 82), MockExams: items("q", 3)}
+
+	tasks := plans.Build("c", content, start, exam)
+	assert.Empty(t, plans.Replan(plan, tasks, start), "not behind on the first day")
+
+	today := date(2026, 10, 19)
+	done := int64(1)
+	for _, task := range tasks {
+		if task.Day.Before(today) && task.RefID == "v0" {
+			task.CompletedAt = &done
+		}
+	}
+	assert.True(t, plans.Behind(tasks, today))
+
+	changed := plans.Replan(plan, tasks, today)
+	assert.NotEmpty(t, changed)
+	assert.False(t, plans.Behind(tasks, today))
+	for _, task := range tasks {
+		if task.RefID == "v0" {
+			assert.Equal(t, start, task.Day, "completed tasks stay")
+			continue
+		}
+		assert.False(t, task.Day.Before(today))
+		if task.TaskType == plans.TASK_VOCABULARY {
+			assert.True(t, task.Day.Before(date(2026, 11, 23)))
+		}
+	}
+	assert.Empty(t, plans.Replan(plan, tasks, today), "nothing left to move")
+}
+
+func TestReplanOverdueMockExam(t *testing.T) {
+	start, exam := date(2026, 10, 12), date(2026, 12, 6)
+	plan := &plans.Plan{StartDate: start, ExamDate: exam}
+	tasks := plans.Build("c", &plans.Content{MockExams: items("q", 2)}, start, exam)
+	mocks := byType(tasks, plans.TASK_MOCK_EXAM)
+
+	plans.Replan(plan, tasks, date(2026, 11, 10))
+	assert.Equal(t, date(2026, 11, 30), mocks[0].Day, "the next free mock exam slot")
+	assert.Equal(t, date(2026, 11, 23), mocks[1].Day)
+
+	plans.Replan(plan, tasks, date(2026, 12, 2))
+	assert.Equal(t, date(2026, 12, 2), mocks[0].Day, "today when no slot is left")
+	assert.Equal(t, date(2026, 12, 2), mocks[1].Day)
+	assert.Equal(t, []int{1, 0}, []int{mocks[0].Position, mocks[1].Position}, "the longest overdue first")
+
+	assert.Empty(t, plans.Replan(plan, tasks, exam), "the exam has passed")
+}
@@ -0,0 +1,7 @@
+package preferences
+
+import "time"
+
+// CACHE_TTL bounds how long a preference read for the response locale or the
+// time zone is reused before it is loaded again.
+const CACHE_TTL = 5 * time.Minute
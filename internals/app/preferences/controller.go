@@ -0,0 +1,71 @@
+package preferences
+
+import (
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+type IPreferenceService interface {
+	Get(ctx echo.Context) (out *PreferenceResponse, err error)
+	Update(ctx echo.Context, in *PreferenceRequest) (out *PreferenceResponse, err error)
+}
+
+type handler struct {
+	service IPreferenceService
+}
+
+func NewHandler(f *factory.Factory) *handler {
+	return &handler{
+		service: NewService(f),
+	}
+}
+
+// @Summary Get Preferences
+// @Description Get the preferences of the logged in customer
+// @Tags preference
+// @Produce json
+// @Success 200 {object} response.Success{data=PreferenceResponse}
+// @Failure 401 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/me/preferences [get]
+func (h *handler) Get(c echo.Context) error {
+	res, err := h.service.Get(c)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Update Preferences
+// @Description Update the preferences of the logged in customer. The locale is used for response messages instead of Accept-Language and the IANA timezone, e.g. Asia/Tokyo, sets when the days of streaks and stats start, DB_TZ is used when it is null
+// @Tags preference
+// @Accept json
+// @Produce json
+// @Param payload body PreferenceRequest true "Payload"
+// @Success 200 {object} response.Success{data=PreferenceResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 401 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/me/preferences [put]
+func (h *handler) Update(c echo.Context) error {
+	req := &PreferenceRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.Update(c, req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
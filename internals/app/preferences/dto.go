@@ -0,0 +1,16 @@
+package preferences
+
+type PreferenceRequest struct {
+	Locale   *string `json:"locale" validate:"omitempty,oneof=en id ja"`
+	Timezone *string `json:"timezone" validate:"omitempty,timezone"`
+}
+
+type PreferenceResponse struct {
+	Locale   *string `json:"locale"`
+	Timezone *string `json:"timezone"`
+}
+
+func (r *PreferenceResponse) MapFromModel(m *Preference) {
+	r.Locale = m.Locale
+	r.Timezone = m.Timezone
+}
@@ -0,0 +1,24 @@
+package preferences
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Preference struct {
+	CustomerID string  `gorm:"column:customer_id;type:uuid;primaryKey" json:"customer_id"`
+	CreatedAt  int64   `gorm:"column:created_at;type:bigint;not null" json:"created_at"`
+	ModifiedAt *int64  `gorm:"column:modified_at;type:bigint" json:"modified_at"`
+	Locale     *string `gorm:"column:locale;type:character varying" json:"locale"`
+	Timezone   *string `gorm:"column:timezone;type:character varying" json:"timezone"`
+}
+
+func (*Preference) TableName() string {
+	return "customer_preferences"
+}
+
+func (m *Preference) BeforeCreate(tx *gorm.DB) (err error) {
+	m.CreatedAt = time.Now().UnixMilli()
+	return
+}
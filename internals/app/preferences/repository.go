@@ -0,0 +1,36 @@
+package preferences
+
+import (
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type repo struct {
+	db *gorm.DB
+}
+
+func NewRepo(db *gorm.DB) *repo {
+	return &repo{
+		db: db,
+	}
+}
+
+func (r *repo) Get(ctx echo.Context, customerID string) (out *Preference, err error) {
+	out = &Preference{}
+	err = r.db.Where("customer_id = ?", customerID).First(out).Error
+	return
+}
+
+func (r *repo) Upsert(ctx echo.Context, in *Preference) (err error) {
+	return r.db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "customer_id"}},
+		DoUpdates: clause.Assignments(map[string]any{
+			"locale":      in.Locale,
+			"timezone":    in.Timezone,
+			"modified_at": time.Now().UnixMilli(),
+		}),
+	}).Create(in).Error
+}
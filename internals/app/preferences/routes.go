@@ -0,0 +1,11 @@
+package preferences
+
+import (
+	"github.com/labstack/echo/v4"
+	"wakuwaku_nihongo/internals/middleware"
+)
+
+func (h *handler) Route(g *echo.Group) {
+	g.GET("", h.Get, middleware.Authentication)
+	g.PUT("", h.Update, middleware.Authentication)
+}
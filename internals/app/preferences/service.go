@@ -0,0 +1,148 @@
+package preferences
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"wakuwaku_nihongo/config"
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/middleware"
+	"wakuwaku_nihongo/internals/pkg/i18n"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+type IPreferenceRepo interface {
+	Get(ctx echo.Context, customerID string) (out *Preference, err error)
+	Upsert(ctx echo.Context, in *Preference) (err error)
+}
+
+type service struct {
+	repo IPreferenceRepo
+}
+
+type cacheEntry struct {
+	preference *Preference
+	expiresAt  time.Time
+}
+
+var (
+	// cache is shared by every service so an update is seen by the locale
+	// resolver right away.
+	cache sync.Map
+	// locations caches the loaded time zones by name.
+	locations sync.Map
+)
+
+func NewService(f *factory.Factory) *service {
+	return &service{
+		repo: NewRepo(f.Db),
+	}
+}
+
+func (s *service) Get(ctx echo.Context) (out *PreferenceResponse, err error) {
+	preference, err := s.get(ctx, middleware.GetUserID(ctx))
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	out = &PreferenceResponse{}
+	out.MapFromModel(preference)
+	return
+}
+
+func (s *service) Update(ctx echo.Context, in *PreferenceRequest) (out *PreferenceResponse, err error) {
+	customerID := middleware.GetUserID(ctx)
+	preference := &Preference{
+		CustomerID: customerID,
+		Locale:     in.Locale,
+		Timezone:   in.Timezone,
+	}
+	err = s.repo.Upsert(ctx, preference)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	cache.Store(customerID, cacheEntry{preference: preference, expiresAt: time.Now().Add(CACHE_TTL)})
+
+	out = &PreferenceResponse{}
+	out.MapFromModel(preference)
+	return
+}
+
+// Locale returns the customer's preferred locale for localizing responses,
+// ok is false when the customer has not chosen one.
+func (s *service) Locale(ctx echo.Context, customerID string) (l i18n.Locale, ok bool) {
+	preference, err := s.cached(ctx, customerID)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to load customer preference")
+		return
+	}
+	if preference.Locale == nil {
+		return
+	}
+	l = i18n.Locale(*preference.Locale)
+	return l, l.IsValid()
+}
+
+// Location returns the time zone the customer's days start and end in.
+func (s *service) Location(ctx echo.Context, customerID string) *time.Location {
+	preference, err := s.cached(ctx, customerID)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to load customer preference")
+		return LoadLocation(nil)
+	}
+	return LoadLocation(preference.Timezone)
+}
+
+// LoadLocation returns the named time zone, falling back to DB_TZ when name
+// is empty or unknown.
+func LoadLocation(name *string) *time.Location {
+	if name == nil || *name == "" {
+		fallback := config.Get().DB.TimeZone
+		name = &fallback
+	}
+	if loc, ok := locations.Load(*name); ok {
+		return loc.(*time.Location)
+	}
+
+	loc, err := time.LoadLocation(*name)
+	if err != nil {
+		if fallback := config.Get().DB.TimeZone; *name != fallback {
+			return LoadLocation(&fallback)
+		}
+		return time.UTC
+	}
+	locations.Store(*name, loc)
+	return loc
+}
+
+// cached returns the preference from the cache while it is fresh.
+func (s *service) cached(ctx echo.Context, customerID string) (out *Preference, err error) {
+	if v, found := cache.Load(customerID); found {
+		entry := v.(cacheEntry)
+		if time.Now().Before(entry.expiresAt) {
+			return entry.preference, nil
+		}
+	}
+
+	out, err = s.get(ctx, customerID)
+	if err != nil {
+		return
+	}
+	cache.Store(customerID, cacheEntry{preference: out, expiresAt: time.Now().Add(CACHE_TTL)})
+	return
+}
+
+// get returns the stored preference or an empty one.
+func (s *service) get(ctx echo.Context, customerID string) (out *Preference, err error) {
+	out, err = s.repo.Get(ctx, customerID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &Preference{CustomerID: customerID}, nil
+	}
+	return
+}
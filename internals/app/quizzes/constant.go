@@ -1,5 +1,55 @@
 package quizzes
 
+import (
+	"time"
+
+	"wakuwaku_nihongo/internals/model"
+)
+
 const (
 	DEFAULT_QUIZ_TITLE = "quiz1"
+
+	QUESTION_TYPE_MULTIPLE_CHOICE = "multiple_choice"
+	QUESTION_TYPE_TYPED           = "typed"
+
+	// sections of the JLPT a question practices
+	SECTION_VOCABULARY = "vocabulary"
+	SECTION_GRAMMAR    = "grammar"
+	SECTION_READING    = "reading"
+	SECTION_LISTENING  = "listening"
+
+	// the statuses a quiz goes through, see model.QUIZ_STATUS_DRAFT.
+	QUIZ_STATUS_DRAFT     = model.QUIZ_STATUS_DRAFT
+	QUIZ_STATUS_IN_REVIEW = model.QUIZ_STATUS_IN_REVIEW
+	QUIZ_STATUS_SCHEDULED = model.QUIZ_STATUS_SCHEDULED
+	QUIZ_STATUS_PUBLISHED = model.QUIZ_STATUS_PUBLISHED
+
+	DECISION_APPROVED = "approved"
+	DECISION_REJECTED = "rejected"
+
+	// PUBLISH_INTERVAL is how often the publishing job publishes the
+	// scheduled quizzes that are due, so how late they can go live.
+	PUBLISH_INTERVAL = time.Minute
+	PUBLISH_JOB_NAME = "quiz_publishing"
+
+	DEFAULT_SORT_BY = "created_at"
 )
+
+var SORTABLE_COLUMNS = map[string]bool{
+	"created_at":   true,
+	"modified_at":  true,
+	"title":        true,
+	"submitted_at": true,
+	"publish_at":   true,
+	"published_at": true,
+}
+
+// TRANSITIONS lists the statuses a quiz of each status can move to. A
+// published quiz is withdrawn to draft to be corrected, the attempts made
+// keep the revisions they were graded against.
+var TRANSITIONS = map[string][]string{
+	QUIZ_STATUS_DRAFT:     {QUIZ_STATUS_IN_REVIEW},
+	QUIZ_STATUS_IN_REVIEW: {QUIZ_STATUS_DRAFT, QUIZ_STATUS_SCHEDULED, QUIZ_STATUS_PUBLISHED},
+	QUIZ_STATUS_SCHEDULED: {QUIZ_STATUS_DRAFT, QUIZ_STATUS_PUBLISHED},
+	QUIZ_STATUS_PUBLISHED: {QUIZ_STATUS_DRAFT},
+}
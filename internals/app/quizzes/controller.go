@@ -0,0 +1,657 @@
+package quizzes
+
+import (
+	"wakuwaku_nihongo/internals/abstraction"
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+type IQuizService interface {
+	ListQuizzes(ctx echo.Context, filter *QuizFilter) (out []*QuizResponse, info *abstraction.PaginationInfo, err error)
+	GetQuiz(ctx echo.Context, quizID string) (out *QuizResponse, err error)
+	Preview(ctx echo.Context, quizID string, filter *PreviewFilter) (out *PreviewResponse, err error)
+	RestoreQuiz(ctx echo.Context, quizID string) (out *QuizResponse, err error)
+	DeleteQuiz(ctx echo.Context, quizID string) (err error)
+	CreateQuiz(ctx echo.Context, in *QuizRequest) (out *QuizResponse, err error)
+	UpdateQuiz(ctx echo.Context, quizID string, in *QuizRequest) (out *QuizResponse, err error)
+	Submit(ctx echo.Context, quizID string, in *SubmitRequest) (out *QuizResponse, err error)
+	AssignReviewer(ctx echo.Context, quizID string, in *SubmitRequest) (out *QuizResponse, err error)
+	Approve(ctx echo.Context, quizID string, in *ApproveRequest) (out *QuizResponse, err error)
+	Reject(ctx echo.Context, quizID string, in *RejectRequest) (out *QuizResponse, err error)
+	Withdraw(ctx echo.Context, quizID string) (out *QuizResponse, err error)
+	ListReviews(ctx echo.Context, quizID string) (out []*ReviewResponse, err error)
+	CreateQuestion(ctx echo.Context, in *CreateQuestionRequest) (out *QuestionResponse, err error)
+	GetQuestion(ctx echo.Context, questionID string, filter *RubyFilter) (out *QuestionResponse, err error)
+	UpdateQuestion(ctx echo.Context, questionID string, in *UpdateQuestionRequest) (out *QuestionResponse, err error)
+	UpdateAnswer(ctx echo.Context, questionID string, answerID string, in *AnswerRequest) (out *QuestionResponse, err error)
+	UpdateGradingMode(ctx echo.Context, questionID string, in *GradingModeRequest) (out *QuestionResponse, err error)
+	DeleteQuestion(ctx echo.Context, questionID string) (err error)
+	DeleteAnswer(ctx echo.Context, questionID string, answerID string) (out *QuestionResponse, err error)
+	RestoreQuestion(ctx echo.Context, questionID string) (out *QuestionResponse, err error)
+	RestoreAnswer(ctx echo.Context, questionID string, answerID string) (out *QuestionResponse, err error)
+}
+
+type handler struct {
+	service IQuizService
+}
+
+func NewHandler(f *factory.Factory) *handler {
+	return &handler{
+		service: NewService(f),
+	}
+}
+
+// @Summary List Quizzes
+// @Description List the quizzes. Learners only see published quizzes, editors see every status and how each quiz went through review
+// @Tags quiz
+// @Produce json
+// @Param status query string false "Status (editor only)" Enums(draft, in_review, scheduled, published)
+// @Param jlpt_level query string false "JLPT level" Enums(N5, N4, N3, N2, N1)
+// @Param reviewer_id query string false "Reviewer ID (editor only)"
+// @Param search query string false "Search in title and description"
+// @Param include_deleted query bool false "Include deleted quizzes (admin only)"
+// @Param page query int false "Page"
+// @Param page_size query int false "Page size"
+// @Param sort_by query string false "Sort by" Enums(created_at, modified_at, title, submitted_at, publish_at, published_at)
+// @Param order_by query string false "Order by" Enums(asc, desc)
+// @Success 200 {object} response.SuccessResponseWithInfo{data=[]QuizResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 401 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/quizzes [get]
+func (h *handler) ListQuizzes(c echo.Context) error {
+	req := &QuizFilter{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+	req.ChangeDefaultSortingClause(DEFAULT_SORT_BY, nil)
+	req.Pagination.SetDefault()
+
+	res, info, err := h.service.ListQuizzes(c, req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponseInfo(res, info).Send(c)
+}
+
+// @Summary Get Quiz
+// @Description Get a quiz. Quizzes not published yet are not found for learners
+// @Tags quiz
+// @Produce json
+// @Param quiz_id path string true "Quiz ID"
+// @Success 200 {object} response.Success{data=QuizResponse}
+// @Failure 401 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/quizzes/{quiz_id} [get]
+func (h *handler) GetQuiz(c echo.Context) error {
+	res, err := h.service.GetQuiz(c, c.Param("quiz_id"))
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Preview Quiz
+// @Description Get the answer key of a quiz of any status, its questions with the correct answers marked (editor only)
+// @Tags quiz
+// @Produce json
+// @Param quiz_id path string true "Quiz ID"
+// @Param ruby query string false "Furigana rendering" Enums(markup, html, segments, strip)
+// @Param include_deleted query bool false "Include deleted questions and answers (admin only)"
+// @Success 200 {object} response.Success{data=PreviewResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/quizzes/{quiz_id}/preview [get]
+func (h *handler) Preview(c echo.Context) error {
+	req := &PreviewFilter{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.Preview(c, c.Param("quiz_id"), req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Create Quiz
+// @Description Create a draft quiz (editor only). Learners do not see it until it is reviewed and published
+// @Tags quiz
+// @Accept json
+// @Produce json
+// @Param payload body QuizRequest true "Payload"
+// @Success 200 {object} response.Success{data=QuizResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/quizzes [post]
+func (h *handler) CreateQuiz(c echo.Context) error {
+	req := &QuizRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.CreateQuiz(c, req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Update Quiz
+// @Description Update the title, description and level of a draft quiz (editor only)
+// @Tags quiz
+// @Accept json
+// @Produce json
+// @Param quiz_id path string true "Quiz ID"
+// @Param payload body QuizRequest true "Payload"
+// @Success 200 {object} response.Success{data=QuizResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/quizzes/{quiz_id} [put]
+func (h *handler) UpdateQuiz(c echo.Context) error {
+	req := &QuizRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.UpdateQuiz(c, c.Param("quiz_id"), req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Submit Quiz for Review
+// @Description Submit a draft quiz with questions to another editor for review (editor only). The reviewer is notified
+// @Tags quiz
+// @Accept json
+// @Produce json
+// @Param quiz_id path string true "Quiz ID"
+// @Param payload body SubmitRequest true "Payload"
+// @Success 200 {object} response.Success{data=QuizResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/quizzes/{quiz_id}/submit [post]
+func (h *handler) Submit(c echo.Context) error {
+	req := &SubmitRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.Submit(c, c.Param("quiz_id"), req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Assign Quiz Reviewer
+// @Description Hand a quiz in review to another reviewer (editor only). The reviewer is notified
+// @Tags quiz
+// @Accept json
+// @Produce json
+// @Param quiz_id path string true "Quiz ID"
+// @Param payload body SubmitRequest true "Payload"
+// @Success 200 {object} response.Success{data=QuizResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/quizzes/{quiz_id}/reviewer [put]
+func (h *handler) AssignReviewer(c echo.Context) error {
+	req := &SubmitRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.AssignReviewer(c, c.Param("quiz_id"), req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Approve Quiz
+// @Description Approve a quiz in review as its reviewer or an admin. It is published right away, or scheduled when publish_at is in the future. The submitter is notified
+// @Tags quiz
+// @Accept json
+// @Produce json
+// @Param quiz_id path string true "Quiz ID"
+// @Param payload body ApproveRequest true "Payload"
+// @Success 200 {object} response.Success{data=QuizResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/quizzes/{quiz_id}/approve [post]
+func (h *handler) Approve(c echo.Context) error {
+	req := &ApproveRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.Approve(c, c.Param("quiz_id"), req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Reject Quiz
+// @Description Send a quiz in review back to draft with what needs fixing, as its reviewer or an admin. The submitter is notified
+// @Tags quiz
+// @Accept json
+// @Produce json
+// @Param quiz_id path string true "Quiz ID"
+// @Param payload body RejectRequest true "Payload"
+// @Success 200 {object} response.Success{data=QuizResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/quizzes/{quiz_id}/reject [post]
+func (h *handler) Reject(c echo.Context) error {
+	req := &RejectRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.Reject(c, c.Param("quiz_id"), req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Withdraw Quiz
+// @Description Take a quiz in review, scheduled or published back to draft (editor only) to correct it, it has to be reviewed again
+// @Tags quiz
+// @Produce json
+// @Param quiz_id path string true "Quiz ID"
+// @Success 200 {object} response.Success{data=QuizResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/quizzes/{quiz_id}/withdraw [post]
+func (h *handler) Withdraw(c echo.Context) error {
+	res, err := h.service.Withdraw(c, c.Param("quiz_id"))
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary List Quiz Reviews
+// @Description List the review decisions on a quiz, the latest first (editor only)
+// @Tags quiz
+// @Produce json
+// @Param quiz_id path string true "Quiz ID"
+// @Success 200 {object} response.Success{data=[]ReviewResponse}
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/quizzes/{quiz_id}/reviews [get]
+func (h *handler) ListReviews(c echo.Context) error {
+	res, err := h.service.ListReviews(c, c.Param("quiz_id"))
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Restore Quiz
+// @Description Restore a deleted quiz (admin only)
+// @Tags quiz
+// @Produce json
+// @Param quiz_id path string true "Quiz ID"
+// @Success 200 {object} response.Success{data=QuizResponse}
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/quizzes/{quiz_id}/restore [post]
+func (h *handler) RestoreQuiz(c echo.Context) error {
+	res, err := h.service.RestoreQuiz(c, c.Param("quiz_id"))
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Delete Quiz
+// @Description Soft delete a draft quiz, its questions are left as they are (editor only). A quiz in review or published is withdrawn first
+// @Tags quiz
+// @Produce json
+// @Param quiz_id path string true "Quiz ID"
+// @Success 200 {object} response.Success{data=string}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/quizzes/{quiz_id} [delete]
+func (h *handler) DeleteQuiz(c echo.Context) error {
+	err := h.service.DeleteQuiz(c, c.Param("quiz_id"))
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse("deleted").Send(c)
+}
+
+// @Summary Create Question
+// @Description Create a question with its answers in a draft quiz (editor only). Question and answer text accept furigana markup such as 漢字[かんじ]
+// @Tags question
+// @Accept json
+// @Produce json
+// @Param payload body CreateQuestionRequest true "Payload"
+// @Success 200 {object} response.Success{data=QuestionResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/questions [post]
+func (h *handler) CreateQuestion(c echo.Context) error {
+	req := &CreateQuestionRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.CreateQuestion(c, req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Get Question
+// @Description Get a question with its answers (editor only)
+// @Tags question
+// @Produce json
+// @Param question_id path string true "Question ID"
+// @Param ruby query string false "Furigana rendering" Enums(markup, html, segments, strip)
+// @Success 200 {object} response.Success{data=QuestionResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/questions/{question_id} [get]
+func (h *handler) GetQuestion(c echo.Context) error {
+	req := &RubyFilter{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.GetQuestion(c, c.Param("question_id"), req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Update Question
+// @Description Update the text, type and reading passage of a question of a draft quiz (editor only)
+// @Tags question
+// @Accept json
+// @Produce json
+// @Param question_id path string true "Question ID"
+// @Param payload body UpdateQuestionRequest true "Payload"
+// @Success 200 {object} response.Success{data=QuestionResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/questions/{question_id} [put]
+func (h *handler) UpdateQuestion(c echo.Context) error {
+	req := &UpdateQuestionRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.UpdateQuestion(c, c.Param("question_id"), req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Update Answer
+// @Description Update the text and correctness of an answer of a question of a draft quiz (editor only)
+// @Tags question
+// @Accept json
+// @Produce json
+// @Param question_id path string true "Question ID"
+// @Param answer_id path string true "Answer ID"
+// @Param payload body AnswerRequest true "Payload"
+// @Success 200 {object} response.Success{data=QuestionResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/questions/{question_id}/answers/{answer_id} [put]
+func (h *handler) UpdateAnswer(c echo.Context) error {
+	req := &AnswerRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.UpdateAnswer(c, c.Param("question_id"), c.Param("answer_id"), req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Update Question Grading Mode
+// @Description Set how strictly typed answers of a question of a draft quiz are graded (editor only)
+// @Tags question
+// @Accept json
+// @Produce json
+// @Param question_id path string true "Question ID"
+// @Param payload body GradingModeRequest true "Payload"
+// @Success 200 {object} response.Success{data=QuestionResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/questions/{question_id}/grading-mode [put]
+func (h *handler) UpdateGradingMode(c echo.Context) error {
+	req := &GradingModeRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.UpdateGradingMode(c, c.Param("question_id"), req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Delete Question
+// @Description Soft delete a question of a draft quiz, its answers are left as they are (editor only)
+// @Tags question
+// @Produce json
+// @Param question_id path string true "Question ID"
+// @Success 200 {object} response.Success{data=string}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/questions/{question_id} [delete]
+func (h *handler) DeleteQuestion(c echo.Context) error {
+	err := h.service.DeleteQuestion(c, c.Param("question_id"))
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse("deleted").Send(c)
+}
+
+// @Summary Delete Answer
+// @Description Soft delete an answer of a question of a draft quiz (editor only). The question keeps at least one correct answer and the delete is recorded as a new revision of it
+// @Tags question
+// @Produce json
+// @Param question_id path string true "Question ID"
+// @Param answer_id path string true "Answer ID"
+// @Success 200 {object} response.Success{data=QuestionResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/questions/{question_id}/answers/{answer_id} [delete]
+func (h *handler) DeleteAnswer(c echo.Context) error {
+	res, err := h.service.DeleteAnswer(c, c.Param("question_id"), c.Param("answer_id"))
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Restore Question
+// @Description Restore a deleted question of a draft quiz (admin only). Its deleted answers stay deleted
+// @Tags question
+// @Produce json
+// @Param question_id path string true "Question ID"
+// @Success 200 {object} response.Success{data=QuestionResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/questions/{question_id}/restore [post]
+func (h *handler) RestoreQuestion(c echo.Context) error {
+	res, err := h.service.RestoreQuestion(c, c.Param("question_id"))
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Restore Answer
+// @Description Restore a deleted answer of a question of a draft quiz (admin only). The restore is recorded as a new revision of the question
+// @Tags question
+// @Produce json
+// @Param question_id path string true "Question ID"
+// @Param answer_id path string true "Answer ID"
+// @Success 200 {object} response.Success{data=QuestionResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/questions/{question_id}/answers/{answer_id}/restore [post]
+func (h *handler) RestoreAnswer(c echo.Context) error {
+	res, err := h.service.RestoreAnswer(c, c.Param("question_id"), c.Param("answer_id"))
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
@@ -1,2 +1,222 @@
 package quizzes
 
+import (
+	"wakuwaku_nihongo/internals/abstraction"
+	"wakuwaku_nihongo/internals/app/media"
+	"wakuwaku_nihongo/internals/model"
+	"wakuwaku_nihongo/internals/pkg/ruby"
+)
+
+type GradingModeRequest struct {
+	GradingMode string `json:"grading_mode" validate:"required,oneof=exact standard lenient"`
+}
+
+// RubyFilter selects how furigana markup is rendered in the response.
+type RubyFilter struct {
+	Ruby string `query:"ruby" validate:"omitempty,oneof=markup html segments strip"`
+}
+
+// PreviewFilter selects how furigana markup is rendered in the preview and
+// lets admins include the deleted questions and answers.
+type PreviewFilter struct {
+	RubyFilter
+	abstraction.DeletedFilter
+}
+
+func (f *RubyFilter) Format() ruby.Format {
+	if f.Ruby == "" {
+		return ruby.FormatMarkup
+	}
+	return ruby.Format(f.Ruby)
+}
+
+type CreateQuestionRequest struct {
+	QuizID       string           `json:"quiz_id" validate:"required,uuid"`
+	QuestionText string           `json:"question_text" validate:"required,ruby"`
+	QuestionType *string          `json:"question_type" validate:"omitempty,oneof=multiple_choice typed"`
+	GradingMode  string           `json:"grading_mode" validate:"omitempty,oneof=exact standard lenient"`
+	PassageID    *string          `json:"passage_id" validate:"omitempty,uuid"`
+	Section      *string          `json:"section" validate:"omitempty,oneof=vocabulary grammar reading listening"`
+	Answers      []*AnswerRequest `json:"answers" validate:"required,min=1,dive"`
+}
+
+type UpdateQuestionRequest struct {
+	QuestionText string  `json:"question_text" validate:"required,ruby"`
+	QuestionType *string `json:"question_type" validate:"omitempty,oneof=multiple_choice typed"`
+	PassageID    *string `json:"passage_id" validate:"omitempty,uuid"`
+	Section      *string `json:"section" validate:"omitempty,oneof=vocabulary grammar reading listening"`
+}
+
+type AnswerRequest struct {
+	AnswerText string `json:"answer_text" validate:"required,ruby"`
+	IsCorrect  bool   `json:"is_correct"`
+}
+
+type QuestionResponse struct {
+	QuestionID       string               `json:"question_id"`
+	QuizID           string               `json:"quiz_id"`
+	QuestionText     string               `json:"question_text"`
+	QuestionSegments []ruby.Segment       `json:"question_segments,omitempty"`
+	QuestionType     *string              `json:"question_type"`
+	GradingMode      string               `json:"grading_mode"`
+	PassageID        *string              `json:"passage_id"`
+	Section          *string              `json:"section"`
+	Answers          []*AnswerResponse    `json:"answers,omitempty"`
+	Audio            *media.MediaResponse `json:"audio,omitempty"`
+	DeletedAt        *int64               `json:"deleted_at,omitempty"`
+	DeletedBy        *string              `json:"deleted_by,omitempty"`
+}
+
+type AnswerResponse struct {
+	AnswerID       string         `json:"answer_id"`
+	AnswerText     string         `json:"answer_text"`
+	AnswerSegments []ruby.Segment `json:"answer_segments,omitempty"`
+	IsCorrect      bool           `json:"is_correct"`
+	DeletedAt      *int64         `json:"deleted_at,omitempty"`
+	DeletedBy      *string        `json:"deleted_by,omitempty"`
+}
+
+func (r *QuestionResponse) MapFromModel(m *model.Question) {
+	r.MapFromModelWithFormat(m, ruby.FormatMarkup)
+}
+
+// MapFromModelWithFormat fills the response rendering question and answer
+// text with format.
+func (r *QuestionResponse) MapFromModelWithFormat(m *model.Question, format ruby.Format) {
+	text := ruby.Render(m.QuestionText, format, nil)
+	r.QuestionID = m.QuestionID
+	r.QuizID = m.QuizID
+	r.QuestionText = text.Text
+	r.QuestionSegments = text.Segments
+	r.QuestionType = m.QuestionType
+	r.GradingMode = m.GradingMode
+	r.PassageID = m.PassageID
+	r.Section = m.Section
+	r.DeletedAt = m.DeletedAt.Ptr()
+	r.DeletedBy = m.DeletedBy
+	for _, a := range m.Answers {
+		answer := &AnswerResponse{}
+		answer.MapFromModelWithFormat(a, format)
+		r.Answers = append(r.Answers, answer)
+	}
+}
+
+func (r *AnswerResponse) MapFromModelWithFormat(m *model.Answer, format ruby.Format) {
+	text := ruby.Render(m.AnswerText, format, nil)
+	r.AnswerID = m.AnswerID
+	r.AnswerText = text.Text
+	r.AnswerSegments = text.Segments
+	r.IsCorrect = m.IsCorrect
+	r.DeletedAt = m.DeletedAt.Ptr()
+	r.DeletedBy = m.DeletedBy
+}
+
+type QuizRequest struct {
+	Title       string  `json:"title" validate:"required,max=255"`
+	Description *string `json:"description" validate:"omitempty,max=2000"`
+	JlptLevel   *string `json:"jlpt_level" validate:"omitempty,oneof=N5 N4 N3 N2 N1"`
+}
+
+// QuizFilter filters the quizzes, learners only ever list published ones
+// whatever Status is.
+type QuizFilter struct {
+	Status     string `query:"status" validate:"omitempty,oneof=draft in_review scheduled published"`
+	JlptLevel  string `query:"jlpt_level" validate:"omitempty,oneof=N5 N4 N3 N2 N1"`
+	ReviewerID string `query:"reviewer_id" validate:"omitempty,uuid"`
+	Search     string `query:"search" validate:"omitempty,max=255"`
+	abstraction.DeletedFilter
+	abstraction.Pagination
+}
+
+type SubmitRequest struct {
+	ReviewerID string `json:"reviewer_id" validate:"required,uuid"`
+}
+
+// ApproveRequest approves a quiz in review, it is published right away
+// unless PublishAt, in unix milliseconds, is in the future.
+type ApproveRequest struct {
+	Comment   *string `json:"comment" validate:"omitempty,max=2000"`
+	PublishAt *int64  `json:"publish_at" validate:"omitempty,gt=0"`
+}
+
+type RejectRequest struct {
+	Comment string `json:"comment" validate:"required,max=2000"`
+}
+
+type QuizResponse struct {
+	QuizID        string  `json:"quiz_id"`
+	Title         string  `json:"title"`
+	Description   *string `json:"description"`
+	JlptLevel     *string `json:"jlpt_level"`
+	Status        string  `json:"status,omitempty"`
+	QuestionCount int64   `json:"question_count"`
+	ReviewerID    *string `json:"reviewer_id,omitempty"`
+	SubmittedAt   *int64  `json:"submitted_at,omitempty"`
+	SubmittedBy   *string `json:"submitted_by,omitempty"`
+	PublishAt     *int64  `json:"publish_at,omitempty"`
+	PublishedAt   *int64  `json:"published_at"`
+	PublishedBy   *string `json:"published_by,omitempty"`
+	CreatedAt     int64   `json:"created_at"`
+	CreatedBy     string  `json:"created_by,omitempty"`
+	ModifiedAt    *int64  `json:"modified_at"`
+	DeletedAt     *int64  `json:"deleted_at,omitempty"`
+	DeletedBy     *string `json:"deleted_by,omitempty"`
+}
+
+// PreviewResponse is the answer key of a quiz for editors, its questions in
+// order with the correct answers marked.
+type PreviewResponse struct {
+	QuizResponse
+	Questions []*QuestionResponse `json:"questions"`
+}
+
+type ReviewResponse struct {
+	ReviewID   string  `json:"review_id"`
+	QuizID     string  `json:"quiz_id"`
+	ReviewerID string  `json:"reviewer_id"`
+	Decision   string  `json:"decision"`
+	Comment    *string `json:"comment"`
+	PublishAt  *int64  `json:"publish_at"`
+	CreatedAt  int64   `json:"created_at"`
+}
+
+func (r *QuizResponse) MapFromModel(m *model.Quiz) {
+	r.QuizID = m.QuizID
+	r.Title = m.Title
+	r.Description = m.Description
+	r.JlptLevel = m.JlptLevel
+	r.Status = m.Status
+	r.ReviewerID = m.ReviewerID
+	r.SubmittedAt = m.SubmittedAt
+	r.SubmittedBy = m.SubmittedBy
+	r.PublishAt = m.PublishAt
+	r.PublishedAt = m.PublishedAt
+	r.PublishedBy = m.PublishedBy
+	r.CreatedAt = m.CreatedAt
+	r.CreatedBy = m.CreatedBy
+	r.ModifiedAt = m.ModifiedAt
+	r.DeletedAt = m.DeletedAt.Ptr()
+	r.DeletedBy = m.DeletedBy
+}
+
+// Hide leaves out what learners do not see, how the quiz went through
+// review.
+func (r *QuizResponse) Hide() {
+	r.Status = ""
+	r.ReviewerID = nil
+	r.SubmittedAt = nil
+	r.SubmittedBy = nil
+	r.PublishAt = nil
+	r.PublishedBy = nil
+	r.CreatedBy = ""
+}
+
+func (r *ReviewResponse) MapFromModel(m *Review) {
+	r.ReviewID = m.ReviewID
+	r.QuizID = m.QuizID
+	r.ReviewerID = m.ReviewerID
+	r.Decision = m.Decision
+	r.Comment = m.Comment
+	r.PublishAt = m.PublishAt
+	r.CreatedAt = m.CreatedAt
+}
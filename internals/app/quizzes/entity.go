@@ -1,2 +1,58 @@
 package quizzes
 
+import (
+	"slices"
+	"time"
+
+	"wakuwaku_nihongo/internals/model"
+	"wakuwaku_nihongo/internals/utils/token"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Review is the decision of a reviewer on a quiz submitted to them, the
+// history of a quiz keeps every one of them.
+type Review struct {
+	ReviewID   string  `gorm:"column:review_id;type:uuid;primaryKey" json:"review_id"`
+	CreatedAt  int64   `gorm:"column:created_at;type:bigint;not null" json:"created_at"`
+	QuizID     string  `gorm:"column:quiz_id;type:uuid;not null" json:"quiz_id"`
+	ReviewerID string  `gorm:"column:reviewer_id;type:uuid;not null" json:"reviewer_id"`
+	Decision   string  `gorm:"column:decision;type:character varying;not null" json:"decision"`
+	Comment    *string `gorm:"column:comment;type:character varying" json:"comment"`
+	PublishAt  *int64  `gorm:"column:publish_at;type:bigint" json:"publish_at"`
+}
+
+func (*Review) TableName() string {
+	return "quiz_reviews"
+}
+
+func (m *Review) BeforeCreate(tx *gorm.DB) (err error) {
+	m.CreatedAt = time.Now().UnixMilli()
+	if m.ReviewID == "" {
+		m.ReviewID = uuid.NewString()
+	}
+	return
+}
+
+type quizWithCount struct {
+	model.Quiz
+	QuestionCount int64
+}
+
+// CanMoveTo reports whether a quiz of status from can move to status to.
+func CanMoveTo(from, to string) bool {
+	return slices.Contains(TRANSITIONS[from], to)
+}
+
+// CanEdit reports whether a quiz of status and its questions can be
+// edited. Only drafts can, so reviewers decide on what learners get.
+func CanEdit(status string) bool {
+	return status == QUIZ_STATUS_DRAFT
+}
+
+// CanPreview reports whether a role sees the quizzes learners do not, the
+// ones not published yet.
+func CanPreview(role string) bool {
+	return role == token.ROLE_EDITOR || role == token.ROLE_ADMIN
+}
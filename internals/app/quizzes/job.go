@@ -0,0 +1,25 @@
+package quizzes
+
+import (
+	"context"
+	"time"
+
+	"wakuwaku_nihongo/internals/factory"
+)
+
+type job struct {
+	repo IQuizRepo
+}
+
+func NewJob(f *factory.Factory) *job {
+	return &job{
+		repo: NewQuizRepo(f.Db),
+	}
+}
+
+// Run publishes the scheduled quizzes that are due, it is scheduled every
+// PUBLISH_INTERVAL.
+func (j *job) Run(ctx context.Context) (err error) {
+	_, err = j.repo.PublishDue(ctx, time.Now().UnixMilli())
+	return
+}
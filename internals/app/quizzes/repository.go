@@ -1,26 +1,38 @@
 package quizzes
 
 import (
+	"context"
+	"time"
+
+	"wakuwaku_nihongo/internals/app/notifications"
+	"wakuwaku_nihongo/internals/app/revisions"
 	"wakuwaku_nihongo/internals/model"
+	"wakuwaku_nihongo/internals/pkg/softdelete"
+	"wakuwaku_nihongo/internals/pkg/sqlutil"
 	"wakuwaku_nihongo/internals/query"
+	"wakuwaku_nihongo/internals/utils/token"
 
+	"github.com/labstack/echo/v4"
 	"github.com/rs/zerolog/log"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type repo struct {
+	db *gorm.DB
 	*query.Query
 }
 
 func NewQuizRepo(db *gorm.DB) *repo {
 	return &repo{
-		query.Use(db),
+		db:    db,
+		Query: query.Use(db),
 	}
 }
 
 func (r *repo) GetQuizzes() (out *model.Quiz, err error) {
 	q := r.Quiz
-	quiz, err := q.Where(q.DeletedAt.IsNotNull(), q.Title.Eq(DEFAULT_QUIZ_TITLE)).
+	quiz, err := q.Where(q.Title.Eq(DEFAULT_QUIZ_TITLE)).
 		Preload(q.Questions).First()
 	if err != nil {
 		log.Error().Err(err).Msg("error query")
@@ -35,7 +47,7 @@ func (r *repo) GetQuestionIDs(quizTitle string) (out []*string, err error) {
 	q := r.Question
 	qz := r.Quiz
 	questions, err := q.Preload(q.Quiz.On(qz.Title.Eq(quizTitle))).
-		Where(q.DeletedAt.IsNull()).Select(q.QuestionID).Find()
+		Select(q.QuestionID).Find()
 	if err != nil {
 		log.Error().Err(err).Msg("error query")
 		return
@@ -46,3 +58,325 @@ func (r *repo) GetQuestionIDs(quizTitle string) (out []*string, err error) {
 	}
 	return out, nil
 }
+
+func (r *repo) GetQuizByID(ctx echo.Context, quizID string) (out *model.Quiz, err error) {
+	q := r.Quiz
+	return q.Where(q.QuizID.Eq(quizID)).First()
+}
+
+// quizzes selects the quizzes with the number of their questions, the
+// deleted ones only when includeDeleted.
+func (r *repo) quizzes(includeDeleted bool) *gorm.DB {
+	db := r.db.Table("quizzes z").
+		Select(`z.*, (SELECT COUNT(*) FROM questions q
+			WHERE q.quiz_id = z.quiz_id AND q.deleted_at IS NULL) AS question_count`)
+	if !includeDeleted {
+		db = db.Where("z.deleted_at IS NULL")
+	}
+	return db
+}
+
+func (r *repo) ListQuizzes(ctx echo.Context, filter *QuizFilter) (out []*quizWithCount, count int64, err error) {
+	db := r.quizzes(filter.IncludeDeleted)
+	if filter.Status != "" {
+		db = db.Where("z.status = ?", filter.Status)
+	}
+	if filter.JlptLevel != "" {
+		db = db.Where("z.jlpt_level = ?", filter.JlptLevel)
+	}
+	if filter.ReviewerID != "" {
+		db = db.Where("z.reviewer_id = ?", filter.ReviewerID)
+	}
+	if filter.Search != "" {
+		like := sqlutil.Contains(filter.Search)
+		db = db.Where("z.title ILIKE ? OR z.description ILIKE ?", like, like)
+	}
+	db = db.Session(&gorm.Session{})
+
+	if err = db.Count(&count).Error; err != nil {
+		return
+	}
+
+	out = []*quizWithCount{}
+	sortBy := *filter.SortBy
+	if !SORTABLE_COLUMNS[sortBy] {
+		sortBy = DEFAULT_SORT_BY
+	}
+	err = db.Order(clause.OrderByColumn{
+		Column: clause.Column{Table: "z", Name: sortBy},
+		Desc:   filter.GetOrderBy() == "desc",
+	}).
+		Order("z.quiz_id").
+		Limit(filter.Limit()).
+		Offset(filter.Offset()).
+		Scan(&out).Error
+	return
+}
+
+func (r *repo) GetQuiz(ctx echo.Context, quizID string) (out *quizWithCount, err error) {
+	out = &quizWithCount{}
+	res := r.quizzes(false).Where("z.quiz_id = ?", quizID).Scan(out)
+	if res.Error == nil && res.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return out, res.Error
+}
+
+// GetQuizQuestions returns the questions of a quiz with their answers, in
+// the order they were added, the deleted ones too when includeDeleted.
+func (r *repo) GetQuizQuestions(ctx echo.Context, quizID string, includeDeleted bool) (out []*model.Question, err error) {
+	q := r.Question
+	do := q.Where(q.QuizID.Eq(quizID))
+	if includeDeleted {
+		do = do.Unscoped()
+	}
+	return do.Preload(q.Answers).
+		Order(q.CreatedAt, q.QuestionID).Find()
+}
+
+// RestoreQuiz undeletes the quiz, restored is false when it was not deleted.
+func (r *repo) RestoreQuiz(ctx echo.Context, quizID string) (restored bool, err error) {
+	res := softdelete.Restore(r.db.Model(&model.Quiz{}).Where("quiz_id = ?", quizID))
+	return res.RowsAffected > 0, res.Error
+}
+
+// DeleteQuiz soft deletes the quiz, its questions stay as they are and come
+// back with it.
+func (r *repo) DeleteQuiz(ctx echo.Context, quizID string, deletedBy string) (err error) {
+	return softdelete.By(r.db, deletedBy).Where("quiz_id = ?", quizID).Delete(&model.Quiz{}).Error
+}
+
+func (r *repo) CreateQuiz(ctx echo.Context, in *model.Quiz) (err error) {
+	return r.Quiz.Create(in)
+}
+
+func (r *repo) UpdateQuiz(ctx echo.Context, in *model.Quiz) (err error) {
+	q := r.Quiz
+	_, err = q.Where(q.QuizID.Eq(in.QuizID)).Updates(map[string]any{
+		"title":       in.Title,
+		"description": in.Description,
+		"jlpt_level":  in.JlptLevel,
+		"modified_at": in.ModifiedAt,
+		"modified_by": in.ModifiedBy,
+	})
+	return
+}
+
+// IsEditor reports whether a customer is an active editor or admin, who
+// quizzes can be submitted to for review.
+func (r *repo) IsEditor(ctx echo.Context, customerID string) (editor bool, err error) {
+	err = r.db.Raw(`SELECT EXISTS (
+		SELECT 1 FROM customers
+		WHERE customer_id = ? AND deleted_at IS NULL AND is_active AND role IN ?)`,
+		customerID, []string{token.ROLE_EDITOR, token.ROLE_ADMIN}).
+		Scan(&editor).Error
+	return
+}
+
+// Move stores the new status of a quiz and how it got there unless it
+// moved from the status from meanwhile, moved is false in that case. The
+// review and notification, unless nil, are saved in the same transaction.
+func (r *repo) Move(ctx echo.Context, in *model.Quiz, from string, review *Review, notification *notifications.Notification) (moved bool, err error) {
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&model.Quiz{}).
+			Where("quiz_id = ? AND status = ?", in.QuizID, from).
+			Updates(map[string]any{
+				"status":       in.Status,
+				"reviewer_id":  in.ReviewerID,
+				"submitted_at": in.SubmittedAt,
+				"submitted_by": in.SubmittedBy,
+				"publish_at":   in.PublishAt,
+				"published_at": in.PublishedAt,
+				"published_by": in.PublishedBy,
+				"modified_at":  in.ModifiedAt,
+				"modified_by":  in.ModifiedBy,
+			})
+		if res.Error != nil || res.RowsAffected == 0 {
+			return res.Error
+		}
+		moved = true
+
+		if review != nil {
+			if err := tx.Create(review).Error; err != nil {
+				return err
+			}
+		}
+		if notification == nil {
+			return nil
+		}
+		return notifications.Notify(ctx, tx, notification)
+	})
+	return
+}
+
+// AssignReviewer stores the reviewer of a quiz unless it left review
+// meanwhile, assigned is false in that case, and notifies them in the same
+// transaction.
+func (r *repo) AssignReviewer(ctx echo.Context, in *model.Quiz, notification *notifications.Notification) (assigned bool, err error) {
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&model.Quiz{}).
+			Where("quiz_id = ? AND status = ?", in.QuizID, QUIZ_STATUS_IN_REVIEW).
+			Updates(map[string]any{
+				"reviewer_id": in.ReviewerID,
+				"modified_at": in.ModifiedAt,
+				"modified_by": in.ModifiedBy,
+			})
+		if res.Error != nil || res.RowsAffected == 0 {
+			return res.Error
+		}
+		assigned = true
+		return notifications.Notify(ctx, tx, notification)
+	})
+	return
+}
+
+func (r *repo) ListReviews(ctx echo.Context, quizID string) (out []*Review, err error) {
+	out = []*Review{}
+	err = r.db.Where("quiz_id = ?", quizID).
+		Order("created_at desc, review_id").
+		Find(&out).Error
+	return
+}
+
+// PublishDue publishes the scheduled quizzes whose time has come by now,
+// they go live at the time they were scheduled for.
+func (r *repo) PublishDue(ctx context.Context, now int64) (published int64, err error) {
+	res := r.db.WithContext(ctx).Model(&model.Quiz{}).
+		Where("status = ? AND publish_at <= ?", QUIZ_STATUS_SCHEDULED, now).
+		Updates(map[string]any{
+			"status":       QUIZ_STATUS_PUBLISHED,
+			"published_at": gorm.Expr("publish_at"),
+			"modified_at":  now,
+		})
+	return res.RowsAffected, res.Error
+}
+
+func (r *repo) GetQuestionByID(ctx echo.Context, questionID string) (out *model.Question, err error) {
+	q := r.Question
+	return q.Where(q.QuestionID.Eq(questionID)).
+		Preload(q.Answers).First()
+}
+
+// GetQuestionQuizID returns the quiz of a question, deleted or not.
+func (r *repo) GetQuestionQuizID(ctx echo.Context, questionID string) (quizID string, err error) {
+	q := r.Question
+	question, err := q.Unscoped().Select(q.QuizID).Where(q.QuestionID.Eq(questionID)).First()
+	if err != nil {
+		return
+	}
+	return question.QuizID, nil
+}
+
+// CreateQuestion saves the question together with its answers as its
+// first revision.
+func (r *repo) CreateQuestion(ctx echo.Context, in *model.Question) (err error) {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := query.Use(tx).Question.Create(in); err != nil {
+			return err
+		}
+		return record(ctx, tx, in.QuestionID, revisions.ACTION_CREATE, in.CreatedBy)
+	})
+}
+
+func (r *repo) UpdateQuestion(ctx echo.Context, questionID string, in *UpdateQuestionRequest, modifiedBy string) (err error) {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		q := query.Use(tx).Question
+		_, err := q.Where(q.QuestionID.Eq(questionID)).Updates(map[string]any{
+			"question_text": in.QuestionText,
+			"question_type": in.QuestionType,
+			"passage_id":    in.PassageID,
+			"section":       in.Section,
+			"modified_at":   time.Now().UnixMilli(),
+			"modified_by":   modifiedBy,
+		})
+		if err != nil {
+			return err
+		}
+		return record(ctx, tx, questionID, revisions.ACTION_UPDATE, modifiedBy)
+	})
+}
+
+func (r *repo) UpdateAnswer(ctx echo.Context, questionID string, answerID string, in *AnswerRequest, modifiedBy string) (err error) {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		a := query.Use(tx).Answer
+		_, err := a.Where(a.AnswerID.Eq(answerID)).Updates(map[string]any{
+			"answer_text": in.AnswerText,
+			"is_correct":  in.IsCorrect,
+			"modified_at": time.Now().UnixMilli(),
+			"modified_by": modifiedBy,
+		})
+		if err != nil {
+			return err
+		}
+		return record(ctx, tx, questionID, revisions.ACTION_UPDATE, modifiedBy)
+	})
+}
+
+func (r *repo) UpdateGradingMode(ctx echo.Context, questionID string, mode string, modifiedBy string) (err error) {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		q := query.Use(tx).Question
+		_, err := q.Where(q.QuestionID.Eq(questionID)).Updates(map[string]any{
+			"grading_mode": mode,
+			"modified_at":  time.Now().UnixMilli(),
+			"modified_by":  modifiedBy,
+		})
+		if err != nil {
+			return err
+		}
+		return record(ctx, tx, questionID, revisions.ACTION_UPDATE, modifiedBy)
+	})
+}
+
+// DeleteQuestion soft deletes the question, its answers stay as they are
+// and come back with it.
+func (r *repo) DeleteQuestion(ctx echo.Context, questionID string, deletedBy string) (err error) {
+	return softdelete.By(r.db, deletedBy).Where("question_id = ?", questionID).Delete(&model.Question{}).Error
+}
+
+// DeleteAnswer soft deletes an answer of the question and records the
+// revision.
+func (r *repo) DeleteAnswer(ctx echo.Context, questionID string, answerID string, deletedBy string) (err error) {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		err := softdelete.By(tx, deletedBy).
+			Where("answer_id = ? AND question_id = ?", answerID, questionID).
+			Delete(&model.Answer{}).Error
+		if err != nil {
+			return err
+		}
+		return record(ctx, tx, questionID, revisions.ACTION_UPDATE, deletedBy)
+	})
+}
+
+// RestoreQuestion undeletes the question, restored is false when it was not
+// deleted. Its answers are left as they are, the ones deleted before it
+// come back through RestoreAnswer or a rollback.
+func (r *repo) RestoreQuestion(ctx echo.Context, questionID string) (restored bool, err error) {
+	res := softdelete.Restore(r.db.Model(&model.Question{}).Where("question_id = ?", questionID))
+	return res.RowsAffected > 0, res.Error
+}
+
+// RestoreAnswer undeletes an answer of the question and records the
+// revision, restored is false when it was not deleted.
+func (r *repo) RestoreAnswer(ctx echo.Context, questionID string, answerID string, restoredBy string) (restored bool, err error) {
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		res := softdelete.Restore(tx.Model(&model.Answer{}).
+			Where("answer_id = ? AND question_id = ?", answerID, questionID))
+		if res.Error != nil || res.RowsAffected == 0 {
+			return res.Error
+		}
+		restored = true
+		return record(ctx, tx, questionID, revisions.ACTION_UPDATE, restoredBy)
+	})
+	return
+}
+
+// record stores the revision a change to a question made, in its
+// transaction.
+func record(ctx echo.Context, tx *gorm.DB, questionID string, action string, by string) (err error) {
+	_, err = revisions.Record(ctx, tx, &revisions.Revision{
+		CreatedBy:  by,
+		QuestionID: questionID,
+		Action:     action,
+	})
+	return
+}
@@ -0,0 +1,41 @@
+package quizzes
+
+import (
+	"github.com/labstack/echo/v4"
+	"wakuwaku_nihongo/internals/middleware"
+	"wakuwaku_nihongo/internals/utils/token"
+)
+
+func (h *handler) Route(g *echo.Group) {
+	editor := middleware.Authorization(token.ROLE_EDITOR)
+	admin := middleware.Authorization(token.ROLE_ADMIN)
+
+	g.GET("", h.ListQuizzes, middleware.Authentication)
+	g.GET("/:quiz_id", h.GetQuiz, middleware.Authentication)
+	g.POST("", h.CreateQuiz, middleware.Authentication, editor)
+	g.PUT("/:quiz_id", h.UpdateQuiz, middleware.Authentication, editor)
+	g.DELETE("/:quiz_id", h.DeleteQuiz, middleware.Authentication, editor)
+	g.GET("/:quiz_id/preview", h.Preview, middleware.Authentication, editor)
+	g.POST("/:quiz_id/submit", h.Submit, middleware.Authentication, editor)
+	g.PUT("/:quiz_id/reviewer", h.AssignReviewer, middleware.Authentication, editor)
+	g.POST("/:quiz_id/approve", h.Approve, middleware.Authentication, editor)
+	g.POST("/:quiz_id/reject", h.Reject, middleware.Authentication, editor)
+	g.POST("/:quiz_id/withdraw", h.Withdraw, middleware.Authentication, editor)
+	g.GET("/:quiz_id/reviews", h.ListReviews, middleware.Authentication, editor)
+	g.POST("/:quiz_id/restore", h.RestoreQuiz, middleware.Authentication, admin)
+}
+
+func (h *handler) QuestionRoute(g *echo.Group) {
+	editor := middleware.Authorization(token.ROLE_EDITOR)
+	admin := middleware.Authorization(token.ROLE_ADMIN)
+
+	g.POST("", h.CreateQuestion, middleware.Authentication, editor)
+	g.GET("/:question_id", h.GetQuestion, middleware.Authentication, editor)
+	g.PUT("/:question_id", h.UpdateQuestion, middleware.Authentication, editor)
+	g.DELETE("/:question_id", h.DeleteQuestion, middleware.Authentication, editor)
+	g.PUT("/:question_id/answers/:answer_id", h.UpdateAnswer, middleware.Authentication, editor)
+	g.DELETE("/:question_id/answers/:answer_id", h.DeleteAnswer, middleware.Authentication, editor)
+	g.PUT("/:question_id/grading-mode", h.UpdateGradingMode, middleware.Authentication, editor)
+	g.POST("/:question_id/restore", h.RestoreQuestion, middleware.Authentication, admin)
+	g.POST("/:question_id/answers/:answer_id/restore", h.RestoreAnswer, middleware.Authentication, admin)
+}
@@ -0,0 +1,850 @@
+package quizzes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"wakuwaku_nihongo/internals/abstraction"
+	"wakuwaku_nihongo/internals/app/media"
+	"wakuwaku_nihongo/internals/app/notifications"
+	"wakuwaku_nihongo/internals/app/passages"
+	"wakuwaku_nihongo/internals/app/search"
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/middleware"
+	"wakuwaku_nihongo/internals/model"
+	"wakuwaku_nihongo/internals/pkg/normalizer"
+	"wakuwaku_nihongo/internals/utils/response"
+	"wakuwaku_nihongo/internals/utils/token"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+type IQuizRepo interface {
+	GetQuizByID(ctx echo.Context, quizID string) (out *model.Quiz, err error)
+	ListQuizzes(ctx echo.Context, filter *QuizFilter) (out []*quizWithCount, count int64, err error)
+	GetQuiz(ctx echo.Context, quizID string) (out *quizWithCount, err error)
+	GetQuizQuestions(ctx echo.Context, quizID string, includeDeleted bool) (out []*model.Question, err error)
+	RestoreQuiz(ctx echo.Context, quizID string) (restored bool, err error)
+	DeleteQuiz(ctx echo.Context, quizID string, deletedBy string) (err error)
+	CreateQuiz(ctx echo.Context, in *model.Quiz) (err error)
+	UpdateQuiz(ctx echo.Context, in *model.Quiz) (err error)
+	IsEditor(ctx echo.Context, customerID string) (editor bool, err error)
+	Move(ctx echo.Context, in *model.Quiz, from string, review *Review, notification *notifications.Notification) (moved bool, err error)
+	AssignReviewer(ctx echo.Context, in *model.Quiz, notification *notifications.Notification) (assigned bool, err error)
+	ListReviews(ctx echo.Context, quizID string) (out []*Review, err error)
+	PublishDue(ctx context.Context, now int64) (published int64, err error)
+	GetQuestionByID(ctx echo.Context, questionID string) (out *model.Question, err error)
+	GetQuestionQuizID(ctx echo.Context, questionID string) (quizID string, err error)
+	CreateQuestion(ctx echo.Context, in *model.Question) (err error)
+	UpdateQuestion(ctx echo.Context, questionID string, in *UpdateQuestionRequest, modifiedBy string) (err error)
+	UpdateAnswer(ctx echo.Context, questionID string, answerID string, in *AnswerRequest, modifiedBy string) (err error)
+	UpdateGradingMode(ctx echo.Context, questionID string, mode string, modifiedBy string) (err error)
+	DeleteQuestion(ctx echo.Context, questionID string, deletedBy string) (err error)
+	DeleteAnswer(ctx echo.Context, questionID string, answerID string, deletedBy string) (err error)
+	RestoreQuestion(ctx echo.Context, questionID string) (restored bool, err error)
+	RestoreAnswer(ctx echo.Context, questionID string, answerID string, restoredBy string) (restored bool, err error)
+}
+
+type ISearchIndexer interface {
+	IndexQuestion(ctx echo.Context, questionID string) (err error)
+}
+
+type IAudioProvider interface {
+	GetAudio(ctx echo.Context, ownerType string, ownerIDs []string) (out map[string]*media.MediaResponse, err error)
+}
+
+type IPassageChecker interface {
+	Exists(ctx echo.Context, id string) (ok bool, err error)
+}
+
+type service struct {
+	repo     IQuizRepo
+	search   ISearchIndexer
+	audio    IAudioProvider
+	passages IPassageChecker
+}
+
+func NewService(f *factory.Factory) *service {
+	return &service{
+		repo:     NewQuizRepo(f.Db),
+		search:   search.NewService(f),
+		audio:    media.NewService(f),
+		passages: passages.NewService(f),
+	}
+}
+
+// ListQuizzes lists the quizzes matching filter, learners only ever see
+// the published ones.
+func (s *service) ListQuizzes(ctx echo.Context, filter *QuizFilter) (out []*QuizResponse, info *abstraction.PaginationInfo, err error) {
+	if filter.IncludeDeleted && middleware.GetRole(ctx) != token.ROLE_ADMIN {
+		err = response.ErrorWrap(response.ErrForbidden, fmt.Errorf("only admins can include deleted quizzes"))
+		return
+	}
+	preview := CanPreview(middleware.GetRole(ctx))
+	if !preview {
+		filter.Status = QUIZ_STATUS_PUBLISHED
+		filter.ReviewerID = ""
+	}
+
+	quizzes, count, err := s.repo.ListQuizzes(ctx, filter)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	out = []*QuizResponse{}
+	for _, q := range quizzes {
+		res := mapQuiz(q)
+		if !preview {
+			res.Hide()
+		}
+		out = append(out, res)
+	}
+	info = filter.Pagination.CreatePageInfo(count)
+	return
+}
+
+// GetQuiz returns a quiz, one not published yet is not found for learners.
+func (s *service) GetQuiz(ctx echo.Context, quizID string) (out *QuizResponse, err error) {
+	quiz, err := s.getQuiz(ctx, quizID)
+	if err != nil {
+		return
+	}
+
+	out = mapQuiz(quiz)
+	if CanPreview(middleware.GetRole(ctx)) {
+		return
+	}
+	if quiz.Status != QUIZ_STATUS_PUBLISHED {
+		return nil, response.ErrorWrap(response.ErrNotFound, fmt.Errorf("quiz not found"))
+	}
+	out.Hide()
+	return
+}
+
+// Preview returns the answer key of a quiz of any status, its questions in
+// order with the correct answers marked. It is not the attempt view, passages
+// are not grouped and readings are not hidden.
+func (s *service) Preview(ctx echo.Context, quizID string, filter *PreviewFilter) (out *PreviewResponse, err error) {
+	if filter.IncludeDeleted && middleware.GetRole(ctx) != token.ROLE_ADMIN {
+		err = response.ErrorWrap(response.ErrForbidden, fmt.Errorf("only admins can include deleted questions"))
+		return
+	}
+
+	quiz, err := s.getQuiz(ctx, quizID)
+	if err != nil {
+		return
+	}
+	questions, err := s.repo.GetQuizQuestions(ctx, quizID, filter.IncludeDeleted)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	ids := []string{}
+	for _, q := range questions {
+		ids = append(ids, q.QuestionID)
+	}
+	audio, err := s.audio.GetAudio(ctx, media.OWNER_TYPE_QUESTION, ids)
+	if err != nil {
+		return
+	}
+
+	out = &PreviewResponse{QuizResponse: *mapQuiz(quiz), Questions: []*QuestionResponse{}}
+	for _, q := range questions {
+		question := &QuestionResponse{}
+		question.MapFromModelWithFormat(q, filter.Format())
+		question.Audio = audio[q.QuestionID]
+		out.Questions = append(out.Questions, question)
+	}
+	return
+}
+
+// RestoreQuiz undeletes a quiz (admin only).
+func (s *service) RestoreQuiz(ctx echo.Context, quizID string) (out *QuizResponse, err error) {
+	restored, err := s.repo.RestoreQuiz(ctx, quizID)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	if !restored {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("deleted quiz not found"))
+		return
+	}
+	quiz, err := s.getQuiz(ctx, quizID)
+	if err != nil {
+		return
+	}
+	return mapQuiz(quiz), nil
+}
+
+// DeleteQuiz soft deletes a draft quiz, its questions are left as they are.
+// A quiz in review or published is withdrawn first.
+func (s *service) DeleteQuiz(ctx echo.Context, quizID string) (err error) {
+	if err = s.checkEditable(ctx, quizID); err != nil {
+		return
+	}
+
+	err = s.repo.DeleteQuiz(ctx, quizID, middleware.GetUserID(ctx))
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	return
+}
+
+// CreateQuiz creates a draft quiz, learners do not see it until it is
+// reviewed and published.
+func (s *service) CreateQuiz(ctx echo.Context, in *QuizRequest) (out *QuizResponse, err error) {
+	quiz := &model.Quiz{
+		CreatedBy:   middleware.GetUserID(ctx),
+		Title:       in.Title,
+		Description: in.Description,
+		JlptLevel:   in.JlptLevel,
+		Status:      QUIZ_STATUS_DRAFT,
+	}
+	if err = s.repo.CreateQuiz(ctx, quiz); err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	out = &QuizResponse{}
+	out.MapFromModel(quiz)
+	return
+}
+
+func (s *service) UpdateQuiz(ctx echo.Context, quizID string, in *QuizRequest) (out *QuizResponse, err error) {
+	quiz, err := s.getQuiz(ctx, quizID)
+	if err != nil {
+		return
+	}
+	if !CanEdit(quiz.Status) {
+		err = response.ErrorWrap(response.ErrBadRequest, fmt.Errorf("only draft quizzes can be edited, withdraw the quiz first"))
+		return
+	}
+
+	now := time.Now().UnixMilli()
+	userID := middleware.GetUserID(ctx)
+	quiz.Title = in.Title
+	quiz.Description = in.Description
+	quiz.JlptLevel = in.JlptLevel
+	quiz.ModifiedAt = &now
+	quiz.ModifiedBy = &userID
+	if err = s.repo.UpdateQuiz(ctx, &quiz.Quiz); err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	return mapQuiz(quiz), nil
+}
+
+// Submit sends a draft quiz to an editor for review, another one than the
+// submitter.
+func (s *service) Submit(ctx echo.Context, quizID string, in *SubmitRequest) (out *QuizResponse, err error) {
+	quiz, err := s.getQuiz(ctx, quizID)
+	if err != nil {
+		return
+	}
+	if quiz.QuestionCount == 0 {
+		err = response.ErrorWrap(response.ErrBadRequest, fmt.Errorf("a quiz without questions cannot be submitted"))
+		return
+	}
+
+	userID := middleware.GetUserID(ctx)
+	if err = s.checkReviewer(ctx, in.ReviewerID, userID); err != nil {
+		return
+	}
+
+	now := time.Now().UnixMilli()
+	from := quiz.Status
+	quiz.Status = QUIZ_STATUS_IN_REVIEW
+	quiz.ReviewerID = &in.ReviewerID
+	quiz.SubmittedAt = &now
+	quiz.SubmittedBy = &userID
+	quiz.PublishAt = nil
+	quiz.ModifiedAt = &now
+	quiz.ModifiedBy = &userID
+	if err = s.move(ctx, &quiz.Quiz, from, nil, reviewRequestedNotification(&quiz.Quiz)); err != nil {
+		return
+	}
+	return mapQuiz(quiz), nil
+}
+
+// AssignReviewer hands a quiz in review to another reviewer.
+func (s *service) AssignReviewer(ctx echo.Context, quizID string, in *SubmitRequest) (out *QuizResponse, err error) {
+	quiz, err := s.getQuiz(ctx, quizID)
+	if err != nil {
+		return
+	}
+	if quiz.Status != QUIZ_STATUS_IN_REVIEW {
+		err = response.ErrorWrap(response.ErrBadRequest, fmt.Errorf("only a quiz in review can be assigned"))
+		return
+	}
+	submitter := ""
+	if quiz.SubmittedBy != nil {
+		submitter = *quiz.SubmittedBy
+	}
+	if err = s.checkReviewer(ctx, in.ReviewerID, submitter); err != nil {
+		return
+	}
+
+	now := time.Now().UnixMilli()
+	userID := middleware.GetUserID(ctx)
+	quiz.ReviewerID = &in.ReviewerID
+	quiz.ModifiedAt = &now
+	quiz.ModifiedBy = &userID
+	assigned, err := s.repo.AssignReviewer(ctx, &quiz.Quiz, reviewRequestedNotification(&quiz.Quiz))
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	if !assigned {
+		err = response.ErrorWrap(response.ErrBadRequest, fmt.Errorf("quiz was updated meanwhile"))
+		return
+	}
+	return mapQuiz(quiz), nil
+}
+
+// Approve publishes a quiz in review, or schedules it when it is to be
+// published later.
+func (s *service) Approve(ctx echo.Context, quizID string, in *ApproveRequest) (out *QuizResponse, err error) {
+	quiz, err := s.getReviewed(ctx, quizID)
+	if err != nil {
+		return
+	}
+
+	now := time.Now().UnixMilli()
+	userID := middleware.GetUserID(ctx)
+	from := quiz.Status
+	quiz.Status = QUIZ_STATUS_PUBLISHED
+	quiz.PublishAt = nil
+	quiz.PublishedAt = &now
+	if in.PublishAt != nil && *in.PublishAt > now {
+		quiz.Status = QUIZ_STATUS_SCHEDULED
+		quiz.PublishAt = in.PublishAt
+		quiz.PublishedAt = nil
+	}
+	quiz.PublishedBy = &userID
+	quiz.ModifiedAt = &now
+	quiz.ModifiedBy = &userID
+
+	review := &Review{
+		QuizID:     quizID,
+		ReviewerID: userID,
+		Decision:   DECISION_APPROVED,
+		Comment:    in.Comment,
+		PublishAt:  quiz.PublishAt,
+	}
+	if err = s.move(ctx, &quiz.Quiz, from, review, reviewedNotification(&quiz.Quiz, review)); err != nil {
+		return
+	}
+	return mapQuiz(quiz), nil
+}
+
+// Reject sends a quiz in review back to draft with what needs fixing.
+func (s *service) Reject(ctx echo.Context, quizID string, in *RejectRequest) (out *QuizResponse, err error) {
+	quiz, err := s.getReviewed(ctx, quizID)
+	if err != nil {
+		return
+	}
+
+	now := time.Now().UnixMilli()
+	userID := middleware.GetUserID(ctx)
+	from := quiz.Status
+	quiz.Status = QUIZ_STATUS_DRAFT
+	quiz.ModifiedAt = &now
+	quiz.ModifiedBy = &userID
+
+	review := &Review{
+		QuizID:     quizID,
+		ReviewerID: userID,
+		Decision:   DECISION_REJECTED,
+		Comment:    &in.Comment,
+	}
+	if err = s.move(ctx, &quiz.Quiz, from, review, reviewedNotification(&quiz.Quiz, review)); err != nil {
+		return
+	}
+	return mapQuiz(quiz), nil
+}
+
+// Withdraw takes a quiz in review, scheduled or published back to draft,
+// it has to be reviewed again before it is published.
+func (s *service) Withdraw(ctx echo.Context, quizID string) (out *QuizResponse, err error) {
+	quiz, err := s.getQuiz(ctx, quizID)
+	if err != nil {
+		return
+	}
+	if quiz.Status == QUIZ_STATUS_DRAFT {
+		err = response.ErrorWrap(response.ErrBadRequest, fmt.Errorf("quiz is already a draft"))
+		return
+	}
+
+	now := time.Now().UnixMilli()
+	userID := middleware.GetUserID(ctx)
+	from := quiz.Status
+	quiz.Status = QUIZ_STATUS_DRAFT
+	quiz.PublishAt = nil
+	quiz.PublishedAt = nil
+	quiz.PublishedBy = nil
+	quiz.ModifiedAt = &now
+	quiz.ModifiedBy = &userID
+	if err = s.move(ctx, &quiz.Quiz, from, nil, nil); err != nil {
+		return
+	}
+	return mapQuiz(quiz), nil
+}
+
+func (s *service) ListReviews(ctx echo.Context, quizID string) (out []*ReviewResponse, err error) {
+	if _, err = s.getQuiz(ctx, quizID); err != nil {
+		return
+	}
+	reviews, err := s.repo.ListReviews(ctx, quizID)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	out = []*ReviewResponse{}
+	for _, r := range reviews {
+		review := &ReviewResponse{}
+		review.MapFromModel(r)
+		out = append(out, review)
+	}
+	return
+}
+
+func (s *service) CreateQuestion(ctx echo.Context, in *CreateQuestionRequest) (out *QuestionResponse, err error) {
+	if err = s.checkEditable(ctx, in.QuizID); err != nil {
+		return
+	}
+
+	hasCorrect := false
+	for _, a := range in.Answers {
+		hasCorrect = hasCorrect || a.IsCorrect
+	}
+	if !hasCorrect {
+		err = response.ErrorWrap(response.ErrValidation, fmt.Errorf("at least one answer must be correct"))
+		return
+	}
+	if err = s.checkPassage(ctx, in.PassageID); err != nil {
+		return
+	}
+
+	gradingMode := in.GradingMode
+	if gradingMode == "" {
+		gradingMode = string(normalizer.DefaultMode)
+	}
+
+	userID := middleware.GetUserID(ctx)
+	question := &model.Question{
+		CreatedBy:    userID,
+		QuizID:       in.QuizID,
+		QuestionText: in.QuestionText,
+		QuestionType: in.QuestionType,
+		GradingMode:  gradingMode,
+		PassageID:    in.PassageID,
+		Section:      in.Section,
+	}
+	for _, a := range in.Answers {
+		question.Answers = append(question.Answers, &model.Answer{
+			CreatedBy:  userID,
+			AnswerText: a.AnswerText,
+			IsCorrect:  a.IsCorrect,
+		})
+	}
+
+	err = s.repo.CreateQuestion(ctx, question)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	s.index(ctx, question.QuestionID)
+
+	out = &QuestionResponse{}
+	out.MapFromModel(question)
+	return
+}
+
+func (s *service) GetQuestion(ctx echo.Context, questionID string, filter *RubyFilter) (out *QuestionResponse, err error) {
+	question, err := s.getQuestion(ctx, questionID)
+	if err != nil {
+		return
+	}
+
+	audio, err := s.audio.GetAudio(ctx, media.OWNER_TYPE_QUESTION, []string{questionID})
+	if err != nil {
+		return
+	}
+
+	out = &QuestionResponse{}
+	out.MapFromModelWithFormat(question, filter.Format())
+	out.Audio = audio[questionID]
+	return
+}
+
+func (s *service) UpdateQuestion(ctx echo.Context, questionID string, in *UpdateQuestionRequest) (out *QuestionResponse, err error) {
+	question, err := s.getQuestion(ctx, questionID)
+	if err != nil {
+		return
+	}
+	if err = s.checkEditable(ctx, question.QuizID); err != nil {
+		return
+	}
+	if err = s.checkPassage(ctx, in.PassageID); err != nil {
+		return
+	}
+
+	err = s.repo.UpdateQuestion(ctx, questionID, in, middleware.GetUserID(ctx))
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	s.index(ctx, questionID)
+	question.QuestionText = in.QuestionText
+	question.QuestionType = in.QuestionType
+	question.PassageID = in.PassageID
+
+	out = &QuestionResponse{}
+	out.MapFromModel(question)
+	return
+}
+
+func (s *service) UpdateAnswer(ctx echo.Context, questionID string, answerID string, in *AnswerRequest) (out *QuestionResponse, err error) {
+	question, err := s.getQuestion(ctx, questionID)
+	if err != nil {
+		return
+	}
+	if err = s.checkEditable(ctx, question.QuizID); err != nil {
+		return
+	}
+
+	var answer *model.Answer
+	hasCorrect := in.IsCorrect
+	for _, a := range question.Answers {
+		if a.AnswerID == answerID {
+			answer = a
+			continue
+		}
+		hasCorrect = hasCorrect || a.IsCorrect
+	}
+	if answer == nil {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("answer not found"))
+		return
+	}
+	if !hasCorrect {
+		err = response.ErrorWrap(response.ErrValidation, fmt.Errorf("at least one answer must be correct"))
+		return
+	}
+
+	err = s.repo.UpdateAnswer(ctx, questionID, answerID, in, middleware.GetUserID(ctx))
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	s.index(ctx, questionID)
+	answer.AnswerText = in.AnswerText
+	answer.IsCorrect = in.IsCorrect
+
+	out = &QuestionResponse{}
+	out.MapFromModel(question)
+	return
+}
+
+func (s *service) UpdateGradingMode(ctx echo.Context, questionID string, in *GradingModeRequest) (out *QuestionResponse, err error) {
+	question, err := s.getQuestion(ctx, questionID)
+	if err != nil {
+		return
+	}
+	if err = s.checkEditable(ctx, question.QuizID); err != nil {
+		return
+	}
+
+	err = s.repo.UpdateGradingMode(ctx, questionID, in.GradingMode, middleware.GetUserID(ctx))
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	question.GradingMode = in.GradingMode
+
+	out = &QuestionResponse{}
+	out.MapFromModel(question)
+	return
+}
+
+// DeleteQuestion soft deletes a question of a draft quiz, its answers are
+// left as they are.
+func (s *service) DeleteQuestion(ctx echo.Context, questionID string) (err error) {
+	question, err := s.getQuestion(ctx, questionID)
+	if err != nil {
+		return
+	}
+	if err = s.checkEditable(ctx, question.QuizID); err != nil {
+		return
+	}
+
+	err = s.repo.DeleteQuestion(ctx, questionID, middleware.GetUserID(ctx))
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	s.index(ctx, questionID)
+	return
+}
+
+// DeleteAnswer soft deletes an answer of a question of a draft quiz, as a
+// new revision of the question. The question keeps at least one correct
+// answer.
+func (s *service) DeleteAnswer(ctx echo.Context, questionID string, answerID string) (out *QuestionResponse, err error) {
+	question, err := s.getQuestion(ctx, questionID)
+	if err != nil {
+		return
+	}
+	if err = s.checkEditable(ctx, question.QuizID); err != nil {
+		return
+	}
+
+	found, hasCorrect := false, false
+	answers := make([]*model.Answer, 0, len(question.Answers))
+	for _, a := range question.Answers {
+		if a.AnswerID == answerID {
+			found = true
+			continue
+		}
+		hasCorrect = hasCorrect || a.IsCorrect
+		answers = append(answers, a)
+	}
+	if !found {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("answer not found"))
+		return
+	}
+	if !hasCorrect {
+		err = response.ErrorWrap(response.ErrValidation, fmt.Errorf("at least one answer must be correct"))
+		return
+	}
+
+	err = s.repo.DeleteAnswer(ctx, questionID, answerID, middleware.GetUserID(ctx))
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	s.index(ctx, questionID)
+	question.Answers = answers
+
+	out = &QuestionResponse{}
+	out.MapFromModel(question)
+	return
+}
+
+// RestoreQuestion undeletes a question of a draft quiz (admin only), its
+// answers stay as they are.
+func (s *service) RestoreQuestion(ctx echo.Context, questionID string) (out *QuestionResponse, err error) {
+	quizID, err := s.repo.GetQuestionQuizID(ctx, questionID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("question not found"))
+		return
+	}
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	if err = s.checkEditable(ctx, quizID); err != nil {
+		return
+	}
+
+	restored, err := s.repo.RestoreQuestion(ctx, questionID)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	if !restored {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("deleted question not found"))
+		return
+	}
+	s.index(ctx, questionID)
+	return s.GetQuestion(ctx, questionID, &RubyFilter{})
+}
+
+// RestoreAnswer undeletes an answer of a question of a draft quiz (admin
+// only), as a new revision of the question.
+func (s *service) RestoreAnswer(ctx echo.Context, questionID string, answerID string) (out *QuestionResponse, err error) {
+	question, err := s.getQuestion(ctx, questionID)
+	if err != nil {
+		return
+	}
+	if err = s.checkEditable(ctx, question.QuizID); err != nil {
+		return
+	}
+
+	restored, err := s.repo.RestoreAnswer(ctx, questionID, answerID, middleware.GetUserID(ctx))
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	if !restored {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("deleted answer not found"))
+		return
+	}
+	s.index(ctx, questionID)
+	return s.GetQuestion(ctx, questionID, &RubyFilter{})
+}
+
+// index refreshes the search document of a question. A failure only makes
+// search results stale until the next reindex, so it does not fail the write.
+func (s *service) index(ctx echo.Context, questionID string) {
+	err := s.search.IndexQuestion(ctx, questionID)
+	if err != nil {
+		log.Error().Err(err).Str("question_id", questionID).Msg("error indexing question")
+	}
+}
+
+func (s *service) checkPassage(ctx echo.Context, passageID *string) (err error) {
+	if passageID == nil {
+		return
+	}
+	ok, err := s.passages.Exists(ctx, *passageID)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	if !ok {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("passage not found"))
+	}
+	return
+}
+
+func (s *service) getQuiz(ctx echo.Context, quizID string) (out *quizWithCount, err error) {
+	out, err = s.repo.GetQuiz(ctx, quizID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("quiz not found"))
+		return
+	}
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	return
+}
+
+// checkEditable checks the quiz exists and can be edited, with its
+// questions.
+func (s *service) checkEditable(ctx echo.Context, quizID string) (err error) {
+	quiz, err := s.repo.GetQuizByID(ctx, quizID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return response.ErrorWrap(response.ErrNotFound, fmt.Errorf("quiz not found"))
+	}
+	if err != nil {
+		return response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	if !CanEdit(quiz.Status) {
+		return response.ErrorWrap(response.ErrBadRequest, fmt.Errorf("only draft quizzes can be edited, withdraw the quiz first"))
+	}
+	return
+}
+
+// getReviewed returns a quiz in review the logged in editor can decide on,
+// as its reviewer or an admin.
+func (s *service) getReviewed(ctx echo.Context, quizID string) (out *quizWithCount, err error) {
+	out, err = s.getQuiz(ctx, quizID)
+	if err != nil {
+		return
+	}
+	if out.Status != QUIZ_STATUS_IN_REVIEW {
+		return nil, response.ErrorWrap(response.ErrBadRequest, fmt.Errorf("quiz is not in review"))
+	}
+	reviewer := out.ReviewerID != nil && *out.ReviewerID == middleware.GetUserID(ctx)
+	if !reviewer && middleware.GetRole(ctx) != token.ROLE_ADMIN {
+		return nil, response.ErrorWrap(response.ErrForbidden, fmt.Errorf("quiz is assigned to another reviewer"))
+	}
+	return
+}
+
+// checkReviewer checks a quiz submitted by submitter can be reviewed by
+// reviewerID, an editor who did not submit it.
+func (s *service) checkReviewer(ctx echo.Context, reviewerID, submitter string) (err error) {
+	if reviewerID == submitter {
+		return response.ErrorWrap(response.ErrBadRequest, fmt.Errorf("a quiz cannot be reviewed by its submitter"))
+	}
+	editor, err := s.repo.IsEditor(ctx, reviewerID)
+	if err != nil {
+		return response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	if !editor {
+		return response.ErrorWrap(response.ErrBadRequest, fmt.Errorf("reviewer is not an editor"))
+	}
+	return
+}
+
+// move stores the quiz moved from the status from to its status.
+func (s *service) move(ctx echo.Context, quiz *model.Quiz, from string, review *Review, notification *notifications.Notification) (err error) {
+	if !CanMoveTo(from, quiz.Status) {
+		return response.ErrorWrap(response.ErrBadRequest, fmt.Errorf("a quiz %s cannot be %s", from, quiz.Status))
+	}
+	moved, err := s.repo.Move(ctx, quiz, from, review, notification)
+	if err != nil {
+		return response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	if !moved {
+		return response.ErrorWrap(response.ErrBadRequest, fmt.Errorf("quiz was updated meanwhile"))
+	}
+	return
+}
+
+func mapQuiz(m *quizWithCount) (out *QuizResponse) {
+	out = &QuizResponse{}
+	out.MapFromModel(&m.Quiz)
+	out.QuestionCount = m.QuestionCount
+	return
+}
+
+// reviewRequestedNotification tells the reviewer of a quiz it awaits them.
+func reviewRequestedNotification(quiz *model.Quiz) *notifications.Notification {
+	return &notifications.Notification{
+		CustomerID: *quiz.ReviewerID,
+		Type:       notifications.TYPE_QUIZ_REVIEW_REQUESTED,
+		SourceID:   quiz.QuizID,
+		Data: datatypes.JSONMap{
+			"quiz_id":      quiz.QuizID,
+			"title":        quiz.Title,
+			"submitted_by": quiz.SubmittedBy,
+		},
+	}
+}
+
+// reviewedNotification tells the submitter of a quiz what its reviewer
+// decided, nil when nobody submitted it.
+func reviewedNotification(quiz *model.Quiz, review *Review) *notifications.Notification {
+	if quiz.SubmittedBy == nil {
+		return nil
+	}
+	data := datatypes.JSONMap{
+		"quiz_id":  quiz.QuizID,
+		"title":    quiz.Title,
+		"decision": review.Decision,
+		"status":   quiz.Status,
+	}
+	if review.Comment != nil {
+		data["comment"] = *review.Comment
+	}
+	if review.PublishAt != nil {
+		data["publish_at"] = *review.PublishAt
+	}
+	return &notifications.Notification{
+		CustomerID: *quiz.SubmittedBy,
+		Type:       notifications.TYPE_QUIZ_REVIEWED,
+		SourceID:   quiz.QuizID,
+		Data:       data,
+	}
+}
+
+func (s *service) getQuestion(ctx echo.Context, questionID string) (out *model.Question, err error) {
+	out, err = s.repo.GetQuestionByID(ctx, questionID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("question not found"))
+		return
+	}
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	return
+}
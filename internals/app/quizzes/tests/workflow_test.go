@@ -0,0 +1,105 @@
+package tests
+
+import (
+	"testing"
+
+	"wakuwaku_nihongo/internals/app/quizzes"
+	"wakuwaku_nihongo/internals/model"
+	"wakuwaku_nihongo/internals/pkg/softdelete"
+	"wakuwaku_nihongo/internals/utils/token"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanMoveTo(t *testing.T) {
+	assert.True(t, quizzes.CanMoveTo(quizzes.QUIZ_STATUS_DRAFT, quizzes.QUIZ_STATUS_IN_REVIEW))
+	assert.False(t, quizzes.CanMoveTo(quizzes.QUIZ_STATUS_DRAFT, quizzes.QUIZ_STATUS_PUBLISHED))
+	assert.False(t, quizzes.CanMoveTo(quizzes.QUIZ_STATUS_DRAFT, quizzes.QUIZ_STATUS_SCHEDULED))
+
+	for _, to := range []string{quizzes.QUIZ_STATUS_DRAFT, quizzes.QUIZ_STATUS_SCHEDULED, quizzes.QUIZ_STATUS_PUBLISHED} {
+		assert.True(t, quizzes.CanMoveTo(quizzes.QUIZ_STATUS_IN_REVIEW, to))
+	}
+	assert.True(t, quizzes.CanMoveTo(quizzes.QUIZ_STATUS_SCHEDULED, quizzes.QUIZ_STATUS_PUBLISHED))
+	assert.True(t, quizzes.CanMoveTo(quizzes.QUIZ_STATUS_SCHEDULED, quizzes.QUIZ_STATUS_DRAFT))
+	assert.False(t, quizzes.CanMoveTo(quizzes.QUIZ_STATUS_SCHEDULED, quizzes.QUIZ_STATUS_IN_REVIEW))
+
+	// a published quiz is withdrawn to be corrected, then reviewed again
+	assert.True(t, quizzes.CanMoveTo(quizzes.QUIZ_STATUS_PUBLISHED, quizzes.QUIZ_STATUS_DRAFT))
+	assert.False(t, quizzes.CanMoveTo(quizzes.QUIZ_STATUS_PUBLISHED, quizzes.QUIZ_STATUS_IN_REVIEW))
+}
+
+func TestCanEdit(t *testing.T) {
+	assert.True(t, quizzes.CanEdit(quizzes.QUIZ_STATUS_DRAFT))
+	assert.False(t, quizzes.CanEdit(quizzes.QUIZ_STATUS_IN_REVIEW))
+	assert.False(t, quizzes.CanEdit(quizzes.QUIZ_STATUS_SCHEDULED))
+	assert.False(t, quizzes.CanEdit(quizzes.QUIZ_STATUS_PUBLISHED))
+
+	// a quiz sent back to draft, rejected or withdrawn, can be edited again
+	for _, from := range []string{quizzes.QUIZ_STATUS_IN_REVIEW, quizzes.QUIZ_STATUS_SCHEDULED, quizzes.QUIZ_STATUS_PUBLISHED} {
+		assert.True(t, quizzes.CanMoveTo(from, quizzes.QUIZ_STATUS_DRAFT))
+	}
+}
+
+func TestCanPreview(t *testing.T) {
+	assert.True(t, quizzes.CanPreview(token.ROLE_EDITOR))
+	assert.True(t, quizzes.CanPreview(token.ROLE_ADMIN))
+	assert.False(t, quizzes.CanPreview(token.ROLE_LEARNER))
+	assert.False(t, quizzes.CanPreview(""))
+}
+
+func TestQuizResponseHide(t *testing.T) {
+	reviewer, at := "reviewer", int64(1000)
+	quiz := &model.Quiz{
+		QuizID:      "quiz",
+		Title:       "N5 verbs",
+		Status:      quizzes.QUIZ_STATUS_PUBLISHED,
+		CreatedBy:   "editor",
+		ReviewerID:  &reviewer,
+		SubmittedAt: &at,
+		SubmittedBy: &reviewer,
+		PublishedAt: &at,
+		PublishedBy: &reviewer,
+	}
+
+	res := &quizzes.QuizResponse{}
+	res.MapFromModel(quiz)
+	assert.Equal(t, quizzes.QUIZ_STATUS_PUBLISHED, res.Status)
+	assert.Equal(t, &reviewer, res.ReviewerID)
+
+	res.Hide()
+	assert.Empty(t, res.Status)
+	assert.Empty(t, res.CreatedBy)
+	assert.Nil(t, res.ReviewerID)
+	assert.Nil(t, res.SubmittedAt)
+	assert.Nil(t, res.SubmittedBy)
+	assert.Nil(t, res.PublishedBy)
+	assert.Equal(t, &at, res.PublishedAt)
+	assert.Equal(t, "N5 verbs", res.Title)
+}
+
+func TestResponseDeleted(t *testing.T) {
+	admin := "admin"
+	question := &model.Question{
+		QuestionID:   "question",
+		QuestionText: "question",
+		DeletedAt:    softdelete.At(1000),
+		DeletedBy:    &admin,
+		Answers: []*model.Answer{
+			{AnswerID: "kept", AnswerText: "kept"},
+			{AnswerID: "deleted", AnswerText: "deleted", DeletedAt: softdelete.At(2000), DeletedBy: &admin},
+		},
+	}
+
+	res := &quizzes.QuestionResponse{}
+	res.MapFromModel(question)
+	assert.Equal(t, int64(1000), *res.DeletedAt)
+	assert.Equal(t, &admin, res.DeletedBy)
+	assert.Nil(t, res.Answers[0].DeletedAt)
+	assert.Nil(t, res.Answers[0].DeletedBy)
+	assert.Equal(t, int64(2000), *res.Answers[1].DeletedAt)
+
+	quiz := &quizzes.QuizResponse{}
+	quiz.MapFromModel(&model.Quiz{QuizID: "quiz"})
+	assert.Nil(t, quiz.DeletedAt)
+	assert.Nil(t, quiz.DeletedBy)
+}
@@ -0,0 +1,33 @@
+package reports
+
+const (
+	// the reasons a learner can report a question for.
+	REASON_WRONG_ANSWER     = "wrong_answer"
+	REASON_MULTIPLE_ANSWERS = "multiple_answers"
+	REASON_TYPO             = "typo"
+	REASON_UNCLEAR          = "unclear"
+	REASON_BAD_EXPLANATION  = "bad_explanation"
+	REASON_BROKEN_MEDIA     = "broken_media"
+	REASON_OTHER            = "other"
+
+	// a report is open until an editor accepts it as a real error, it is
+	// resolved once rejected or fixed.
+	STATUS_OPEN     = "open"
+	STATUS_ACCEPTED = "accepted"
+	STATUS_REJECTED = "rejected"
+	STATUS_FIXED    = "fixed"
+
+	DEFAULT_SORT_BY = "created_at"
+)
+
+var SORTABLE_COLUMNS = map[string]bool{
+	"created_at":  true,
+	"modified_at": true,
+	"resolved_at": true,
+}
+
+// TRANSITIONS lists the statuses a report of each status can move to.
+var TRANSITIONS = map[string][]string{
+	STATUS_OPEN:     {STATUS_ACCEPTED, STATUS_REJECTED, STATUS_FIXED},
+	STATUS_ACCEPTED: {STATUS_REJECTED, STATUS_FIXED},
+}
@@ -0,0 +1,227 @@
+package reports
+
+import (
+	"wakuwaku_nihongo/internals/abstraction"
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+type IReportService interface {
+	Create(ctx echo.Context, questionID string, in *ReportRequest) (out *ReportResponse, err error)
+	ListMine(ctx echo.Context, filter *ReportFilter) (out []*ReportResponse, info *abstraction.PaginationInfo, err error)
+	List(ctx echo.Context, filter *ReportFilter) (out []*ReportResponse, info *abstraction.PaginationInfo, err error)
+	Get(ctx echo.Context, reportID string) (out *ReportResponse, err error)
+	Assign(ctx echo.Context, reportID string, in *AssignRequest) (out *ReportResponse, err error)
+	Move(ctx echo.Context, reportID string, in *StatusRequest) (out *ReportResponse, err error)
+}
+
+type handler struct {
+	service IReportService
+}
+
+func NewHandler(f *factory.Factory) *handler {
+	return &handler{
+		service: NewService(f),
+	}
+}
+
+// @Summary Report Question
+// @Description Report an error in a question. A comment is required when the reason is other. A question can be reported again once the previous report is resolved
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Param question_id path string true "Question ID"
+// @Param payload body ReportRequest true "Payload"
+// @Success 200 {object} response.Success{data=ReportResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/questions/{question_id}/reports [post]
+func (h *handler) Create(c echo.Context) error {
+	req := &ReportRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.Create(c, c.Param("question_id"), req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary List My Reports
+// @Description List the question reports of the logged in customer. The editor note shows once a report is resolved
+// @Tags reports
+// @Produce json
+// @Param status query string false "Status" Enums(open, accepted, rejected, fixed)
+// @Param reason query string false "Reason" Enums(wrong_answer, multiple_answers, typo, unclear, bad_explanation, broken_media, other)
+// @Param page query int false "Page"
+// @Param page_size query int false "Page size"
+// @Param sort_by query string false "Sort by" Enums(created_at, modified_at, resolved_at)
+// @Success 200 {object} response.SuccessResponseWithInfo{data=[]ReportResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 401 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/me/reports [get]
+func (h *handler) ListMine(c echo.Context) error {
+	req, err := bindFilter(c, nil)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+
+	res, info, err := h.service.ListMine(c, req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponseInfo(res, info).Send(c)
+}
+
+// @Summary List Reports
+// @Description List the question reports for editors to triage, the oldest first
+// @Tags reports
+// @Produce json
+// @Param status query string false "Status" Enums(open, accepted, rejected, fixed)
+// @Param reason query string false "Reason" Enums(wrong_answer, multiple_answers, typo, unclear, bad_explanation, broken_media, other)
+// @Param assigned_to query string false "Assignee ID"
+// @Param unassigned query bool false "Only unassigned reports"
+// @Param question_id query string false "Question ID"
+// @Param jlpt_level query string false "JLPT level" Enums(N5, N4, N3, N2, N1)
+// @Param section query string false "Section" Enums(vocabulary, grammar, reading, listening)
+// @Param page query int false "Page"
+// @Param page_size query int false "Page size"
+// @Param sort_by query string false "Sort by" Enums(created_at, modified_at, resolved_at)
+// @Param order_by query string false "Order by" Enums(asc, desc)
+// @Success 200 {object} response.SuccessResponseWithInfo{data=[]ReportResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 401 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/reports [get]
+func (h *handler) List(c echo.Context) error {
+	asc := "asc"
+	req, err := bindFilter(c, &asc)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+
+	res, info, err := h.service.List(c, req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponseInfo(res, info).Send(c)
+}
+
+// @Summary Get Report
+// @Description Get a question report
+// @Tags reports
+// @Produce json
+// @Param id path string true "Report ID"
+// @Success 200 {object} response.Success{data=ReportResponse}
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/reports/{id} [get]
+func (h *handler) Get(c echo.Context) error {
+	res, err := h.service.Get(c, c.Param("id"))
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Assign Report
+// @Description Assign a pending report to an editor, a null assignee_id unassigns it
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Param id path string true "Report ID"
+// @Param payload body AssignRequest true "Payload"
+// @Success 200 {object} response.Success{data=ReportResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/reports/{id}/assign [put]
+func (h *handler) Assign(c echo.Context) error {
+	req := &AssignRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.Assign(c, c.Param("id"), req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Update Report Status
+// @Description Accept an open report, or resolve a pending one as rejected or fixed. Resolving notifies the reporter with the note
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Param id path string true "Report ID"
+// @Param payload body StatusRequest true "Payload"
+// @Success 200 {object} response.Success{data=ReportResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/reports/{id}/status [put]
+func (h *handler) Move(c echo.Context) error {
+	req := &StatusRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.Move(c, c.Param("id"), req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// bindFilter binds and validates the report filter, orderBy is the default
+// order unless it is nil.
+func bindFilter(c echo.Context, orderBy *string) (req *ReportFilter, err error) {
+	req = &ReportFilter{}
+	err = c.Bind(req)
+	if err != nil {
+		return nil, response.ErrorWrap(response.ErrUnprocessableEntity, err)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return nil, response.ErrorWrap(response.ErrValidation, err)
+	}
+	req.ChangeDefaultSortingClause(DEFAULT_SORT_BY, orderBy)
+	req.Pagination.SetDefault()
+	return
+}
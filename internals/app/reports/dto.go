@@ -0,0 +1,78 @@
+package reports
+
+import (
+	"wakuwaku_nihongo/internals/abstraction"
+)
+
+type ReportRequest struct {
+	Reason  string  `json:"reason" validate:"required,oneof=wrong_answer multiple_answers typo unclear bad_explanation broken_media other"`
+	Comment *string `json:"comment" validate:"required_if=Reason other,omitempty,max=2000"`
+}
+
+type ReportFilter struct {
+	Status     string `query:"status" validate:"omitempty,oneof=open accepted rejected fixed"`
+	Reason     string `query:"reason" validate:"omitempty,oneof=wrong_answer multiple_answers typo unclear bad_explanation broken_media other"`
+	AssignedTo string `query:"assigned_to" validate:"omitempty,uuid"`
+	Unassigned bool   `query:"unassigned"`
+	QuestionID string `query:"question_id" validate:"omitempty,uuid"`
+	JlptLevel  string `query:"jlpt_level" validate:"omitempty,oneof=N5 N4 N3 N2 N1"`
+	Section    string `query:"section" validate:"omitempty,oneof=vocabulary grammar reading listening"`
+	abstraction.Pagination
+}
+
+// AssignRequest assigns a report to an editor, a null AssigneeID
+// unassigns it.
+type AssignRequest struct {
+	AssigneeID *string `json:"assignee_id" validate:"omitempty,uuid"`
+}
+
+type StatusRequest struct {
+	Status string  `json:"status" validate:"required,oneof=accepted rejected fixed"`
+	Note   *string `json:"note" validate:"omitempty,max=2000"`
+}
+
+type ReportResponse struct {
+	ReportID     string  `json:"report_id"`
+	QuestionID   string  `json:"question_id"`
+	QuizID       string  `json:"quiz_id,omitempty"`
+	QuestionText string  `json:"question_text,omitempty"`
+	Section      *string `json:"section,omitempty"`
+	JlptLevel    *string `json:"jlpt_level,omitempty"`
+	CustomerID   string  `json:"customer_id"`
+	Reason       string  `json:"reason"`
+	Comment      *string `json:"comment"`
+	Status       string  `json:"status"`
+	AssignedTo   *string `json:"assigned_to"`
+	AssignedAt   *int64  `json:"assigned_at"`
+	ResolvedAt   *int64  `json:"resolved_at"`
+	ResolvedBy   *string `json:"resolved_by"`
+	EditorNote   *string `json:"editor_note"`
+	CreatedAt    int64   `json:"created_at"`
+	ModifiedAt   *int64  `json:"modified_at"`
+}
+
+func (r *ReportResponse) MapFromModel(m *Report) {
+	r.ReportID = m.ReportID
+	r.QuestionID = m.QuestionID
+	r.CustomerID = m.CustomerID
+	r.Reason = m.Reason
+	r.Comment = m.Comment
+	r.Status = m.Status
+	r.AssignedTo = m.AssignedTo
+	r.AssignedAt = m.AssignedAt
+	r.ResolvedAt = m.ResolvedAt
+	r.ResolvedBy = m.ResolvedBy
+	r.EditorNote = m.EditorNote
+	r.CreatedAt = m.CreatedAt
+	r.ModifiedAt = m.ModifiedAt
+}
+
+// Hide leaves out what the reporter does not see, who the report is
+// assigned to and, until it is resolved, the editor's note.
+func (r *ReportResponse) Hide() {
+	r.AssignedTo = nil
+	r.AssignedAt = nil
+	if r.ResolvedAt == nil {
+		r.EditorNote = nil
+	}
+}
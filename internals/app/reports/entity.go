@@ -0,0 +1,70 @@
+package reports
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Report is an error in a question reported by a learner, which editors
+// triage and resolve. The reporter is notified once it is resolved.
+type Report struct {
+	ReportID   string  `gorm:"column:report_id;type:uuid;primaryKey" json:"report_id"`
+	CreatedAt  int64   `gorm:"column:created_at;type:bigint;not null" json:"created_at"`
+	ModifiedAt *int64  `gorm:"column:modified_at;type:bigint" json:"modified_at"`
+	CreatedBy  string  `gorm:"column:created_by;type:character varying;not null" json:"created_by"`
+	ModifiedBy *string `gorm:"column:modified_by;type:character varying" json:"modified_by"`
+	CustomerID string  `gorm:"column:customer_id;type:uuid;not null" json:"customer_id"`
+	QuestionID string  `gorm:"column:question_id;type:uuid;not null" json:"question_id"`
+	Reason     string  `gorm:"column:reason;type:character varying;not null" json:"reason"`
+	Comment    *string `gorm:"column:comment;type:character varying" json:"comment"`
+	Status     string  `gorm:"column:status;type:character varying;not null" json:"status"`
+	AssignedTo *string `gorm:"column:assigned_to;type:uuid" json:"assigned_to"`
+	AssignedAt *int64  `gorm:"column:assigned_at;type:bigint" json:"assigned_at"`
+	ResolvedAt *int64  `gorm:"column:resolved_at;type:bigint" json:"resolved_at"`
+	ResolvedBy *string `gorm:"column:resolved_by;type:character varying" json:"resolved_by"`
+	// EditorNote is what the editor wrote when they last moved the report,
+	// the reporter sees it once the report is resolved.
+	EditorNote *string `gorm:"column:editor_note;type:character varying" json:"editor_note"`
+}
+
+func (*Report) TableName() string {
+	return "question_reports"
+}
+
+func (m *Report) BeforeCreate(tx *gorm.DB) (err error) {
+	m.CreatedAt = time.Now().UnixMilli()
+	if m.ReportID == "" {
+		m.ReportID = uuid.NewString()
+	}
+	if m.Status == "" {
+		m.Status = STATUS_OPEN
+	}
+	return
+}
+
+// CanMoveTo reports whether the report can move to status, resolved
+// reports are final.
+func (m *Report) CanMoveTo(status string) bool {
+	return slices.Contains(TRANSITIONS[m.Status], status)
+}
+
+// Resolved reports whether an editor rejected or fixed the report.
+func (m *Report) Resolved() bool {
+	return IsResolution(m.Status)
+}
+
+// IsResolution reports whether moving to status resolves a report.
+func IsResolution(status string) bool {
+	return status == STATUS_REJECTED || status == STATUS_FIXED
+}
+
+type reportWithQuestion struct {
+	Report
+	QuestionText string
+	Section      *string
+	QuizID       string
+	JlptLevel    *string
+}
@@ -0,0 +1,167 @@
+package reports
+
+import (
+	"wakuwaku_nihongo/internals/app/notifications"
+	"wakuwaku_nihongo/internals/app/quizzes"
+	"wakuwaku_nihongo/internals/utils/token"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type repo struct {
+	db *gorm.DB
+}
+
+func NewRepo(db *gorm.DB) *repo {
+	return &repo{
+		db: db,
+	}
+}
+
+// QuestionExists reports whether a question learners can see exists, one
+// of a published quiz.
+func (r *repo) QuestionExists(ctx echo.Context, questionID string) (exists bool, err error) {
+	err = r.db.Raw(`SELECT EXISTS (
+		SELECT 1 FROM questions q
+		JOIN quizzes z ON z.quiz_id = q.quiz_id AND z.deleted_at IS NULL AND z.status = ?
+		WHERE q.question_id = ? AND q.deleted_at IS NULL)`, quizzes.QUIZ_STATUS_PUBLISHED, questionID).
+		Scan(&exists).Error
+	return
+}
+
+// IsEditor reports whether a customer is an active editor or admin, who
+// reports can be assigned to.
+func (r *repo) IsEditor(ctx echo.Context, customerID string) (editor bool, err error) {
+	err = r.db.Raw(`SELECT EXISTS (
+		SELECT 1 FROM customers
+		WHERE customer_id = ? AND deleted_at IS NULL AND is_active AND role IN ?)`,
+		customerID, []string{token.ROLE_EDITOR, token.ROLE_ADMIN}).
+		Scan(&editor).Error
+	return
+}
+
+// HasPending reports whether a customer has a report on a question not
+// resolved yet.
+func (r *repo) HasPending(ctx echo.Context, customerID, questionID string) (pending bool, err error) {
+	err = r.db.Raw(`SELECT EXISTS (
+		SELECT 1 FROM question_reports
+		WHERE customer_id = ? AND question_id = ? AND status IN ?)`,
+		customerID, questionID, []string{STATUS_OPEN, STATUS_ACCEPTED}).
+		Scan(&pending).Error
+	return
+}
+
+func (r *repo) Create(ctx echo.Context, in *Report) (err error) {
+	return r.db.Create(in).Error
+}
+
+// List returns the reports matching filter with their questions, those of
+// customerID only unless it is empty.
+func (r *repo) List(ctx echo.Context, customerID string, filter *ReportFilter) (out []*reportWithQuestion, count int64, err error) {
+	db := r.db.Table("question_reports r").
+		Joins("JOIN questions q ON q.question_id = r.question_id").
+		Joins("JOIN quizzes z ON z.quiz_id = q.quiz_id")
+	if customerID != "" {
+		db = db.Where("r.customer_id = ?", customerID)
+	}
+	if filter.Status != "" {
+		db = db.Where("r.status = ?", filter.Status)
+	}
+	if filter.Reason != "" {
+		db = db.Where("r.reason = ?", filter.Reason)
+	}
+	if filter.AssignedTo != "" {
+		db = db.Where("r.assigned_to = ?", filter.AssignedTo)
+	}
+	if filter.Unassigned {
+		db = db.Where("r.assigned_to IS NULL")
+	}
+	if filter.QuestionID != "" {
+		db = db.Where("r.question_id = ?", filter.QuestionID)
+	}
+	if filter.JlptLevel != "" {
+		db = db.Where("z.jlpt_level = ?", filter.JlptLevel)
+	}
+	if filter.Section != "" {
+		db = db.Where("q.section = ?", filter.Section)
+	}
+	db = db.Session(&gorm.Session{})
+
+	if err = db.Count(&count).Error; err != nil {
+		return
+	}
+
+	out = []*reportWithQuestion{}
+	sortBy := *filter.SortBy
+	if !SORTABLE_COLUMNS[sortBy] {
+		sortBy = DEFAULT_SORT_BY
+	}
+	err = db.Select("r.*, q.question_text, q.section, q.quiz_id, z.jlpt_level").
+		Order(clause.OrderByColumn{
+			Column: clause.Column{Table: "r", Name: sortBy},
+			Desc:   filter.GetOrderBy() == "desc",
+		}).
+		Order("r.report_id").
+		Limit(filter.Limit()).
+		Offset(filter.Offset()).
+		Scan(&out).Error
+	return
+}
+
+func (r *repo) Get(ctx echo.Context, reportID string) (out *reportWithQuestion, err error) {
+	out = &reportWithQuestion{}
+	res := r.db.Table("question_reports r").
+		Joins("JOIN questions q ON q.question_id = r.question_id").
+		Joins("JOIN quizzes z ON z.quiz_id = q.quiz_id").
+		Select("r.*, q.question_text, q.section, q.quiz_id, z.jlpt_level").
+		Where("r.report_id = ?", reportID).
+		Scan(out)
+	if res.Error == nil && res.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return out, res.Error
+}
+
+// Assign stores the assignee of a report unless it was resolved meanwhile,
+// assigned is false in that case.
+func (r *repo) Assign(ctx echo.Context, in *Report) (assigned bool, err error) {
+	res := r.db.Model(&Report{}).
+		Where("report_id = ? AND status IN ?", in.ReportID, []string{STATUS_OPEN, STATUS_ACCEPTED}).
+		Updates(map[string]any{
+			"assigned_to": in.AssignedTo,
+			"assigned_at": in.AssignedAt,
+			"modified_at": in.ModifiedAt,
+			"modified_by": in.ModifiedBy,
+		})
+	return res.RowsAffected > 0, res.Error
+}
+
+// Move stores the new status of a report unless it moved from the status
+// from meanwhile, moved is false in that case, and notifies the reporter
+// with notification, unless it is nil, in the same transaction.
+func (r *repo) Move(ctx echo.Context, in *Report, from string, notification *notifications.Notification) (moved bool, err error) {
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&Report{}).
+			Where("report_id = ? AND status = ?", in.ReportID, from).
+			Updates(map[string]any{
+				"status":      in.Status,
+				"editor_note": in.EditorNote,
+				"resolved_at": in.ResolvedAt,
+				"resolved_by": in.ResolvedBy,
+				"modified_at": in.ModifiedAt,
+				"modified_by": in.ModifiedBy,
+			})
+		if res.Error != nil || res.RowsAffected == 0 {
+			return res.Error
+		}
+		moved = true
+
+		if notification == nil {
+			return nil
+		}
+		return notifications.Notify(ctx, tx, notification)
+	})
+	return
+}
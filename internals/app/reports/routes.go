@@ -0,0 +1,24 @@
+package reports
+
+import (
+	"github.com/labstack/echo/v4"
+	"wakuwaku_nihongo/internals/middleware"
+	"wakuwaku_nihongo/internals/utils/token"
+)
+
+func (h *handler) Route(g *echo.Group) {
+	editor := middleware.Authorization(token.ROLE_EDITOR)
+
+	g.GET("", h.List, middleware.Authentication, editor)
+	g.GET("/:id", h.Get, middleware.Authentication, editor)
+	g.PUT("/:id/assign", h.Assign, middleware.Authentication, editor)
+	g.PUT("/:id/status", h.Move, middleware.Authentication, editor)
+}
+
+func (h *handler) ReporterRoute(g *echo.Group) {
+	g.GET("", h.ListMine, middleware.Authentication)
+}
+
+func (h *handler) QuestionRoute(g *echo.Group) {
+	g.POST("/:question_id/reports", h.Create, middleware.Authentication)
+}
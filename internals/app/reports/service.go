@@ -0,0 +1,244 @@
+package reports
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"wakuwaku_nihongo/internals/abstraction"
+	"wakuwaku_nihongo/internals/app/notifications"
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/middleware"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+type IReportRepo interface {
+	QuestionExists(ctx echo.Context, questionID string) (exists bool, err error)
+	IsEditor(ctx echo.Context, customerID string) (editor bool, err error)
+	HasPending(ctx echo.Context, customerID, questionID string) (pending bool, err error)
+	Create(ctx echo.Context, in *Report) (err error)
+	List(ctx echo.Context, customerID string, filter *ReportFilter) (out []*reportWithQuestion, count int64, err error)
+	Get(ctx echo.Context, reportID string) (out *reportWithQuestion, err error)
+	Assign(ctx echo.Context, in *Report) (assigned bool, err error)
+	Move(ctx echo.Context, in *Report, from string, notification *notifications.Notification) (moved bool, err error)
+}
+
+type service struct {
+	repo IReportRepo
+}
+
+func NewService(f *factory.Factory) *service {
+	return &service{
+		repo: NewRepo(f.Db),
+	}
+}
+
+// Create reports an error in a question. A learner has at most one report
+// of a question pending.
+func (s *service) Create(ctx echo.Context, questionID string, in *ReportRequest) (out *ReportResponse, err error) {
+	exists, err := s.repo.QuestionExists(ctx, questionID)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	if !exists {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("question not found"))
+		return
+	}
+
+	userID := middleware.GetUserID(ctx)
+	pending, err := s.repo.HasPending(ctx, userID, questionID)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	if pending {
+		err = response.ErrorWrap(response.ErrBadRequest, fmt.Errorf("you already reported this question"))
+		return
+	}
+
+	report := &Report{
+		CreatedBy:  userID,
+		CustomerID: userID,
+		QuestionID: questionID,
+		Reason:     in.Reason,
+		Comment:    in.Comment,
+		Status:     STATUS_OPEN,
+	}
+	if err = s.repo.Create(ctx, report); err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	out = &ReportResponse{}
+	out.MapFromModel(report)
+	out.Hide()
+	return
+}
+
+// ListMine returns the reports of the logged in customer, without what
+// editors keep to themselves.
+func (s *service) ListMine(ctx echo.Context, filter *ReportFilter) (out []*ReportResponse, info *abstraction.PaginationInfo, err error) {
+	out, info, err = s.list(ctx, middleware.GetUserID(ctx), filter)
+	for _, res := range out {
+		res.Hide()
+	}
+	return
+}
+
+// List returns the queue of reports for editors.
+func (s *service) List(ctx echo.Context, filter *ReportFilter) (out []*ReportResponse, info *abstraction.PaginationInfo, err error) {
+	return s.list(ctx, "", filter)
+}
+
+func (s *service) Get(ctx echo.Context, reportID string) (out *ReportResponse, err error) {
+	report, err := s.get(ctx, reportID)
+	if err != nil {
+		return
+	}
+	return mapJoined(report), nil
+}
+
+// Assign assigns a pending report to an editor or, when in.AssigneeID is
+// nil, unassigns it.
+func (s *service) Assign(ctx echo.Context, reportID string, in *AssignRequest) (out *ReportResponse, err error) {
+	report, err := s.get(ctx, reportID)
+	if err != nil {
+		return
+	}
+	if report.Resolved() {
+		err = response.ErrorWrap(response.ErrBadRequest, fmt.Errorf("report is already resolved"))
+		return
+	}
+	if in.AssigneeID != nil {
+		editor, editorErr := s.repo.IsEditor(ctx, *in.AssigneeID)
+		if editorErr != nil {
+			err = response.ErrorWrap(response.ErrInternalServerError, editorErr)
+			return
+		}
+		if !editor {
+			err = response.ErrorWrap(response.ErrBadRequest, fmt.Errorf("reports can only be assigned to editors"))
+			return
+		}
+	}
+
+	now := time.Now().UnixMilli()
+	userID := middleware.GetUserID(ctx)
+	report.AssignedTo = in.AssigneeID
+	report.AssignedAt = nil
+	if in.AssigneeID != nil {
+		report.AssignedAt = &now
+	}
+	report.ModifiedAt = &now
+	report.ModifiedBy = &userID
+
+	assigned, err := s.repo.Assign(ctx, &report.Report)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	if !assigned {
+		err = response.ErrorWrap(response.ErrBadRequest, fmt.Errorf("report is already resolved"))
+		return
+	}
+	return mapJoined(report), nil
+}
+
+// Move accepts, rejects or fixes a report. Rejecting or fixing resolves it
+// and notifies the reporter.
+func (s *service) Move(ctx echo.Context, reportID string, in *StatusRequest) (out *ReportResponse, err error) {
+	report, err := s.get(ctx, reportID)
+	if err != nil {
+		return
+	}
+	from := report.Status
+	if !report.CanMoveTo(in.Status) {
+		err = response.ErrorWrap(response.ErrBadRequest, fmt.Errorf("a report %s cannot be %s", from, in.Status))
+		return
+	}
+
+	now := time.Now().UnixMilli()
+	userID := middleware.GetUserID(ctx)
+	report.Status = in.Status
+	report.EditorNote = in.Note
+	report.ModifiedAt = &now
+	report.ModifiedBy = &userID
+
+	var notification *notifications.Notification
+	if report.Resolved() {
+		report.ResolvedAt = &now
+		report.ResolvedBy = &userID
+		notification = resolvedNotification(&report.Report)
+	}
+
+	moved, err := s.repo.Move(ctx, &report.Report, from, notification)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	if !moved {
+		err = response.ErrorWrap(response.ErrBadRequest, fmt.Errorf("report was updated meanwhile"))
+		return
+	}
+	return mapJoined(report), nil
+}
+
+func (s *service) list(ctx echo.Context, customerID string, filter *ReportFilter) (out []*ReportResponse, info *abstraction.PaginationInfo, err error) {
+	reports, count, err := s.repo.List(ctx, customerID, filter)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	out = []*ReportResponse{}
+	for _, r := range reports {
+		out = append(out, mapJoined(r))
+	}
+	info = filter.Pagination.CreatePageInfo(count)
+	return
+}
+
+func (s *service) get(ctx echo.Context, reportID string) (out *reportWithQuestion, err error) {
+	out, err = s.repo.Get(ctx, reportID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("report not found"))
+		return
+	}
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	return
+}
+
+// resolvedNotification tells the reporter how their report was resolved.
+func resolvedNotification(report *Report) *notifications.Notification {
+	data := datatypes.JSONMap{
+		"report_id":   report.ReportID,
+		"question_id": report.QuestionID,
+		"reason":      report.Reason,
+		"status":      report.Status,
+	}
+	if report.EditorNote != nil {
+		data["note"] = *report.EditorNote
+	}
+	return &notifications.Notification{
+		CustomerID: report.CustomerID,
+		Type:       notifications.TYPE_REPORT_RESOLVED,
+		SourceID:   report.ReportID,
+		Data:       data,
+	}
+}
+
+func mapJoined(m *reportWithQuestion) *ReportResponse {
+	res := &ReportResponse{
+		QuizID:       m.QuizID,
+		QuestionText: m.QuestionText,
+		Section:      m.Section,
+		JlptLevel:    m.JlptLevel,
+	}
+	res.MapFromModel(&m.Report)
+	return res
+}
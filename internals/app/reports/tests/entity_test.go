@@ -0,0 +1,49 @@
+package tests
+
+import (
+	"testing"
+
+	"wakuwaku_nihongo/internals/app/reports"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanMoveTo(t *testing.T) {
+	open := &reports.Report{Status: reports.STATUS_OPEN}
+	assert.True(t, open.CanMoveTo(reports.STATUS_ACCEPTED))
+	assert.True(t, open.CanMoveTo(reports.STATUS_REJECTED))
+	assert.True(t, open.CanMoveTo(reports.STATUS_FIXED))
+	assert.False(t, open.CanMoveTo(reports.STATUS_OPEN))
+
+	accepted := &reports.Report{Status: reports.STATUS_ACCEPTED}
+	assert.True(t, accepted.CanMoveTo(reports.STATUS_FIXED))
+	assert.False(t, accepted.CanMoveTo(reports.STATUS_ACCEPTED))
+
+	for _, status := range []string{reports.STATUS_REJECTED, reports.STATUS_FIXED} {
+		resolved := &reports.Report{Status: status}
+		assert.True(t, resolved.Resolved())
+		assert.False(t, resolved.CanMoveTo(reports.STATUS_ACCEPTED))
+		assert.False(t, resolved.CanMoveTo(reports.STATUS_FIXED))
+	}
+	assert.False(t, accepted.Resolved())
+}
+
+func TestHide(t *testing.T) {
+	editor, note, at := "editor", "checking with the author", int64(1000)
+	report := &reports.Report{Status: reports.STATUS_ACCEPTED, AssignedTo: &editor, AssignedAt: &at, EditorNote: &note}
+
+	res := &reports.ReportResponse{}
+	res.MapFromModel(report)
+	res.Hide()
+	assert.Nil(t, res.AssignedTo)
+	assert.Nil(t, res.AssignedAt)
+	assert.Nil(t, res.EditorNote)
+
+	report.Status = reports.STATUS_FIXED
+	report.ResolvedAt = &at
+	res = &reports.ReportResponse{}
+	res.MapFromModel(report)
+	res.Hide()
+	assert.Nil(t, res.AssignedTo)
+	assert.Equal(t, &note, res.EditorNote)
+}
@@ -0,0 +1,24 @@
+package revisions
+
+const (
+	// a revision is recorded when a question is created, updated or rolled
+	// back to an earlier revision.
+	ACTION_CREATE   = "create"
+	ACTION_UPDATE   = "update"
+	ACTION_ROLLBACK = "rollback"
+
+	DEFAULT_SORT_BY = "number"
+)
+
+// the fields a diff reports changes of, an answer change carries its id.
+const (
+	FIELD_QUESTION_TEXT  = "question_text"
+	FIELD_QUESTION_TYPE  = "question_type"
+	FIELD_GRADING_MODE   = "grading_mode"
+	FIELD_PASSAGE_ID     = "passage_id"
+	FIELD_SECTION        = "section"
+	FIELD_ANSWER_ADDED   = "answer_added"
+	FIELD_ANSWER_REMOVED = "answer_removed"
+	FIELD_ANSWER_TEXT    = "answer_text"
+	FIELD_IS_CORRECT     = "is_correct"
+)
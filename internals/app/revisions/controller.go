@@ -0,0 +1,152 @@
+package revisions
+
+import (
+	"fmt"
+	"strconv"
+
+	"wakuwaku_nihongo/internals/abstraction"
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+type IRevisionService interface {
+	List(ctx echo.Context, questionID string, p *abstraction.Pagination) (out []*RevisionResponse, info *abstraction.PaginationInfo, err error)
+	Get(ctx echo.Context, questionID string, number int) (out *RevisionResponse, err error)
+	Diff(ctx echo.Context, questionID string, in *DiffFilter) (out *DiffResponse, err error)
+	Rollback(ctx echo.Context, questionID string, number int) (out *RevisionResponse, err error)
+}
+
+type handler struct {
+	service IRevisionService
+}
+
+func NewHandler(f *factory.Factory) *handler {
+	return &handler{
+		service: NewService(f),
+	}
+}
+
+// @Summary List Question Revisions
+// @Description List the revisions of a question (editor only), the latest first. A revision is recorded on every change to the question or its answers
+// @Tags revisions
+// @Produce json
+// @Param question_id path string true "Question ID"
+// @Param page query int false "Page"
+// @Param page_size query int false "Page size"
+// @Param order_by query string false "Order" Enums(asc, desc)
+// @Success 200 {object} response.SuccessResponseWithInfo{data=[]RevisionResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/questions/{question_id}/revisions [get]
+func (h *handler) List(c echo.Context) error {
+	req := &abstraction.Pagination{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+	req.ChangeDefaultSortingClause(DEFAULT_SORT_BY, nil)
+	req.SetDefault()
+
+	res, info, err := h.service.List(c, c.Param("question_id"), req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponseInfo(res, info).Send(c)
+}
+
+// @Summary Get Question Revision
+// @Description Get a revision of a question with its snapshot (editor only)
+// @Tags revisions
+// @Produce json
+// @Param question_id path string true "Question ID"
+// @Param number path int true "Revision number"
+// @Success 200 {object} response.Success{data=RevisionResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/questions/{question_id}/revisions/{number} [get]
+func (h *handler) Get(c echo.Context) error {
+	number, err := parseNumber(c)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+
+	res, err := h.service.Get(c, c.Param("question_id"), number)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Diff Question Revisions
+// @Description List the changes between two revisions of a question (editor only), in either order
+// @Tags revisions
+// @Produce json
+// @Param question_id path string true "Question ID"
+// @Param from query int true "Revision number to diff from"
+// @Param to query int true "Revision number to diff to"
+// @Success 200 {object} response.Success{data=DiffResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/questions/{question_id}/revisions/diff [get]
+func (h *handler) Diff(c echo.Context) error {
+	req := &DiffFilter{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.Diff(c, c.Param("question_id"), req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Roll Back Question
+// @Description Restore a question of a draft quiz and its answers to a revision (editor only). The rollback is recorded as a new revision, answers added since are removed and past attempts keep the revision they were graded against
+// @Tags revisions
+// @Produce json
+// @Param question_id path string true "Question ID"
+// @Param number path int true "Revision number"
+// @Success 200 {object} response.Success{data=RevisionResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/questions/{question_id}/revisions/{number}/rollback [post]
+func (h *handler) Rollback(c echo.Context) error {
+	number, err := parseNumber(c)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+
+	res, err := h.service.Rollback(c, c.Param("question_id"), number)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+func parseNumber(c echo.Context) (number int, err error) {
+	number, err = strconv.Atoi(c.Param("number"))
+	if err != nil || number < 1 {
+		return 0, response.ErrorWrap(response.ErrValidation, fmt.Errorf("revision number must be a positive integer"))
+	}
+	return
+}
@@ -0,0 +1,69 @@
+package revisions
+
+// Change is a field that differs between two snapshots, AnswerID is set
+// for a change of an answer. From is nil for an added answer and To for a
+// removed one.
+type Change struct {
+	Field    string  `json:"field"`
+	AnswerID *string `json:"answer_id,omitempty"`
+	From     any     `json:"from"`
+	To       any     `json:"to"`
+}
+
+// Diff lists the changes from one snapshot to another, question fields
+// first and then the answers in the order they appear.
+func Diff(from, to Snapshot) []*Change {
+	out := []*Change{}
+	add := func(field string, answerID *string, a, b any) {
+		out = append(out, &Change{Field: field, AnswerID: answerID, From: a, To: b})
+	}
+
+	if from.QuestionText != to.QuestionText {
+		add(FIELD_QUESTION_TEXT, nil, from.QuestionText, to.QuestionText)
+	}
+	if !equal(from.QuestionType, to.QuestionType) {
+		add(FIELD_QUESTION_TYPE, nil, from.QuestionType, to.QuestionType)
+	}
+	if from.GradingMode != to.GradingMode {
+		add(FIELD_GRADING_MODE, nil, from.GradingMode, to.GradingMode)
+	}
+	if !equal(from.PassageID, to.PassageID) {
+		add(FIELD_PASSAGE_ID, nil, from.PassageID, to.PassageID)
+	}
+	if !equal(from.Section, to.Section) {
+		add(FIELD_SECTION, nil, from.Section, to.Section)
+	}
+
+	after := map[string]*SnapshotAnswer{}
+	for _, a := range to.Answers {
+		after[a.AnswerID] = a
+	}
+	before := map[string]bool{}
+	for _, a := range from.Answers {
+		before[a.AnswerID] = true
+		b, ok := after[a.AnswerID]
+		if !ok {
+			add(FIELD_ANSWER_REMOVED, &a.AnswerID, a, nil)
+			continue
+		}
+		if a.AnswerText != b.AnswerText {
+			add(FIELD_ANSWER_TEXT, &a.AnswerID, a.AnswerText, b.AnswerText)
+		}
+		if a.IsCorrect != b.IsCorrect {
+			add(FIELD_IS_CORRECT, &a.AnswerID, a.IsCorrect, b.IsCorrect)
+		}
+	}
+	for _, b := range to.Answers {
+		if !before[b.AnswerID] {
+			add(FIELD_ANSWER_ADDED, &b.AnswerID, nil, b)
+		}
+	}
+	return out
+}
+
+func equal(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,35 @@
+package revisions
+
+type DiffFilter struct {
+	From int `query:"from" validate:"required,min=1"`
+	To   int `query:"to" validate:"required,min=1"`
+}
+
+type RevisionResponse struct {
+	RevisionID   string   `json:"revision_id"`
+	QuestionID   string   `json:"question_id"`
+	Number       int      `json:"number"`
+	Action       string   `json:"action"`
+	RestoredFrom *int     `json:"restored_from"`
+	Snapshot     Snapshot `json:"snapshot"`
+	CreatedAt    int64    `json:"created_at"`
+	CreatedBy    string   `json:"created_by"`
+}
+
+func (r *RevisionResponse) MapFromModel(m *Revision) {
+	r.RevisionID = m.RevisionID
+	r.QuestionID = m.QuestionID
+	r.Number = m.Number
+	r.Action = m.Action
+	r.RestoredFrom = m.RestoredFrom
+	r.Snapshot = m.Snapshot.Data()
+	r.CreatedAt = m.CreatedAt
+	r.CreatedBy = m.CreatedBy
+}
+
+type DiffResponse struct {
+	QuestionID string    `json:"question_id"`
+	From       int       `json:"from"`
+	To         int       `json:"to"`
+	Changes    []*Change `json:"changes"`
+}
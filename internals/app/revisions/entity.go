@@ -0,0 +1,97 @@
+package revisions
+
+import (
+	"time"
+
+	"wakuwaku_nihongo/internals/model"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// Revision is an immutable snapshot of a question and its answers taken
+// after each change, numbered from 1 per question. Graded attempt answers
+// point at the revision they were graded against.
+type Revision struct {
+	RevisionID string `gorm:"column:revision_id;type:uuid;primaryKey" json:"revision_id"`
+	CreatedAt  int64  `gorm:"column:created_at;type:bigint;not null" json:"created_at"`
+	CreatedBy  string `gorm:"column:created_by;type:character varying;not null" json:"created_by"`
+	QuestionID string `gorm:"column:question_id;type:uuid;not null" json:"question_id"`
+	Number     int    `gorm:"column:number;type:integer;not null" json:"number"`
+	Action     string `gorm:"column:action;type:character varying;not null" json:"action"`
+	// RestoredFrom is the number of the revision a rollback restored.
+	RestoredFrom *int                         `gorm:"column:restored_from;type:integer" json:"restored_from"`
+	Snapshot     datatypes.JSONType[Snapshot] `gorm:"column:snapshot;type:jsonb;not null" json:"snapshot"`
+}
+
+func (*Revision) TableName() string {
+	return "question_revisions"
+}
+
+func (m *Revision) BeforeCreate(tx *gorm.DB) (err error) {
+	m.CreatedAt = time.Now().UnixMilli()
+	if m.RevisionID == "" {
+		m.RevisionID = uuid.NewString()
+	}
+	return
+}
+
+// Snapshot is the content of a question and its answers.
+type Snapshot struct {
+	QuizID       string            `json:"quiz_id"`
+	QuestionText string            `json:"question_text"`
+	QuestionType *string           `json:"question_type"`
+	GradingMode  string            `json:"grading_mode"`
+	PassageID    *string           `json:"passage_id"`
+	Section      *string           `json:"section"`
+	Answers      []*SnapshotAnswer `json:"answers"`
+}
+
+type SnapshotAnswer struct {
+	AnswerID   string `json:"answer_id"`
+	AnswerText string `json:"answer_text"`
+	IsCorrect  bool   `json:"is_correct"`
+}
+
+// SnapshotOf takes the snapshot of a question with its answers.
+func SnapshotOf(q *model.Question) Snapshot {
+	out := Snapshot{
+		QuizID:       q.QuizID,
+		QuestionText: q.QuestionText,
+		QuestionType: q.QuestionType,
+		GradingMode:  q.GradingMode,
+		PassageID:    q.PassageID,
+		Section:      q.Section,
+		Answers:      []*SnapshotAnswer{},
+	}
+	for _, a := range q.Answers {
+		out.Answers = append(out.Answers, &SnapshotAnswer{
+			AnswerID:   a.AnswerID,
+			AnswerText: a.AnswerText,
+			IsCorrect:  a.IsCorrect,
+		})
+	}
+	return out
+}
+
+// Apply returns a copy of q with the content of the snapshot, so a past
+// attempt shows the question as it was graded.
+func (s Snapshot) Apply(q *model.Question) *model.Question {
+	out := *q
+	out.QuestionText = s.QuestionText
+	out.QuestionType = s.QuestionType
+	out.GradingMode = s.GradingMode
+	out.PassageID = s.PassageID
+	out.Section = s.Section
+	out.Answers = []*model.Answer{}
+	for _, a := range s.Answers {
+		out.Answers = append(out.Answers, &model.Answer{
+			AnswerID:   a.AnswerID,
+			QuestionID: q.QuestionID,
+			AnswerText: a.AnswerText,
+			IsCorrect:  a.IsCorrect,
+		})
+	}
+	return &out
+}
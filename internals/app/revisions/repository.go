@@ -0,0 +1,182 @@
+package revisions
+
+import (
+	"time"
+
+	"wakuwaku_nihongo/internals/abstraction"
+	"wakuwaku_nihongo/internals/model"
+	"wakuwaku_nihongo/internals/pkg/softdelete"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type repo struct {
+	db *gorm.DB
+}
+
+func NewRepo(db *gorm.DB) *repo {
+	return &repo{
+		db: db,
+	}
+}
+
+// GetQuestion returns a question with its answers, locking it so revisions
+// of a question are numbered one at a time.
+func (r *repo) GetQuestion(ctx echo.Context, questionID string) (out *model.Question, err error) {
+	out = &model.Question{}
+	err = r.db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("question_id = ?", questionID).
+		Preload("Answers", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at, answer_id")
+		}).
+		First(out).Error
+	return
+}
+
+// GetQuizStatus returns the status of the quiz of a question.
+func (r *repo) GetQuizStatus(ctx echo.Context, questionID string) (status string, err error) {
+	var statuses []string
+	err = r.db.Table("questions q").
+		Joins("JOIN quizzes z ON z.quiz_id = q.quiz_id").
+		Where("q.question_id = ? AND q.deleted_at IS NULL", questionID).
+		Pluck("z.status", &statuses).Error
+	if err != nil {
+		return
+	}
+	if len(statuses) == 0 {
+		return "", gorm.ErrRecordNotFound
+	}
+	return statuses[0], nil
+}
+
+// Create numbers in after the latest revision of its question and stores
+// it. The question must be locked.
+func (r *repo) Create(ctx echo.Context, in *Revision) (err error) {
+	err = r.db.Model(&Revision{}).
+		Select("COALESCE(MAX(number), 0) + 1").
+		Where("question_id = ?", in.QuestionID).
+		Scan(&in.Number).Error
+	if err != nil {
+		return
+	}
+	return r.db.Create(in).Error
+}
+
+func (r *repo) List(ctx echo.Context, questionID string, p *abstraction.Pagination) (out []*Revision, count int64, err error) {
+	db := r.db.Model(&Revision{}).Where("question_id = ?", questionID).Session(&gorm.Session{})
+	if err = db.Count(&count).Error; err != nil {
+		return
+	}
+
+	out = []*Revision{}
+	err = db.Order(clause.OrderByColumn{
+		Column: clause.Column{Name: "number"},
+		Desc:   p.GetOrderBy() == "desc",
+	}).
+		Limit(p.Limit()).
+		Offset(p.Offset()).
+		Find(&out).Error
+	return
+}
+
+func (r *repo) Get(ctx echo.Context, questionID string, number int) (out *Revision, err error) {
+	out = &Revision{}
+	err = r.db.Where("question_id = ? AND number = ?", questionID, number).First(out).Error
+	return
+}
+
+func (r *repo) GetLatest(ctx echo.Context, questionID string) (out *Revision, err error) {
+	out = &Revision{}
+	err = r.db.Where("question_id = ?", questionID).Order("number DESC").First(out).Error
+	return
+}
+
+// GetByIDs returns revisions by their ids.
+func (r *repo) GetByIDs(ctx echo.Context, ids []string) (out []*Revision, err error) {
+	out = []*Revision{}
+	if len(ids) == 0 {
+		return
+	}
+	err = r.db.Where("revision_id IN ?", ids).Find(&out).Error
+	return
+}
+
+// GetLatestIDs returns the id of the latest revision of each question.
+func (r *repo) GetLatestIDs(ctx echo.Context, questionIDs []string) (out map[string]string, err error) {
+	out = map[string]string{}
+	if len(questionIDs) == 0 {
+		return
+	}
+	latest := []*Revision{}
+	err = r.db.Raw(`SELECT DISTINCT ON (question_id) question_id, revision_id
+		FROM question_revisions WHERE question_id IN ?
+		ORDER BY question_id, number DESC`, questionIDs).
+		Scan(&latest).Error
+	for _, rev := range latest {
+		out[rev.QuestionID] = rev.RevisionID
+	}
+	return
+}
+
+// Restore brings the question and its answers back to a snapshot, removing
+// the answers added since, and records the rollback.
+func (r *repo) Restore(ctx echo.Context, snapshot Snapshot, in *Revision) (err error) {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		txRepo := NewRepo(tx)
+		question, err := txRepo.GetQuestion(ctx, in.QuestionID)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now().UnixMilli()
+		err = tx.Model(&model.Question{}).
+			Where("question_id = ?", in.QuestionID).
+			Updates(map[string]any{
+				"question_text": snapshot.QuestionText,
+				"question_type": snapshot.QuestionType,
+				"grading_mode":  snapshot.GradingMode,
+				"passage_id":    snapshot.PassageID,
+				"section":       snapshot.Section,
+				"modified_at":   now,
+				"modified_by":   in.CreatedBy,
+			}).Error
+		if err != nil {
+			return err
+		}
+
+		kept := map[string]bool{}
+		for _, a := range snapshot.Answers {
+			kept[a.AnswerID] = true
+			// unscoped, the answers deleted since the revision come back
+			err := tx.Unscoped().Model(&model.Answer{}).
+				Where("answer_id = ? AND question_id = ?", a.AnswerID, in.QuestionID).
+				Updates(map[string]any{
+					"answer_text": a.AnswerText,
+					"is_correct":  a.IsCorrect,
+					"deleted_at":  nil,
+					"deleted_by":  nil,
+					"modified_at": now,
+					"modified_by": in.CreatedBy,
+				}).Error
+			if err != nil {
+				return err
+			}
+		}
+		for _, a := range question.Answers {
+			if kept[a.AnswerID] {
+				continue
+			}
+			err := softdelete.By(tx, in.CreatedBy).
+				Where("answer_id = ?", a.AnswerID).
+				Delete(&model.Answer{}).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err = Record(ctx, tx, in)
+		return err
+	})
+}
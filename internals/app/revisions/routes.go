@@ -0,0 +1,16 @@
+package revisions
+
+import (
+	"github.com/labstack/echo/v4"
+	"wakuwaku_nihongo/internals/middleware"
+	"wakuwaku_nihongo/internals/utils/token"
+)
+
+func (h *handler) QuestionRoute(g *echo.Group) {
+	editor := middleware.Authorization(token.ROLE_EDITOR)
+
+	g.GET("/:question_id/revisions", h.List, middleware.Authentication, editor)
+	g.GET("/:question_id/revisions/diff", h.Diff, middleware.Authentication, editor)
+	g.GET("/:question_id/revisions/:number", h.Get, middleware.Authentication, editor)
+	g.POST("/:question_id/revisions/:number/rollback", h.Rollback, middleware.Authentication, editor)
+}
@@ -0,0 +1,190 @@
+package revisions
+
+import (
+	"errors"
+	"fmt"
+
+	"wakuwaku_nihongo/internals/abstraction"
+	"wakuwaku_nihongo/internals/app/search"
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/middleware"
+	"wakuwaku_nihongo/internals/model"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+type IRevisionRepo interface {
+	GetQuizStatus(ctx echo.Context, questionID string) (status string, err error)
+	List(ctx echo.Context, questionID string, p *abstraction.Pagination) (out []*Revision, count int64, err error)
+	Get(ctx echo.Context, questionID string, number int) (out *Revision, err error)
+	GetLatest(ctx echo.Context, questionID string) (out *Revision, err error)
+	GetByIDs(ctx echo.Context, ids []string) (out []*Revision, err error)
+	GetLatestIDs(ctx echo.Context, questionIDs []string) (out map[string]string, err error)
+	Restore(ctx echo.Context, snapshot Snapshot, in *Revision) (err error)
+}
+
+type ISearchIndexer interface {
+	IndexQuestion(ctx echo.Context, questionID string) (err error)
+}
+
+type service struct {
+	repo   IRevisionRepo
+	search ISearchIndexer
+}
+
+func NewService(f *factory.Factory) *service {
+	return &service{
+		repo:   NewRepo(f.Db),
+		search: search.NewService(f),
+	}
+}
+
+// Record stores the next revision of the question of in with a snapshot of
+// it, locking the question until db commits so revisions are numbered in
+// order.
+func Record(ctx echo.Context, db *gorm.DB, in *Revision) (out *Revision, err error) {
+	r := NewRepo(db)
+	question, err := r.GetQuestion(ctx, in.QuestionID)
+	if err != nil {
+		return
+	}
+	in.Snapshot = datatypes.NewJSONType(SnapshotOf(question))
+	if err = r.Create(ctx, in); err != nil {
+		return
+	}
+	return in, nil
+}
+
+func (s *service) List(ctx echo.Context, questionID string, p *abstraction.Pagination) (out []*RevisionResponse, info *abstraction.PaginationInfo, err error) {
+	revisions, count, err := s.repo.List(ctx, questionID, p)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	out = []*RevisionResponse{}
+	for _, rev := range revisions {
+		res := &RevisionResponse{}
+		res.MapFromModel(rev)
+		out = append(out, res)
+	}
+	info = p.CreatePageInfo(count)
+	return
+}
+
+func (s *service) Get(ctx echo.Context, questionID string, number int) (out *RevisionResponse, err error) {
+	revision, err := s.get(ctx, questionID, number)
+	if err != nil {
+		return
+	}
+	out = &RevisionResponse{}
+	out.MapFromModel(revision)
+	return
+}
+
+// Diff lists the changes from one revision of a question to another.
+func (s *service) Diff(ctx echo.Context, questionID string, in *DiffFilter) (out *DiffResponse, err error) {
+	from, err := s.get(ctx, questionID, in.From)
+	if err != nil {
+		return
+	}
+	to, err := s.get(ctx, questionID, in.To)
+	if err != nil {
+		return
+	}
+	return &DiffResponse{
+		QuestionID: questionID,
+		From:       from.Number,
+		To:         to.Number,
+		Changes:    Diff(from.Snapshot.Data(), to.Snapshot.Data()),
+	}, nil
+}
+
+// Rollback restores a question to one of its revisions as a new revision,
+// so the history is kept and attempts graded since still point at theirs.
+func (s *service) Rollback(ctx echo.Context, questionID string, number int) (out *RevisionResponse, err error) {
+	target, err := s.get(ctx, questionID, number)
+	if err != nil {
+		return
+	}
+	status, err := s.repo.GetQuizStatus(ctx, questionID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("question not found"))
+		return
+	}
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	if status != model.QUIZ_STATUS_DRAFT {
+		err = response.ErrorWrap(response.ErrBadRequest, fmt.Errorf("only questions of draft quizzes can be rolled back, withdraw the quiz first"))
+		return
+	}
+	latest, err := s.repo.GetLatest(ctx, questionID)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	if len(Diff(latest.Snapshot.Data(), target.Snapshot.Data())) == 0 {
+		err = response.ErrorWrap(response.ErrBadRequest, fmt.Errorf("question already matches revision %d", number))
+		return
+	}
+
+	revision := &Revision{
+		CreatedBy:    middleware.GetUserID(ctx),
+		QuestionID:   questionID,
+		Action:       ACTION_ROLLBACK,
+		RestoredFrom: &target.Number,
+	}
+	err = s.repo.Restore(ctx, target.Snapshot.Data(), revision)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("question not found"))
+		return
+	}
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	if indexErr := s.search.IndexQuestion(ctx, questionID); indexErr != nil {
+		log.Error().Err(indexErr).Str("question_id", questionID).Msg("error indexing question")
+	}
+
+	out = &RevisionResponse{}
+	out.MapFromModel(revision)
+	return
+}
+
+// LatestIDs returns the id of the latest revision of each question, the
+// one an answer graded now is graded against.
+func (s *service) LatestIDs(ctx echo.Context, questionIDs []string) (out map[string]string, err error) {
+	return s.repo.GetLatestIDs(ctx, questionIDs)
+}
+
+// Snapshots returns the snapshots of revisions by their ids.
+func (s *service) Snapshots(ctx echo.Context, revisionIDs []string) (out map[string]Snapshot, err error) {
+	revisions, err := s.repo.GetByIDs(ctx, revisionIDs)
+	if err != nil {
+		return
+	}
+	out = map[string]Snapshot{}
+	for _, rev := range revisions {
+		out[rev.RevisionID] = rev.Snapshot.Data()
+	}
+	return
+}
+
+func (s *service) get(ctx echo.Context, questionID string, number int) (out *Revision, err error) {
+	out, err = s.repo.Get(ctx, questionID, number)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("revision %d not found", number))
+		return
+	}
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	return
+}
@@ -0,0 +1,80 @@
+package tests
+
+import (
+	"testing"
+
+	"wakuwaku_nihongo/internals/app/revisions"
+	"wakuwaku_nihongo/internals/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func question() *model.Question {
+	section := "vocabulary"
+	return &model.Question{
+		QuestionID:   "q",
+		QuizID:       "quiz",
+		QuestionText: "猫[ねこ]",
+		GradingMode:  "standard",
+		Section:      &section,
+		Answers: []*model.Answer{
+			{AnswerID: "a", AnswerText: "cat", IsCorrect: true},
+			{AnswerID: "b", AnswerText: "dog"},
+		},
+	}
+}
+
+func TestDiff(t *testing.T) {
+	from := revisions.SnapshotOf(question())
+
+	edited := question()
+	typed := "typed"
+	edited.QuestionText = "犬[いぬ]"
+	edited.QuestionType = &typed
+	edited.Section = nil
+	edited.Answers[0].IsCorrect = false
+	edited.Answers[1].AnswerText = "dog!"
+	edited.Answers[1].IsCorrect = true
+	edited.Answers = append(edited.Answers[1:], &model.Answer{AnswerID: "c", AnswerText: "bird"})
+	to := revisions.SnapshotOf(edited)
+
+	changes := revisions.Diff(from, to)
+	fields := []string{}
+	for _, c := range changes {
+		fields = append(fields, c.Field)
+	}
+	assert.Equal(t, []string{
+		revisions.FIELD_QUESTION_TEXT,
+		revisions.FIELD_QUESTION_TYPE,
+		revisions.FIELD_SECTION,
+		revisions.FIELD_ANSWER_REMOVED,
+		revisions.FIELD_ANSWER_TEXT,
+		revisions.FIELD_IS_CORRECT,
+		revisions.FIELD_ANSWER_ADDED,
+	}, fields)
+	assert.Equal(t, "猫[ねこ]", changes[0].From)
+	assert.Equal(t, "犬[いぬ]", changes[0].To)
+	assert.Equal(t, "a", *changes[3].AnswerID)
+	assert.Nil(t, changes[3].To)
+	assert.Equal(t, "c", *changes[6].AnswerID)
+	assert.Nil(t, changes[6].From)
+
+	assert.Empty(t, revisions.Diff(from, revisions.SnapshotOf(question())))
+}
+
+func TestSnapshotApply(t *testing.T) {
+	snapshot := revisions.SnapshotOf(question())
+
+	current := question()
+	current.QuestionText = "犬[いぬ]"
+	current.Answers = current.Answers[:1]
+	current.Answers[0].IsCorrect = false
+
+	graded := snapshot.Apply(current)
+	assert.Equal(t, "猫[ねこ]", graded.QuestionText)
+	assert.Len(t, graded.Answers, 2)
+	assert.True(t, graded.Answers[0].IsCorrect)
+	assert.Equal(t, "q", graded.Answers[1].QuestionID)
+	// the current question is left as it is
+	assert.Equal(t, "犬[いぬ]", current.QuestionText)
+}
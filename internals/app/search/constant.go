@@ -0,0 +1,12 @@
+package search
+
+const (
+	// Separates the question and answer texts in a document so that no bigram
+	// spans two of them.
+	DOCUMENT_SEPARATOR = "\n"
+
+	HIGHLIGHT_OPEN  = "<mark>"
+	HIGHLIGHT_CLOSE = "</mark>"
+
+	REINDEX_BATCH_SIZE = 200
+)
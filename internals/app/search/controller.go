@@ -0,0 +1,75 @@
+package search
+
+import (
+	"wakuwaku_nihongo/internals/abstraction"
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ISearchService interface {
+	Search(ctx echo.Context, filter *SearchFilter) (out []*SearchResultResponse, info *abstraction.PaginationInfo, err error)
+	Reindex(ctx echo.Context) (out *ReindexResponse, err error)
+}
+
+type handler struct {
+	service ISearchService
+}
+
+func NewHandler(f *factory.Factory) *handler {
+	return &handler{
+		service: NewService(f),
+	}
+}
+
+// @Summary Search Questions
+// @Description Search question and answer text and their explanations (editor only). Works without spaces between Japanese words, romaji queries are converted to kana. Matches are wrapped in <mark> in the highlight fields
+// @Tags question
+// @Produce json
+// @Param q query string true "Search query"
+// @Param quiz_id query string false "Quiz ID"
+// @Param page query int false "Page"
+// @Param page_size query int false "Page size"
+// @Success 200 {object} response.SuccessResponseWithInfo{data=[]SearchResultResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/questions/search [get]
+func (h *handler) Search(c echo.Context) error {
+	req := &SearchFilter{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+	req.Pagination.SetDefault()
+
+	res, info, err := h.service.Search(c, req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponseInfo(res, info).Send(c)
+}
+
+// @Summary Reindex Questions
+// @Description Rebuild the search documents of every question (admin only)
+// @Tags question
+// @Produce json
+// @Success 200 {object} response.Success{data=ReindexResponse}
+// @Failure 403 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/questions/search/reindex [post]
+func (h *handler) Reindex(c echo.Context) error {
+	res, err := h.service.Reindex(c)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
@@ -0,0 +1,78 @@
+package search
+
+import (
+	"wakuwaku_nihongo/internals/abstraction"
+	"wakuwaku_nihongo/internals/model"
+	"wakuwaku_nihongo/internals/pkg/ruby"
+)
+
+type SearchFilter struct {
+	Query  string `query:"q" validate:"required"`
+	QuizID string `query:"quiz_id" validate:"omitempty,uuid"`
+	abstraction.Pagination
+}
+
+// SearchHit is a ranked row of the search query.
+type SearchHit struct {
+	QuestionID string  `gorm:"column:question_id"`
+	Score      float64 `gorm:"column:score"`
+}
+
+type SearchResultResponse struct {
+	QuestionID   string                  `json:"question_id"`
+	QuizID       string                  `json:"quiz_id"`
+	QuestionType *string                 `json:"question_type"`
+	QuestionText string                  `json:"question_text"`
+	Highlight    string                  `json:"highlight"`
+	Score        float64                 `json:"score"`
+	Answers      []*AnswerResultResponse `json:"answers"`
+}
+
+type AnswerResultResponse struct {
+	AnswerID   string `json:"answer_id"`
+	AnswerText string `json:"answer_text"`
+	Highlight  string `json:"highlight"`
+	IsCorrect  bool   `json:"is_correct"`
+	Matched    bool   `json:"matched"`
+}
+
+type ReindexResponse struct {
+	Indexed int `json:"indexed"`
+}
+
+// MapFromModel fills the result highlighting query, already normalized, in
+// the question and answer texts. Furigana is stripped from the texts; when
+// the query only matches a reading, the reading is highlighted instead.
+func (r *SearchResultResponse) MapFromModel(m *model.Question, score float64, query string) {
+	r.QuestionID = m.QuestionID
+	r.QuizID = m.QuizID
+	r.QuestionType = m.QuestionType
+	r.QuestionText = ruby.Strip(m.QuestionText)
+	r.Highlight, _ = highlightMarkup(m.QuestionText, query)
+	r.Score = score
+	r.Answers = []*AnswerResultResponse{}
+	for _, a := range m.Answers {
+		highlight, matched := highlightMarkup(a.AnswerText, query)
+		r.Answers = append(r.Answers, &AnswerResultResponse{
+			AnswerID:   a.AnswerID,
+			AnswerText: ruby.Strip(a.AnswerText),
+			Highlight:  highlight,
+			IsCorrect:  a.IsCorrect,
+			Matched:    matched,
+		})
+	}
+}
+
+func highlightMarkup(markup string, query string) (string, bool) {
+	segs, err := ruby.Parse(markup)
+	if err != nil {
+		return Highlight(markup, query)
+	}
+	out, found := Highlight(ruby.Text(segs), query)
+	if !found {
+		if reading, ok := Highlight(ruby.Reading(segs), query); ok {
+			return reading, true
+		}
+	}
+	return out, found
+}
@@ -0,0 +1,80 @@
+package search
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+)
+
+type QuestionSearchDocument struct {
+	QuestionID string    `gorm:"column:question_id;type:uuid;primaryKey" json:"question_id"`
+	Content    string    `gorm:"column:content;type:text;not null" json:"content"`
+	Bigrams    TextArray `gorm:"column:bigrams;type:text[];not null" json:"bigrams"`
+	ModifiedAt int64     `gorm:"column:modified_at;type:bigint;not null" json:"modified_at"`
+}
+
+func (*QuestionSearchDocument) TableName() string {
+	return "question_search_documents"
+}
+
+// TextArray maps a Postgres text[] column.
+type TextArray []string
+
+func (a TextArray) Value() (driver.Value, error) {
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, s := range a {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteByte('"')
+		b.WriteString(strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s))
+		b.WriteByte('"')
+	}
+	b.WriteByte('}')
+	return b.String(), nil
+}
+
+func (a *TextArray) Scan(src any) error {
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	case nil:
+		*a = nil
+		return nil
+	default:
+		return fmt.Errorf("cannot scan %T into TextArray", src)
+	}
+
+	s = strings.TrimSuffix(strings.TrimPrefix(s, "{"), "}")
+	out := TextArray{}
+	var cur strings.Builder
+	quoted, escaped, hasValue := false, false, false
+	for _, r := range s {
+		switch {
+		case escaped:
+			cur.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '"':
+			quoted = !quoted
+			hasValue = true
+		case r == ',' && !quoted:
+			out = append(out, cur.String())
+			cur.Reset()
+			hasValue = false
+		default:
+			cur.WriteRune(r)
+			hasValue = true
+		}
+	}
+	if hasValue || len(out) > 0 {
+		out = append(out, cur.String())
+	}
+	*a = out
+	return nil
+}
@@ -0,0 +1,76 @@
+package search
+
+import (
+	"html"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/width"
+
+	"wakuwaku_nihongo/internals/pkg/kana"
+)
+
+// Highlight escapes text and wraps every occurrence of the normalized query
+// in <mark>. Matching folds width, case and katakana and skips whitespace and
+// punctuation like the document normalization does. The second result
+// reports whether anything was highlighted.
+func Highlight(text string, query string) (string, bool) {
+	needle := []rune(query)
+	rs := []rune(text)
+	if len(needle) == 0 {
+		return html.EscapeString(text), false
+	}
+
+	// folded keeps the searchable runes of text with their original index.
+	type foldedRune struct {
+		r   rune
+		pos int
+	}
+	folded := []foldedRune{}
+	for i, r := range rs {
+		if unicode.IsSpace(r) || unicode.IsPunct(r) || unicode.IsSymbol(r) || r == '〜' {
+			continue
+		}
+		folded = append(folded, foldedRune{r: foldRune(r), pos: i})
+	}
+
+	marks := make([]bool, len(rs))
+	found := false
+	for i := 0; i+len(needle) <= len(folded); i++ {
+		match := true
+		for j, r := range needle {
+			if folded[i+j].r != r {
+				match = false
+				break
+			}
+		}
+		if !match {
+			continue
+		}
+		found = true
+		for k := folded[i].pos; k <= folded[i+len(needle)-1].pos; k++ {
+			marks[k] = true
+		}
+		i += len(needle) - 1
+	}
+
+	var b strings.Builder
+	for i, r := range rs {
+		if marks[i] && (i == 0 || !marks[i-1]) {
+			b.WriteString(HIGHLIGHT_OPEN)
+		}
+		b.WriteString(html.EscapeString(string(r)))
+		if marks[i] && (i == len(rs)-1 || !marks[i+1]) {
+			b.WriteString(HIGHLIGHT_CLOSE)
+		}
+	}
+	return b.String(), found
+}
+
+func foldRune(r rune) rune {
+	s := kana.ToHiragana(width.Fold.String(string(r)))
+	for _, f := range s {
+		return unicode.ToLower(f)
+	}
+	return r
+}
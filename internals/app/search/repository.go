@@ -0,0 +1,151 @@
+package search
+
+import (
+	"errors"
+	"time"
+	"unicode/utf8"
+
+	"wakuwaku_nihongo/internals/model"
+	"wakuwaku_nihongo/internals/pkg/sqlutil"
+	"wakuwaku_nihongo/internals/query"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gen"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type repo struct {
+	db *gorm.DB
+	*query.Query
+}
+
+func NewRepo(db *gorm.DB) *repo {
+	return &repo{
+		db:    db,
+		Query: query.Use(db),
+	}
+}
+
+// Search ranks the questions whose document contains every bigram of the
+// query by trigram word similarity. A single character query has no bigram
+// and falls back to a substring match.
+func (r *repo) Search(ctx echo.Context, filter *SearchFilter, normalized string) (out []*SearchHit, count int64, err error) {
+	db := r.db.Table("question_search_documents d").
+		Joins("JOIN questions q ON q.question_id = d.question_id").
+		Where("q.deleted_at IS NULL")
+	if filter.QuizID != "" {
+		db = db.Where("q.quiz_id = ?", filter.QuizID)
+	}
+	if utf8.RuneCountInString(normalized) < 2 {
+		db = db.Where("d.content LIKE ?", sqlutil.Contains(normalized))
+	} else {
+		db = db.Where("d.bigrams @> CAST(? AS text[])", TextArray(Bigrams(normalized)))
+	}
+	db = db.Session(&gorm.Session{})
+
+	if err = db.Count(&count).Error; err != nil {
+		return
+	}
+
+	out = []*SearchHit{}
+	err = db.Select("d.question_id, word_similarity(?, d.content) AS score", normalized).
+		Order("score DESC").
+		Order("q.created_at DESC").
+		Limit(filter.Limit()).
+		Offset(filter.Offset()).
+		Scan(&out).Error
+	return
+}
+
+func (r *repo) GetQuestions(ctx echo.Context, questionIDs []string) (out []*model.Question, err error) {
+	q := r.Question
+	return q.Where(q.QuestionID.In(questionIDs...)).
+		Preload(q.Answers).Find()
+}
+
+// IndexQuestion rebuilds the document of a question, removing it when the
+// question no longer exists.
+func (r *repo) IndexQuestion(ctx echo.Context, questionID string) (err error) {
+	q := r.Question
+	question, err := q.Where(q.QuestionID.Eq(questionID)).
+		Preload(q.Answers).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return r.db.Where("question_id = ?", questionID).Delete(&QuestionSearchDocument{}).Error
+	}
+	if err != nil {
+		return
+	}
+	return r.saveDocuments([]*model.Question{question})
+}
+
+// Reindex rebuilds the documents of every question and returns how many were
+// indexed.
+func (r *repo) Reindex(ctx echo.Context) (indexed int, err error) {
+	q := r.Question
+	var batch []*model.Question
+	err = q.Preload(q.Answers).
+		FindInBatches(&batch, REINDEX_BATCH_SIZE, func(tx gen.Dao, _ int) error {
+			indexed += len(batch)
+			return r.saveDocuments(batch)
+		})
+	return
+}
+
+func (r *repo) saveDocuments(questions []*model.Question) (err error) {
+	if len(questions) == 0 {
+		return
+	}
+	explanations, err := r.getExplanations(questions)
+	if err != nil {
+		return
+	}
+	now := time.Now().UnixMilli()
+	docs := []*QuestionSearchDocument{}
+	for _, question := range questions {
+		doc := BuildDocument(question, explanations[question.QuestionID])
+		doc.ModifiedAt = now
+		docs = append(docs, doc)
+	}
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "question_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"content", "bigrams", "modified_at"}),
+	}).Create(&docs).Error
+}
+
+// getExplanations returns the explanation translations of the questions and
+// of their answers keyed by question ID. The explanations table is read
+// directly, the explanations package depends on this one for indexing.
+func (r *repo) getExplanations(questions []*model.Question) (out map[string][]string, err error) {
+	questionIDs := map[string]string{}
+	for _, q := range questions {
+		questionIDs[q.QuestionID] = q.QuestionID
+		for _, a := range q.Answers {
+			questionIDs[a.AnswerID] = q.QuestionID
+		}
+	}
+	ownerIDs := make([]string, 0, len(questionIDs))
+	for id := range questionIDs {
+		ownerIDs = append(ownerIDs, id)
+	}
+
+	rows := []struct {
+		OwnerID     string
+		Explanation string
+	}{}
+	err = r.db.Table("explanations").
+		Select("owner_id, explanation").
+		Where("owner_id IN ? AND deleted_at IS NULL", ownerIDs).
+		Order("owner_type DESC, owner_id, locale").
+		Scan(&rows).Error
+	if err != nil {
+		return
+	}
+
+	out = map[string][]string{}
+	for _, row := range rows {
+		questionID := questionIDs[row.OwnerID]
+		out[questionID] = append(out[questionID], row.Explanation)
+	}
+	return
+}
@@ -0,0 +1,15 @@
+package search
+
+import (
+	"github.com/labstack/echo/v4"
+	"wakuwaku_nihongo/internals/middleware"
+	"wakuwaku_nihongo/internals/utils/token"
+)
+
+func (h *handler) QuestionRoute(g *echo.Group) {
+	editor := middleware.Authorization(token.ROLE_EDITOR)
+	admin := middleware.Authorization(token.ROLE_ADMIN)
+
+	g.GET("/search", h.Search, middleware.Authentication, editor)
+	g.POST("/search/reindex", h.Reindex, middleware.Authentication, admin)
+}
@@ -0,0 +1,86 @@
+package search
+
+import (
+	"fmt"
+
+	"wakuwaku_nihongo/internals/abstraction"
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/model"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ISearchRepo interface {
+	Search(ctx echo.Context, filter *SearchFilter, normalized string) (out []*SearchHit, count int64, err error)
+	GetQuestions(ctx echo.Context, questionIDs []string) (out []*model.Question, err error)
+	IndexQuestion(ctx echo.Context, questionID string) (err error)
+	Reindex(ctx echo.Context) (indexed int, err error)
+}
+
+type service struct {
+	repo ISearchRepo
+}
+
+func NewService(f *factory.Factory) *service {
+	return &service{
+		repo: NewRepo(f.Db),
+	}
+}
+
+func (s *service) Search(ctx echo.Context, filter *SearchFilter) (out []*SearchResultResponse, info *abstraction.PaginationInfo, err error) {
+	normalized := NormalizeQuery(filter.Query)
+	if normalized == "" {
+		err = response.ErrorWrap(response.ErrValidation, fmt.Errorf("query has no searchable characters"))
+		return
+	}
+
+	hits, count, err := s.repo.Search(ctx, filter, normalized)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	ids := []string{}
+	for _, hit := range hits {
+		ids = append(ids, hit.QuestionID)
+	}
+	questions, err := s.repo.GetQuestions(ctx, ids)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	byID := map[string]*model.Question{}
+	for _, q := range questions {
+		byID[q.QuestionID] = q
+	}
+
+	out = []*SearchResultResponse{}
+	for _, hit := range hits {
+		question, ok := byID[hit.QuestionID]
+		if !ok {
+			continue
+		}
+		result := &SearchResultResponse{}
+		result.MapFromModel(question, hit.Score, normalized)
+		out = append(out, result)
+	}
+	info = filter.CreatePageInfo(count)
+	return
+}
+
+// IndexQuestion refreshes the search document of a question. It is called by
+// the features that write question or answer text.
+func (s *service) IndexQuestion(ctx echo.Context, questionID string) (err error) {
+	return s.repo.IndexQuestion(ctx, questionID)
+}
+
+func (s *service) Reindex(ctx echo.Context) (out *ReindexResponse, err error) {
+	indexed, err := s.repo.Reindex(ctx)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	out = &ReindexResponse{Indexed: indexed}
+	return
+}
@@ -0,0 +1,117 @@
+package tests
+
+import (
+	"testing"
+	"wakuwaku_nihongo/internals/app/search"
+	"wakuwaku_nihongo/internals/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeQuery(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"漢字", "漢字"},
+		{"カタカナ", "かたかな"},
+		{"benkyou", "べんきょう"},
+		{"Tōkyō", "とうきょう"},
+		{"ATM", "atm"},
+		{"  食べる。 ", "食べる"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			assert.Equal(t, tt.want, search.NormalizeQuery(tt.in))
+		})
+	}
+}
+
+func TestBigrams(t *testing.T) {
+	assert.Equal(t, []string{"にほ", "ほん", "んご"}, search.Bigrams("にほんご"))
+	assert.Equal(t, []string{"ああ"}, search.Bigrams("あああ"))
+	assert.Equal(t, []string{}, search.Bigrams("あ"))
+}
+
+func TestBuildDocument(t *testing.T) {
+	doc := search.BuildDocument(&model.Question{
+		QuestionID:   "q",
+		QuestionText: "漢字[かんじ]の読み方",
+		Answers: []*model.Answer{
+			{AnswerText: "カンジ"},
+			{AnswerText: "かんじ"},
+		},
+	}, nil)
+
+	assert.Equal(t, "q", doc.QuestionID)
+	assert.Equal(t, "漢字の読み方\nかんじの読み方\nかんじ", doc.Content)
+	assert.Contains(t, doc.Bigrams, "漢字")
+	assert.Contains(t, doc.Bigrams, "かん")
+	assert.NotContains(t, doc.Bigrams, "方か")
+}
+
+func TestBuildDocumentTwoCharacterAnswer(t *testing.T) {
+	doc := search.BuildDocument(&model.Question{
+		QuestionID:   "q",
+		QuestionText: "「せんせい」の漢字はどれですか",
+		Answers: []*model.Answer{
+			{AnswerText: "先生"},
+			{AnswerText: "学生"},
+		},
+	}, nil)
+
+	assert.Contains(t, doc.Bigrams, "先生")
+	assert.Contains(t, doc.Bigrams, "学生")
+	assert.Subset(t, doc.Bigrams, search.Bigrams(search.NormalizeQuery("先生")))
+}
+
+func TestBuildDocumentExplanations(t *testing.T) {
+	doc := search.BuildDocument(&model.Question{
+		QuestionID:   "q",
+		QuestionText: "どれですか",
+		Answers:      []*model.Answer{{AnswerText: "はい"}},
+	}, []string{"Polite question ending", "丁寧[ていねい]な言い方"})
+
+	assert.Equal(t, "どれですか\nはい\npolitequestionending\n丁寧な言い方\nていねいな言い方", doc.Content)
+	assert.Contains(t, doc.Bigrams, "丁寧")
+	assert.Contains(t, doc.Bigrams, "てい")
+}
+
+func TestHighlight(t *testing.T) {
+	tests := []struct {
+		text  string
+		query string
+		want  string
+		found bool
+	}{
+		{"漢字の読み方", "読み", "漢字の<mark>読み</mark>方", true},
+		{"カタカナで書く", "かたかな", "<mark>カタカナ</mark>で書く", true},
+		{"わたし は がくせい", "はが", "わたし <mark>は が</mark>くせい", true},
+		{"あいうあい", "あい", "<mark>あい</mark>う<mark>あい</mark>", true},
+		{"<b>漢字</b>", "漢字", "&lt;b&gt;<mark>漢字</mark>&lt;/b&gt;", true},
+		{"漢字", "かんじ", "漢字", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			got, found := search.Highlight(tt.text, tt.query)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.found, found)
+		})
+	}
+}
+
+func TestTextArray(t *testing.T) {
+	in := search.TextArray{"あい", `a"b`, `c\d`, "e,f"}
+	value, err := in.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `{"あい","a\"b","c\\d","e,f"}`, value)
+
+	out := search.TextArray{}
+	assert.NoError(t, out.Scan(value))
+	assert.Equal(t, in, out)
+
+	assert.NoError(t, out.Scan("{}"))
+	assert.Equal(t, search.TextArray{}, out)
+}
@@ -0,0 +1,94 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+
+	"wakuwaku_nihongo/internals/model"
+	"wakuwaku_nihongo/internals/pkg/kana"
+	"wakuwaku_nihongo/internals/pkg/normalizer"
+	"wakuwaku_nihongo/internals/pkg/ruby"
+)
+
+var normalizeOptions = normalizer.ModeStandard.Options()
+
+// NormalizeQuery folds the query the same way documents are folded. A query
+// typed in romaji is converted to hiragana first, unless it does not read as
+// romaji (e.g. an abbreviation such as ATM).
+func NormalizeQuery(q string) string {
+	q = strings.TrimSpace(q)
+	if kana.IsRomaji(q) {
+		if converted := kana.RomajiToHiragana(q); !strings.ContainsFunc(converted, isLatin) {
+			q = converted
+		}
+	}
+	return normalizer.Normalize(q, normalizeOptions)
+}
+
+// Bigrams returns the distinct pairs of consecutive characters of s.
+func Bigrams(s string) []string {
+	rs := []rune(s)
+	seen := map[string]bool{}
+	out := []string{}
+	for i := 0; i+1 < len(rs); i++ {
+		bigram := string(rs[i : i+2])
+		if !seen[bigram] {
+			seen[bigram] = true
+			out = append(out, bigram)
+		}
+	}
+	return out
+}
+
+// BuildDocument makes the search document of a question out of the question
+// and answer texts and the translations of their explanations. Text with
+// furigana is indexed both as written and as read, so 漢字[かんじ] is found
+// by 漢字 and by かんじ.
+func BuildDocument(question *model.Question, explanations []string) *QuestionSearchDocument {
+	texts := documentTexts(question.QuestionText)
+	for _, a := range question.Answers {
+		texts = append(texts, documentTexts(a.AnswerText)...)
+	}
+	for _, e := range explanations {
+		texts = append(texts, documentTexts(e)...)
+	}
+
+	// pieces and bigrams are deduplicated apart, a two character piece is
+	// its own bigram
+	seenPieces := map[string]bool{}
+	seenBigrams := map[string]bool{}
+	pieces := []string{}
+	bigrams := TextArray{}
+	for _, text := range texts {
+		piece := normalizer.Normalize(text, normalizeOptions)
+		if piece == "" || seenPieces[piece] {
+			continue
+		}
+		seenPieces[piece] = true
+		pieces = append(pieces, piece)
+		for _, bigram := range Bigrams(piece) {
+			if !seenBigrams[bigram] {
+				seenBigrams[bigram] = true
+				bigrams = append(bigrams, bigram)
+			}
+		}
+	}
+
+	return &QuestionSearchDocument{
+		QuestionID: question.QuestionID,
+		Content:    strings.Join(pieces, DOCUMENT_SEPARATOR),
+		Bigrams:    bigrams,
+	}
+}
+
+func documentTexts(markup string) []string {
+	segs, err := ruby.Parse(markup)
+	if err != nil {
+		return []string{markup}
+	}
+	return []string{ruby.Text(segs), ruby.Reading(segs)}
+}
+
+func isLatin(r rune) bool {
+	return r < unicode.MaxASCII && unicode.IsLetter(r)
+}
@@ -0,0 +1,179 @@
+package stats
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Day returns the calendar day of a unix millisecond timestamp in loc, as
+// midnight UTC so it maps onto a DATE column unchanged.
+func Day(ms int64, loc *time.Location) time.Time {
+	y, m, d := time.UnixMilli(ms).In(loc).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// Aggregate counts the answers of one attempt by every dimension of their
+// question. Questions without meta are only counted overall. The rows are
+// sorted so concurrent upserts lock them in the same order.
+func Aggregate(customerID string, day time.Time, answers []Answer, meta map[string]*QuestionMeta) []*LearnerStat {
+	rows := map[[2]string]*LearnerStat{}
+	add := func(dimension, key string, correct bool) {
+		row, ok := rows[[2]string{dimension, key}]
+		if !ok {
+			row = &LearnerStat{CustomerID: customerID, Day: day, Dimension: dimension, DimensionKey: key}
+			rows[[2]string{dimension, key}] = row
+		}
+		row.Total++
+		if correct {
+			row.Correct++
+		}
+	}
+
+	for _, a := range answers {
+		add(DIMENSION_OVERALL, "", a.IsCorrect)
+		m, ok := meta[a.QuestionID]
+		if !ok {
+			continue
+		}
+		if m.JlptLevel != nil && *m.JlptLevel != "" {
+			add(DIMENSION_JLPT_LEVEL, *m.JlptLevel, a.IsCorrect)
+		}
+		if m.Section != nil && *m.Section != "" {
+			add(DIMENSION_SECTION, *m.Section, a.IsCorrect)
+		}
+		if m.QuestionType != nil && *m.QuestionType != "" {
+			add(DIMENSION_QUESTION_TYPE, *m.QuestionType, a.IsCorrect)
+		}
+		for _, id := range m.GrammarPointIDs {
+			add(DIMENSION_GRAMMAR_POINT, id, a.IsCorrect)
+		}
+	}
+
+	out := make([]*LearnerStat, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, row)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Dimension != out[j].Dimension {
+			return out[i].Dimension < out[j].Dimension
+		}
+		return out[i].DimensionKey < out[j].DimensionKey
+	})
+	return out
+}
+
+// periodStart returns the first day of the period containing day, weeks
+// start on Monday.
+func periodStart(day time.Time, granularity string) time.Time {
+	switch granularity {
+	case GRANULARITY_WEEK:
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	case GRANULARITY_MONTH:
+		return time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+	return day
+}
+
+func nextPeriod(start time.Time, granularity string) time.Time {
+	switch granularity {
+	case GRANULARITY_WEEK:
+		return start.AddDate(0, 0, 7)
+	case GRANULARITY_MONTH:
+		return start.AddDate(0, 1, 0)
+	}
+	return start.AddDate(0, 0, 1)
+}
+
+// Trend sums the daily overall counts into periods from from to to. Periods
+// without answers are included so the series has no gaps.
+func Trend(daily []*LearnerStat, from, to time.Time, granularity string) []*TrendResponse {
+	sums := map[time.Time][2]int{}
+	for _, row := range daily {
+		start := periodStart(row.Day, granularity)
+		sum := sums[start]
+		sums[start] = [2]int{sum[0] + row.Correct, sum[1] + row.Total}
+	}
+
+	layout := DATE_LAYOUT
+	if granularity == GRANULARITY_MONTH {
+		layout = "2006-01"
+	}
+	out := []*TrendResponse{}
+	for start := periodStart(from, granularity); !start.After(to); start = nextPeriod(start, granularity) {
+		sum := sums[start]
+		out = append(out, &TrendResponse{
+			Period:   start.Format(layout),
+			Accuracy: NewAccuracy(sum[0], sum[1]),
+		})
+	}
+	return out
+}
+
+// Weakest returns the areas with the lowest accuracy among those with enough
+// answers to tell, least accurate first.
+func Weakest(totals []*Total, labels map[string]string, minAnswers, limit int) []*WeakAreaResponse {
+	out := []*WeakAreaResponse{}
+	for _, t := range totals {
+		if t.Dimension == DIMENSION_OVERALL || t.Total < minAnswers {
+			continue
+		}
+		out = append(out, &WeakAreaResponse{
+			Dimension: t.Dimension,
+			Key:       t.DimensionKey,
+			Label:     label(t, labels),
+			Accuracy:  NewAccuracy(t.Correct, t.Total),
+		})
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].Accuracy.Accuracy != out[j].Accuracy.Accuracy {
+			return out[i].Accuracy.Accuracy < out[j].Accuracy.Accuracy
+		}
+		if out[i].Total != out[j].Total {
+			return out[i].Total > out[j].Total
+		}
+		if out[i].Dimension != out[j].Dimension {
+			return out[i].Dimension < out[j].Dimension
+		}
+		return out[i].Key < out[j].Key
+	})
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out
+}
+
+// label names grammar points by their pattern, other keys speak for
+// themselves.
+func label(t *Total, labels map[string]string) string {
+	if t.Dimension == DIMENSION_GRAMMAR_POINT {
+		return labels[t.DimensionKey]
+	}
+	return ""
+}
+
+// DateRange parses the from and to dates of a filter. to defaults to today
+// in loc and from to DEFAULT_RANGE_DAYS days before it.
+func DateRange(from, to string, now time.Time, loc *time.Location) (start, end time.Time, err error) {
+	end = Day(now.UnixMilli(), loc)
+	if to != "" {
+		if end, err = time.Parse(DATE_LAYOUT, to); err != nil {
+			return
+		}
+	}
+	start = end.AddDate(0, 0, -(DEFAULT_RANGE_DAYS - 1))
+	if from != "" {
+		if start, err = time.Parse(DATE_LAYOUT, from); err != nil {
+			return
+		}
+	}
+
+	if start.After(end) {
+		err = fmt.Errorf("from must not be after to")
+		return
+	}
+	if end.Sub(start) >= MAX_RANGE_DAYS*24*time.Hour {
+		err = fmt.Errorf("the range must not exceed %d days", MAX_RANGE_DAYS)
+	}
+	return
+}
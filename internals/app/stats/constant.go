@@ -0,0 +1,25 @@
+package stats
+
+const (
+	// dimensions answers are counted by, overall has an empty key
+	DIMENSION_OVERALL       = "overall"
+	DIMENSION_JLPT_LEVEL    = "jlpt_level"
+	DIMENSION_SECTION       = "section"
+	DIMENSION_QUESTION_TYPE = "question_type"
+	DIMENSION_GRAMMAR_POINT = "grammar_point"
+
+	GRANULARITY_DAY   = "day"
+	GRANULARITY_WEEK  = "week"
+	GRANULARITY_MONTH = "month"
+
+	DATE_LAYOUT = "2006-01-02"
+
+	// DEFAULT_RANGE_DAYS is the period reported when no from date is given.
+	DEFAULT_RANGE_DAYS = 90
+	// MAX_RANGE_DAYS bounds the period so a daily trend stays small.
+	MAX_RANGE_DAYS = 731
+
+	// an area needs this many answers before it can be reported as weak
+	WEAK_AREA_MIN_ANSWERS = 5
+	WEAK_AREA_LIMIT       = 5
+)
@@ -0,0 +1,54 @@
+package stats
+
+import (
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+type IStatsService interface {
+	Get(ctx echo.Context, filter *StatsFilter) (out *StatsResponse, err error)
+}
+
+type handler struct {
+	service IStatsService
+}
+
+func NewHandler(f *factory.Factory) *handler {
+	return &handler{
+		service: NewService(f),
+	}
+}
+
+// @Summary Get Learner Stats
+// @Description Accuracy of the logged in customer per JLPT level, section, question type and grammar point, the trend over time and the weakest areas. from defaults to 90 days before to, to defaults to today
+// @Tags stats
+// @Produce json
+// @Param from query string false "From date (YYYY-MM-DD)"
+// @Param to query string false "To date (YYYY-MM-DD)"
+// @Param granularity query string false "Trend period" Enums(day, week, month)
+// @Success 200 {object} response.Success{data=StatsResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 401 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/me/stats [get]
+func (h *handler) Get(c echo.Context) error {
+	req := &StatsFilter{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.Get(c, req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
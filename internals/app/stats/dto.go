@@ -0,0 +1,85 @@
+package stats
+
+import "math"
+
+type StatsFilter struct {
+	From        string `query:"from" validate:"omitempty,is-date"`
+	To          string `query:"to" validate:"omitempty,is-date"`
+	Granularity string `query:"granularity" validate:"omitempty,oneof=day week month"`
+}
+
+// Accuracy is the share of correct answers, between 0 and 1.
+type Accuracy struct {
+	Correct  int     `json:"correct"`
+	Total    int     `json:"total"`
+	Accuracy float64 `json:"accuracy"`
+}
+
+type BreakdownResponse struct {
+	Key   string `json:"key"`
+	Label string `json:"label,omitempty"`
+	Accuracy
+}
+
+type TrendResponse struct {
+	Period string `json:"period"`
+	Accuracy
+}
+
+type WeakAreaResponse struct {
+	Dimension string `json:"dimension"`
+	Key       string `json:"key"`
+	Label     string `json:"label,omitempty"`
+	Accuracy
+}
+
+type StatsResponse struct {
+	From          string               `json:"from"`
+	To            string               `json:"to"`
+	Granularity   string               `json:"granularity"`
+	Overall       Accuracy             `json:"overall"`
+	JlptLevels    []*BreakdownResponse `json:"jlpt_levels"`
+	Sections      []*BreakdownResponse `json:"sections"`
+	QuestionTypes []*BreakdownResponse `json:"question_types"`
+	GrammarPoints []*BreakdownResponse `json:"grammar_points"`
+	Trend         []*TrendResponse     `json:"trend"`
+	Weakest       []*WeakAreaResponse  `json:"weakest"`
+}
+
+func NewAccuracy(correct, total int) Accuracy {
+	a := Accuracy{Correct: correct, Total: total}
+	if total > 0 {
+		a.Accuracy = math.Round(float64(correct)/float64(total)*10000) / 10000
+	}
+	return a
+}
+
+// SetTotals fills the overall accuracy and the breakdowns from the summed
+// counts of the period.
+func (r *StatsResponse) SetTotals(totals []*Total, labels map[string]string) {
+	r.JlptLevels = []*BreakdownResponse{}
+	r.Sections = []*BreakdownResponse{}
+	r.QuestionTypes = []*BreakdownResponse{}
+	r.GrammarPoints = []*BreakdownResponse{}
+	r.Overall = NewAccuracy(0, 0)
+
+	for _, t := range totals {
+		breakdown := &BreakdownResponse{
+			Key:      t.DimensionKey,
+			Label:    label(t, labels),
+			Accuracy: NewAccuracy(t.Correct, t.Total),
+		}
+		switch t.Dimension {
+		case DIMENSION_OVERALL:
+			r.Overall = breakdown.Accuracy
+		case DIMENSION_JLPT_LEVEL:
+			r.JlptLevels = append(r.JlptLevels, breakdown)
+		case DIMENSION_SECTION:
+			r.Sections = append(r.Sections, breakdown)
+		case DIMENSION_QUESTION_TYPE:
+			r.QuestionTypes = append(r.QuestionTypes, breakdown)
+		case DIMENSION_GRAMMAR_POINT:
+			r.GrammarPoints = append(r.GrammarPoints, breakdown)
+		}
+	}
+}
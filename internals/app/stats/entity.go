@@ -0,0 +1,42 @@
+package stats
+
+import "time"
+
+// LearnerStat counts the answers of a customer on one day for one key of a
+// dimension, e.g. the N4 questions answered on 2026-03-01.
+type LearnerStat struct {
+	CustomerID   string    `gorm:"column:customer_id;type:uuid;primaryKey" json:"customer_id"`
+	Day          time.Time `gorm:"column:day;type:date;primaryKey" json:"day"`
+	Dimension    string    `gorm:"column:dimension;type:character varying;primaryKey" json:"dimension"`
+	DimensionKey string    `gorm:"column:dimension_key;type:character varying;primaryKey" json:"dimension_key"`
+	Correct      int       `gorm:"column:correct;type:integer;not null" json:"correct"`
+	Total        int       `gorm:"column:total;type:integer;not null" json:"total"`
+}
+
+func (*LearnerStat) TableName() string {
+	return "learner_stats"
+}
+
+// QuestionMeta is what a question is counted by.
+type QuestionMeta struct {
+	QuestionID      string
+	QuestionType    *string
+	Section         *string
+	JlptLevel       *string
+	GrammarPointIDs []string `gorm:"-"`
+}
+
+// Answer is a graded question of a submitted attempt, unanswered questions
+// count as incorrect.
+type Answer struct {
+	QuestionID string
+	IsCorrect  bool
+}
+
+// Total is the sum of the counts of a dimension key over a period.
+type Total struct {
+	Dimension    string
+	DimensionKey string
+	Correct      int
+	Total        int
+}
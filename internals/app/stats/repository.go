@@ -0,0 +1,116 @@
+package stats
+
+import (
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type repo struct {
+	db *gorm.DB
+}
+
+func NewRepo(db *gorm.DB) *repo {
+	return &repo{
+		db: db,
+	}
+}
+
+// GetQuestionMeta returns the level, section, type and grammar points of
+// questions keyed by question id.
+func (r *repo) GetQuestionMeta(ctx echo.Context, questionIDs []string) (out map[string]*QuestionMeta, err error) {
+	out = map[string]*QuestionMeta{}
+	if len(questionIDs) == 0 {
+		return
+	}
+
+	rows := []*QuestionMeta{}
+	err = r.db.Table("questions q").
+		Select("q.question_id, q.question_type, q.section, z.jlpt_level").
+		Joins("JOIN quizzes z ON z.quiz_id = q.quiz_id").
+		Where("q.question_id IN ?", questionIDs).
+		Scan(&rows).Error
+	if err != nil {
+		return
+	}
+	for _, row := range rows {
+		out[row.QuestionID] = row
+	}
+
+	links := []struct {
+		QuestionID     string
+		GrammarPointID string
+	}{}
+	err = r.db.Table("question_grammar_points").
+		Select("question_id, grammar_point_id").
+		Where("question_id IN ?", questionIDs).
+		Order("grammar_point_id").
+		Scan(&links).Error
+	if err != nil {
+		return
+	}
+	for _, link := range links {
+		if m, ok := out[link.QuestionID]; ok {
+			m.GrammarPointIDs = append(m.GrammarPointIDs, link.GrammarPointID)
+		}
+	}
+	return
+}
+
+// Increment adds the counts of in to the stored rows.
+func (r *repo) Increment(ctx echo.Context, in []*LearnerStat) (err error) {
+	if len(in) == 0 {
+		return
+	}
+	return r.db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "customer_id"}, {Name: "dimension"}, {Name: "dimension_key"}, {Name: "day"}},
+		DoUpdates: clause.Assignments(map[string]any{
+			"correct": gorm.Expr("learner_stats.correct + excluded.correct"),
+			"total":   gorm.Expr("learner_stats.total + excluded.total"),
+		}),
+	}).Create(&in).Error
+}
+
+// GetTotals sums the counts of every dimension key between from and to,
+// both inclusive.
+func (r *repo) GetTotals(ctx echo.Context, customerID string, from, to time.Time) (out []*Total, err error) {
+	out = []*Total{}
+	err = r.db.Model(&LearnerStat{}).
+		Select("dimension, dimension_key, SUM(correct) AS correct, SUM(total) AS total").
+		Where("customer_id = ? AND day BETWEEN ? AND ?", customerID, from, to).
+		Group("dimension, dimension_key").
+		Order("dimension, dimension_key").
+		Scan(&out).Error
+	return
+}
+
+// GetDaily returns the overall counts of each day between from and to.
+func (r *repo) GetDaily(ctx echo.Context, customerID string, from, to time.Time) (out []*LearnerStat, err error) {
+	out = []*LearnerStat{}
+	err = r.db.Where("customer_id = ? AND dimension = ? AND day BETWEEN ? AND ?", customerID, DIMENSION_OVERALL, from, to).
+		Order("day").
+		Find(&out).Error
+	return
+}
+
+// GetGrammarPatterns returns the pattern of grammar points keyed by id.
+func (r *repo) GetGrammarPatterns(ctx echo.Context, grammarPointIDs []string) (out map[string]string, err error) {
+	out = map[string]string{}
+	if len(grammarPointIDs) == 0 {
+		return
+	}
+	rows := []struct {
+		GrammarPointID string
+		Pattern        string
+	}{}
+	err = r.db.Table("grammar_points").
+		Select("grammar_point_id, pattern").
+		Where("grammar_point_id IN ?", grammarPointIDs).
+		Scan(&rows).Error
+	for _, row := range rows {
+		out[row.GrammarPointID] = row.Pattern
+	}
+	return
+}
@@ -0,0 +1,98 @@
+package stats
+
+import (
+	"time"
+
+	"wakuwaku_nihongo/internals/app/preferences"
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/middleware"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+type IStatsRepo interface {
+	GetQuestionMeta(ctx echo.Context, questionIDs []string) (out map[string]*QuestionMeta, err error)
+	Increment(ctx echo.Context, in []*LearnerStat) (err error)
+	GetTotals(ctx echo.Context, customerID string, from, to time.Time) (out []*Total, err error)
+	GetDaily(ctx echo.Context, customerID string, from, to time.Time) (out []*LearnerStat, err error)
+	GetGrammarPatterns(ctx echo.Context, grammarPointIDs []string) (out map[string]string, err error)
+}
+
+type ILocationProvider interface {
+	Location(ctx echo.Context, customerID string) *time.Location
+}
+
+type service struct {
+	repo      IStatsRepo
+	locations ILocationProvider
+}
+
+func NewService(f *factory.Factory) *service {
+	return &service{
+		repo:      NewRepo(f.Db),
+		locations: preferences.NewService(f),
+	}
+}
+
+func (s *service) Get(ctx echo.Context, filter *StatsFilter) (out *StatsResponse, err error) {
+	customerID := middleware.GetUserID(ctx)
+	from, to, err := DateRange(filter.From, filter.To, time.Now(), s.locations.Location(ctx, customerID))
+	if err != nil {
+		err = response.ErrorWrap(response.ErrValidation, err)
+		return
+	}
+	granularity := filter.Granularity
+	if granularity == "" {
+		granularity = GRANULARITY_WEEK
+	}
+
+	totals, err := s.repo.GetTotals(ctx, customerID, from, to)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	daily, err := s.repo.GetDaily(ctx, customerID, from, to)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	grammarPointIDs := []string{}
+	for _, t := range totals {
+		if t.Dimension == DIMENSION_GRAMMAR_POINT {
+			grammarPointIDs = append(grammarPointIDs, t.DimensionKey)
+		}
+	}
+	labels, err := s.repo.GetGrammarPatterns(ctx, grammarPointIDs)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	out = &StatsResponse{
+		From:        from.Format(DATE_LAYOUT),
+		To:          to.Format(DATE_LAYOUT),
+		Granularity: granularity,
+		Trend:       Trend(daily, from, to, granularity),
+		Weakest:     Weakest(totals, labels, WEAK_AREA_MIN_ANSWERS, WEAK_AREA_LIMIT),
+	}
+	out.SetTotals(totals, labels)
+	return
+}
+
+// Record adds the answers graded on day to the customer's stats of that
+// day, counted by every dimension of their question.
+func Record(ctx echo.Context, db *gorm.DB, customerID string, day time.Time, answers []Answer) (err error) {
+	r := NewRepo(db)
+	questionIDs := make([]string, 0, len(answers))
+	for _, a := range answers {
+		questionIDs = append(questionIDs, a.QuestionID)
+	}
+	meta, err := r.GetQuestionMeta(ctx, questionIDs)
+	if err != nil {
+		return
+	}
+	return r.Increment(ctx, Aggregate(customerID, day, answers, meta))
+}
@@ -0,0 +1,144 @@
+package tests
+
+import (
+	"testing"
+	"time"
+	"wakuwaku_nihongo/internals/app/stats"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func ptr(s string) *string {
+	return &s
+}
+
+func date(s string) time.Time {
+	t, _ := time.Parse(stats.DATE_LAYOUT, s)
+	return t
+}
+
+func TestDay(t *testing.T) {
+	jakarta, _ := time.LoadLocation("Asia/Jakarta")
+	// 2026-03-01 18:30 UTC is already 2026-03-02 in Jakarta
+	ms := time.Date(2026, 3, 1, 18, 30, 0, 0, time.UTC).UnixMilli()
+	assert.Equal(t, date("2026-03-02"), stats.Day(ms, jakarta))
+	assert.Equal(t, date("2026-03-01"), stats.Day(ms, time.UTC))
+}
+
+func TestAggregate(t *testing.T) {
+	meta := map[string]*stats.QuestionMeta{
+		"q1": {QuestionID: "q1", JlptLevel: ptr("N4"), Section: ptr("grammar"), QuestionType: ptr("multiple_choice"), GrammarPointIDs: []string{"g1", "g2"}},
+		"q2": {QuestionID: "q2", JlptLevel: ptr("N4"), Section: ptr("vocabulary"), QuestionType: ptr("typed")},
+		"q3": {QuestionID: "q3", Section: ptr("grammar"), GrammarPointIDs: []string{"g1"}},
+	}
+	answers := []stats.Answer{
+		{QuestionID: "q1", IsCorrect: true},
+		{QuestionID: "q2", IsCorrect: false},
+		{QuestionID: "q3", IsCorrect: false},
+		{QuestionID: "deleted", IsCorrect: true},
+	}
+
+	rows := stats.Aggregate("c1", date("2026-03-02"), answers, meta)
+
+	got := map[string][2]int{}
+	for _, row := range rows {
+		assert.Equal(t, "c1", row.CustomerID)
+		assert.Equal(t, date("2026-03-02"), row.Day)
+		got[row.Dimension+":"+row.DimensionKey] = [2]int{row.Correct, row.Total}
+	}
+	assert.Equal(t, map[string][2]int{
+		"overall:":                      {2, 4},
+		"jlpt_level:N4":                 {1, 2},
+		"section:grammar":               {1, 2},
+		"section:vocabulary":            {0, 1},
+		"question_type:multiple_choice": {1, 1},
+		"question_type:typed":           {0, 1},
+		"grammar_point:g1":              {1, 2},
+		"grammar_point:g2":              {1, 1},
+	}, got)
+
+	for i := 1; i < len(rows); i++ {
+		prev, cur := rows[i-1], rows[i]
+		assert.True(t, prev.Dimension < cur.Dimension || (prev.Dimension == cur.Dimension && prev.DimensionKey < cur.DimensionKey), "rows are sorted")
+	}
+}
+
+func TestTrend(t *testing.T) {
+	daily := []*stats.LearnerStat{
+		{Day: date("2026-03-02"), Correct: 3, Total: 4},
+		{Day: date("2026-03-04"), Correct: 1, Total: 4},
+		{Day: date("2026-03-16"), Correct: 5, Total: 5},
+	}
+
+	weeks := stats.Trend(daily, date("2026-03-03"), date("2026-03-17"), stats.GRANULARITY_WEEK)
+	assert.Len(t, weeks, 3)
+	assert.Equal(t, "2026-03-02", weeks[0].Period)
+	assert.Equal(t, stats.NewAccuracy(4, 8), weeks[0].Accuracy)
+	assert.Equal(t, "2026-03-09", weeks[1].Period)
+	assert.Equal(t, 0, weeks[1].Total, "weeks without answers are kept")
+	assert.Equal(t, 1.0, weeks[2].Accuracy.Accuracy)
+
+	days := stats.Trend(daily, date("2026-03-02"), date("2026-03-04"), stats.GRANULARITY_DAY)
+	assert.Len(t, days, 3)
+	assert.Equal(t, 0.75, days[0].Accuracy.Accuracy)
+	assert.Equal(t, 0.25, days[2].Accuracy.Accuracy)
+
+	months := stats.Trend(daily, date("2026-02-20"), date("2026-03-31"), stats.GRANULARITY_MONTH)
+	assert.Len(t, months, 2)
+	assert.Equal(t, "2026-02", months[0].Period)
+	assert.Equal(t, "2026-03", months[1].Period)
+	assert.Equal(t, stats.NewAccuracy(9, 13), months[1].Accuracy)
+}
+
+func TestWeakest(t *testing.T) {
+	totals := []*stats.Total{
+		{Dimension: stats.DIMENSION_OVERALL, Correct: 1, Total: 100},
+		{Dimension: stats.DIMENSION_JLPT_LEVEL, DimensionKey: "N3", Correct: 6, Total: 10},
+		{Dimension: stats.DIMENSION_SECTION, DimensionKey: "reading", Correct: 3, Total: 10},
+		{Dimension: stats.DIMENSION_GRAMMAR_POINT, DimensionKey: "g1", Correct: 0, Total: 2},
+		{Dimension: stats.DIMENSION_GRAMMAR_POINT, DimensionKey: "g2", Correct: 6, Total: 20},
+		{Dimension: stats.DIMENSION_QUESTION_TYPE, DimensionKey: "typed", Correct: 9, Total: 10},
+	}
+
+	weakest := stats.Weakest(totals, map[string]string{"g2": "〜ばかり"}, 5, 3)
+	assert.Len(t, weakest, 3)
+	assert.Equal(t, "g2", weakest[0].Key, "more answers break the tie")
+	assert.Equal(t, "〜ばかり", weakest[0].Label)
+	assert.Equal(t, "reading", weakest[1].Key)
+	assert.Equal(t, "N3", weakest[2].Key)
+}
+
+func TestDateRange(t *testing.T) {
+	now := time.Date(2026, 3, 31, 12, 0, 0, 0, time.UTC)
+
+	from, to, err := stats.DateRange("", "", now, time.UTC)
+	assert.NoError(t, err)
+	assert.Equal(t, date("2026-03-31"), to)
+	assert.Equal(t, date("2026-01-01"), from)
+
+	from, to, err = stats.DateRange("2026-02-01", "2026-02-28", now, time.UTC)
+	assert.NoError(t, err)
+	assert.Equal(t, date("2026-02-01"), from)
+	assert.Equal(t, date("2026-02-28"), to)
+
+	_, _, err = stats.DateRange("2026-03-01", "2026-02-01", now, time.UTC)
+	assert.Error(t, err)
+	_, _, err = stats.DateRange("2020-01-01", "", now, time.UTC)
+	assert.Error(t, err)
+}
+
+func TestSetTotals(t *testing.T) {
+	res := &stats.StatsResponse{}
+	res.SetTotals([]*stats.Total{
+		{Dimension: stats.DIMENSION_OVERALL, Correct: 7, Total: 9},
+		{Dimension: stats.DIMENSION_JLPT_LEVEL, DimensionKey: "N5", Correct: 7, Total: 9},
+		{Dimension: stats.DIMENSION_GRAMMAR_POINT, DimensionKey: "g1", Correct: 1, Total: 3},
+	}, map[string]string{"g1": "〜てもいい"})
+
+	assert.Equal(t, stats.NewAccuracy(7, 9), res.Overall)
+	assert.Equal(t, 0.7778, res.Overall.Accuracy)
+	assert.Len(t, res.JlptLevels, 1)
+	assert.Empty(t, res.Sections)
+	assert.NotNil(t, res.Sections)
+	assert.Equal(t, "〜てもいい", res.GrammarPoints[0].Label)
+}
@@ -0,0 +1,22 @@
+package streaks
+
+import "time"
+
+const (
+	// DEFAULT_DAILY_GOAL is the number of questions to answer a day until the
+	// customer sets their own goal.
+	DEFAULT_DAILY_GOAL = 10
+	MAX_DAILY_GOAL     = 500
+
+	// a freeze keeps the streak alive over a missed day, one is earned every
+	// FREEZE_EARN_EVERY days of streak and at most MAX_FREEZES are held.
+	MAX_FREEZES       = 2
+	FREEZE_EARN_EVERY = 7
+
+	// ROLLOVER_INTERVAL is how often the rollover job looks for customers
+	// whose day has ended, short enough to follow the half and quarter hour
+	// time zones.
+	ROLLOVER_INTERVAL   = 15 * time.Minute
+	ROLLOVER_BATCH_SIZE = 500
+	ROLLOVER_JOB_NAME   = "streak_rollover"
+)
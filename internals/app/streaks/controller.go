@@ -0,0 +1,90 @@
+package streaks
+
+import (
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+type IStreakService interface {
+	Get(ctx echo.Context) (out *StreakResponse, err error)
+	UpdateGoal(ctx echo.Context, in *GoalRequest) (out *StreakResponse, err error)
+	Rollover(ctx echo.Context) (out *RolloverResponse, err error)
+}
+
+type handler struct {
+	service IStreakService
+}
+
+func NewHandler(f *factory.Factory) *handler {
+	return &handler{
+		service: NewService(f),
+	}
+}
+
+// @Summary Get Streak
+// @Description Get the study streak of the logged in customer and whether today's goal of answered questions is met. Days follow the customer's timezone preference
+// @Tags streak
+// @Produce json
+// @Success 200 {object} response.Success{data=StreakResponse}
+// @Failure 401 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/me/streak [get]
+func (h *handler) Get(c echo.Context) error {
+	res, err := h.service.Get(c)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Update Daily Goal
+// @Description Set the number of questions to answer a day for it to count toward the streak
+// @Tags streak
+// @Accept json
+// @Produce json
+// @Param payload body GoalRequest true "Payload"
+// @Success 200 {object} response.Success{data=StreakResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 401 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/me/streak/goal [put]
+func (h *handler) UpdateGoal(c echo.Context) error {
+	req := &GoalRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.UpdateGoal(c, req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Roll Over Streaks
+// @Description Settle the streaks of customers whose day has ended without meeting their goal, using a freeze or resetting the streak. The same job runs every 15 minutes
+// @Tags streak
+// @Produce json
+// @Success 200 {object} response.Success{data=RolloverResponse}
+// @Failure 401 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/streaks/rollover [post]
+func (h *handler) Rollover(c echo.Context) error {
+	res, err := h.service.Rollover(c)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
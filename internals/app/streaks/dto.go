@@ -0,0 +1,48 @@
+package streaks
+
+import (
+	"time"
+
+	"wakuwaku_nihongo/internals/app/stats"
+)
+
+type GoalRequest struct {
+	DailyGoal int `json:"daily_goal" validate:"required,min=1,max=500"`
+}
+
+type StreakResponse struct {
+	CurrentStreak int     `json:"current_streak"`
+	LongestStreak int     `json:"longest_streak"`
+	DailyGoal     int     `json:"daily_goal"`
+	TodayProgress int     `json:"today_progress"`
+	GoalMet       bool    `json:"goal_met"`
+	Today         string  `json:"today"`
+	Timezone      string  `json:"timezone"`
+	LastGoalDay   *string `json:"last_goal_day"`
+	Freezes       int     `json:"freezes"`
+	MaxFreezes    int     `json:"max_freezes"`
+	FreezesUsed   int     `json:"freezes_used"`
+}
+
+type RolloverResponse struct {
+	Checked int `json:"checked"`
+	Frozen  int `json:"frozen"`
+	Reset   int `json:"reset"`
+}
+
+func (r *StreakResponse) MapFromModel(m *Streak, today time.Time, loc *time.Location, progress int) {
+	r.CurrentStreak = m.CurrentStreak
+	r.LongestStreak = m.LongestStreak
+	r.DailyGoal = m.DailyGoal
+	r.TodayProgress = progress
+	r.GoalMet = m.GoalMet(today)
+	r.Today = today.Format(stats.DATE_LAYOUT)
+	r.Timezone = loc.String()
+	if m.LastGoalDay != nil {
+		day := m.LastGoalDay.Format(stats.DATE_LAYOUT)
+		r.LastGoalDay = &day
+	}
+	r.Freezes = m.Freezes
+	r.MaxFreezes = MAX_FREEZES
+	r.FreezesUsed = m.FreezesUsed
+}
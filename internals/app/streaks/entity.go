@@ -0,0 +1,35 @@
+package streaks
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Streak struct {
+	CustomerID    string     `gorm:"column:customer_id;type:uuid;primaryKey" json:"customer_id"`
+	CreatedAt     int64      `gorm:"column:created_at;type:bigint;not null" json:"created_at"`
+	ModifiedAt    *int64     `gorm:"column:modified_at;type:bigint" json:"modified_at"`
+	CurrentStreak int        `gorm:"column:current_streak;type:integer;not null" json:"current_streak"`
+	LongestStreak int        `gorm:"column:longest_streak;type:integer;not null" json:"longest_streak"`
+	LastGoalDay   *time.Time `gorm:"column:last_goal_day;type:date" json:"last_goal_day"`
+	DailyGoal     int        `gorm:"column:daily_goal;type:integer;not null" json:"daily_goal"`
+	Freezes       int        `gorm:"column:freezes;type:integer;not null" json:"freezes"`
+	FreezesUsed   int        `gorm:"column:freezes_used;type:integer;not null" json:"freezes_used"`
+}
+
+func (*Streak) TableName() string {
+	return "customer_streaks"
+}
+
+func (m *Streak) BeforeCreate(tx *gorm.DB) (err error) {
+	m.CreatedAt = time.Now().UnixMilli()
+	return
+}
+
+// overdue is a streak the rollover job has to look at, with the customer's
+// time zone.
+type overdue struct {
+	CustomerID string
+	Timezone   *string
+}
@@ -0,0 +1,71 @@
+package streaks
+
+import (
+	"context"
+	"time"
+
+	"wakuwaku_nihongo/internals/app/preferences"
+	"wakuwaku_nihongo/internals/app/stats"
+	"wakuwaku_nihongo/internals/factory"
+)
+
+type job struct {
+	repo IStreakRepo
+}
+
+func NewJob(f *factory.Factory) *job {
+	return &job{
+		repo: NewRepo(f.Db),
+	}
+}
+
+// Run rolls the streaks over, it is scheduled every ROLLOVER_INTERVAL.
+func (j *job) Run(ctx context.Context) (err error) {
+	_, err = j.Rollover(ctx, time.Now())
+	return
+}
+
+// Rollover settles the streaks of the customers whose day has ended since
+// they last met their goal, using a freeze or resetting the streak.
+func (j *job) Rollover(ctx context.Context, now time.Time) (out *RolloverResponse, err error) {
+	out = &RolloverResponse{}
+	// no time zone is more than a day ahead of UTC, so this bound includes
+	// every customer who missed yesterday in their own time zone
+	before := stats.Day(now.UnixMilli(), time.UTC)
+
+	after := ""
+	for {
+		var candidates []*overdue
+		candidates, err = j.repo.ListOverdue(ctx, before, after, ROLLOVER_BATCH_SIZE)
+		if err != nil || len(candidates) == 0 {
+			return
+		}
+
+		for _, c := range candidates {
+			if err = ctx.Err(); err != nil {
+				return
+			}
+			today := stats.Day(now.UnixMilli(), preferences.LoadLocation(c.Timezone))
+			err = j.repo.Transaction(ctx, func(tx IStreakRepo) error {
+				streak, err := tx.GetForUpdate(ctx, c.CustomerID)
+				if err != nil {
+					return err
+				}
+				frozen, reset := streak.Roll(today)
+				if frozen == 0 && !reset {
+					return nil
+				}
+				out.Frozen += frozen
+				if reset {
+					out.Reset++
+				}
+				return tx.Save(ctx, streak)
+			})
+			if err != nil {
+				return
+			}
+			out.Checked++
+		}
+		after = candidates[len(candidates)-1].CustomerID
+	}
+}
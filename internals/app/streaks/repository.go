@@ -0,0 +1,90 @@
+package streaks
+
+import (
+	"context"
+	"time"
+
+	"wakuwaku_nihongo/internals/app/stats"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// The methods take a context.Context rather than an echo.Context as they
+// are shared with the rollover job, which runs outside of a request.
+type repo struct {
+	db *gorm.DB
+}
+
+func NewRepo(db *gorm.DB) *repo {
+	return &repo{
+		db: db,
+	}
+}
+
+// Transaction runs fn with a repo bound to a transaction.
+func (r *repo) Transaction(ctx context.Context, fn func(tx IStreakRepo) error) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(NewRepo(tx))
+	})
+}
+
+// GetForUpdate returns the customer's streak, creating it on first use, and
+// locks it until the transaction ends.
+func (r *repo) GetForUpdate(ctx context.Context, customerID string) (out *Streak, err error) {
+	db := r.db.WithContext(ctx)
+	err = db.Clauses(clause.OnConflict{DoNothing: true}).Create(&Streak{
+		CustomerID: customerID,
+		DailyGoal:  DEFAULT_DAILY_GOAL,
+	}).Error
+	if err != nil {
+		return
+	}
+
+	out = &Streak{}
+	err = db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("customer_id = ?", customerID).
+		First(out).Error
+	return
+}
+
+func (r *repo) Save(ctx context.Context, in *Streak) (err error) {
+	now := time.Now().UnixMilli()
+	return r.db.WithContext(ctx).Model(&Streak{}).
+		Where("customer_id = ?", in.CustomerID).
+		Updates(map[string]any{
+			"current_streak": in.CurrentStreak,
+			"longest_streak": in.LongestStreak,
+			"last_goal_day":  in.LastGoalDay,
+			"daily_goal":     in.DailyGoal,
+			"freezes":        in.Freezes,
+			"freezes_used":   in.FreezesUsed,
+			"modified_at":    now,
+		}).Error
+}
+
+// GetProgress returns the number of questions answered on day.
+func (r *repo) GetProgress(ctx context.Context, customerID string, day time.Time) (out int, err error) {
+	err = r.db.WithContext(ctx).Model(&stats.LearnerStat{}).
+		Select("COALESCE(SUM(total), 0)").
+		Where("customer_id = ? AND dimension = ? AND day = ?", customerID, stats.DIMENSION_OVERALL, day).
+		Scan(&out).Error
+	return
+}
+
+// ListOverdue returns running streaks whose goal was last met before
+// before, ordered by customer id and starting after the given id.
+func (r *repo) ListOverdue(ctx context.Context, before time.Time, after string, limit int) (out []*overdue, err error) {
+	out = []*overdue{}
+	db := r.db.WithContext(ctx).Table("customer_streaks s").
+		Select("s.customer_id, p.timezone").
+		Joins("LEFT JOIN customer_preferences p ON p.customer_id = s.customer_id").
+		Where("s.current_streak > 0 AND s.last_goal_day < ?", before)
+	if after != "" {
+		db = db.Where("s.customer_id > ?", after)
+	}
+	err = db.Order("s.customer_id").
+		Limit(limit).
+		Scan(&out).Error
+	return
+}
@@ -0,0 +1,18 @@
+package streaks
+
+import (
+	"github.com/labstack/echo/v4"
+	"wakuwaku_nihongo/internals/middleware"
+	"wakuwaku_nihongo/internals/utils/token"
+)
+
+func (h *handler) Route(g *echo.Group) {
+	g.GET("", h.Get, middleware.Authentication)
+	g.PUT("/goal", h.UpdateGoal, middleware.Authentication)
+}
+
+func (h *handler) AdminRoute(g *echo.Group) {
+	admin := middleware.Authorization(token.ROLE_ADMIN)
+
+	g.POST("/rollover", h.Rollover, middleware.Authentication, admin)
+}
@@ -0,0 +1,125 @@
+package streaks
+
+import (
+	"context"
+	"time"
+
+	"wakuwaku_nihongo/internals/app/preferences"
+	"wakuwaku_nihongo/internals/app/stats"
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/middleware"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+type IStreakRepo interface {
+	Transaction(ctx context.Context, fn func(tx IStreakRepo) error) error
+	GetForUpdate(ctx context.Context, customerID string) (out *Streak, err error)
+	Save(ctx context.Context, in *Streak) (err error)
+	GetProgress(ctx context.Context, customerID string, day time.Time) (out int, err error)
+	ListOverdue(ctx context.Context, before time.Time, after string, limit int) (out []*overdue, err error)
+}
+
+type ILocationProvider interface {
+	Location(ctx echo.Context, customerID string) *time.Location
+}
+
+type IRolloverJob interface {
+	Rollover(ctx context.Context, now time.Time) (out *RolloverResponse, err error)
+}
+
+type service struct {
+	repo      IStreakRepo
+	locations ILocationProvider
+	job       IRolloverJob
+}
+
+func NewService(f *factory.Factory) *service {
+	return &service{
+		repo:      NewRepo(f.Db),
+		locations: preferences.NewService(f),
+		job:       NewJob(f),
+	}
+}
+
+// Get returns the customer's streak, settling the days missed since their
+// last visit so it is right even before the rollover job has run.
+func (s *service) Get(ctx echo.Context) (out *StreakResponse, err error) {
+	return s.update(ctx, func(streak *Streak, today time.Time, progress int) bool {
+		frozen, reset := streak.Roll(today)
+		return frozen > 0 || reset
+	})
+}
+
+func (s *service) UpdateGoal(ctx echo.Context, in *GoalRequest) (out *StreakResponse, err error) {
+	return s.update(ctx, func(streak *Streak, today time.Time, progress int) bool {
+		streak.DailyGoal = in.DailyGoal
+		streak.Roll(today)
+		if progress >= streak.DailyGoal {
+			streak.MeetGoal(today)
+		}
+		return true
+	})
+}
+
+func (s *service) Rollover(ctx echo.Context) (out *RolloverResponse, err error) {
+	out, err = s.job.Rollover(ctx.Request().Context(), time.Now())
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	return
+}
+
+// update locks the customer's streak, lets fn change it and saves it when fn
+// reports a change.
+func (s *service) update(ctx echo.Context, fn func(streak *Streak, today time.Time, progress int) bool) (out *StreakResponse, err error) {
+	customerID := middleware.GetUserID(ctx)
+	loc := s.locations.Location(ctx, customerID)
+	today := stats.Day(time.Now().UnixMilli(), loc)
+	c := ctx.Request().Context()
+
+	err = s.repo.Transaction(c, func(tx IStreakRepo) error {
+		streak, err := tx.GetForUpdate(c, customerID)
+		if err != nil {
+			return err
+		}
+		progress, err := tx.GetProgress(c, customerID, today)
+		if err != nil {
+			return err
+		}
+		if fn(streak, today, progress) {
+			if err = tx.Save(c, streak); err != nil {
+				return err
+			}
+		}
+		out = &StreakResponse{}
+		out.MapFromModel(streak, today, loc, progress)
+		return nil
+	})
+	if err != nil {
+		out = nil
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	return
+}
+
+// Record marks day met in the customer's streak once their answers that
+// day reach the daily goal, it reads the stats recorded within db.
+func Record(ctx echo.Context, db *gorm.DB, customerID string, day time.Time) (err error) {
+	r := NewRepo(db)
+	c := ctx.Request().Context()
+	streak, err := r.GetForUpdate(c, customerID)
+	if err != nil {
+		return
+	}
+	progress, err := r.GetProgress(c, customerID, day)
+	if err != nil || progress < streak.DailyGoal {
+		return
+	}
+	if streak.MeetGoal(day) {
+		err = r.Save(c, streak)
+	}
+	return
+}
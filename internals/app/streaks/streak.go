@@ -0,0 +1,56 @@
+package streaks
+
+import "time"
+
+// Days are calendar dates at midnight UTC, see stats.Day.
+
+// GoalMet reports whether the daily goal was met on today.
+func (s *Streak) GoalMet(today time.Time) bool {
+	return s.LastGoalDay != nil && s.LastGoalDay.Equal(today)
+}
+
+// Roll settles the days missed before today. Each missed day uses up a
+// freeze, when there are not enough freezes the streak is lost and the
+// freezes are kept. It reports the freezes used and whether the streak was
+// reset.
+func (s *Streak) Roll(today time.Time) (frozen int, reset bool) {
+	if s.CurrentStreak == 0 || s.LastGoalDay == nil {
+		return
+	}
+	yesterday := today.AddDate(0, 0, -1)
+	if !s.LastGoalDay.Before(yesterday) {
+		return
+	}
+
+	missed := int(yesterday.Sub(*s.LastGoalDay).Hours() / 24)
+	if missed > s.Freezes {
+		s.CurrentStreak = 0
+		return 0, true
+	}
+	s.Freezes -= missed
+	s.FreezesUsed += missed
+	s.LastGoalDay = &yesterday
+	return missed, false
+}
+
+// MeetGoal counts today toward the streak, once a day. It reports whether the
+// streak changed.
+func (s *Streak) MeetGoal(today time.Time) bool {
+	s.Roll(today)
+	if s.GoalMet(today) {
+		return false
+	}
+
+	if s.CurrentStreak > 0 && s.LastGoalDay != nil && s.LastGoalDay.Equal(today.AddDate(0, 0, -1)) {
+		s.CurrentStreak++
+	} else {
+		s.CurrentStreak = 1
+	}
+	s.LongestStreak = max(s.LongestStreak, s.CurrentStreak)
+	s.LastGoalDay = &today
+
+	if s.CurrentStreak%FREEZE_EARN_EVERY == 0 && s.Freezes < MAX_FREEZES {
+		s.Freezes++
+	}
+	return true
+}
@@ -0,0 +1,107 @@
+package tests
+
+import (
+	"testing"
+	"time"
+	"wakuwaku_nihongo/internals/app/streaks"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func day(s string) time.Time {
+	t, _ := time.Parse("2006-01-02", s)
+	return t
+}
+
+func dayPtr(s string) *time.Time {
+	t := day(s)
+	return &t
+}
+
+func TestMeetGoal(t *testing.T) {
+	s := &streaks.Streak{DailyGoal: 10}
+
+	assert.True(t, s.MeetGoal(day("2026-03-01")))
+	assert.Equal(t, 1, s.CurrentStreak)
+	assert.False(t, s.MeetGoal(day("2026-03-01")), "a day counts once")
+	assert.True(t, s.GoalMet(day("2026-03-01")))
+	assert.False(t, s.GoalMet(day("2026-03-02")))
+
+	for d := 2; d <= 7; d++ {
+		s.MeetGoal(day("2026-03-01").AddDate(0, 0, d-1))
+	}
+	assert.Equal(t, 7, s.CurrentStreak)
+	assert.Equal(t, 7, s.LongestStreak)
+	assert.Equal(t, 1, s.Freezes, "a freeze is earned after a week")
+}
+
+func TestFreezesAreLimited(t *testing.T) {
+	s := &streaks.Streak{CurrentStreak: 20, LongestStreak: 20, LastGoalDay: dayPtr("2026-03-20"), Freezes: streaks.MAX_FREEZES}
+	s.MeetGoal(day("2026-03-21"))
+	assert.Equal(t, 21, s.CurrentStreak)
+	assert.Equal(t, streaks.MAX_FREEZES, s.Freezes)
+}
+
+func TestRoll(t *testing.T) {
+	tests := []struct {
+		name        string
+		streak      streaks.Streak
+		today       string
+		frozen      int
+		reset       bool
+		current     int
+		freezes     int
+		lastGoalDay string
+	}{
+		{
+			name:   "goal met yesterday",
+			streak: streaks.Streak{CurrentStreak: 3, LastGoalDay: dayPtr("2026-03-01"), Freezes: 1},
+			today:  "2026-03-02", current: 3, freezes: 1, lastGoalDay: "2026-03-01",
+		},
+		{
+			name:   "one missed day uses a freeze",
+			streak: streaks.Streak{CurrentStreak: 3, LastGoalDay: dayPtr("2026-03-01"), Freezes: 1},
+			today:  "2026-03-03", frozen: 1, current: 3, freezes: 0, lastGoalDay: "2026-03-02",
+		},
+		{
+			name:   "two missed days use two freezes",
+			streak: streaks.Streak{CurrentStreak: 9, LastGoalDay: dayPtr("2026-03-01"), Freezes: 2},
+			today:  "2026-03-04", frozen: 2, current: 9, freezes: 0, lastGoalDay: "2026-03-03",
+		},
+		{
+			name:   "not enough freezes",
+			streak: streaks.Streak{CurrentStreak: 9, LastGoalDay: dayPtr("2026-03-01"), Freezes: 1},
+			today:  "2026-03-04", reset: true, current: 0, freezes: 1, lastGoalDay: "2026-03-01",
+		},
+		{
+			name:   "no streak",
+			streak: streaks.Streak{LastGoalDay: dayPtr("2026-03-01"), Freezes: 1},
+			today:  "2026-03-10", current: 0, freezes: 1, lastGoalDay: "2026-03-01",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := tt.streak
+			frozen, reset := s.Roll(day(tt.today))
+			assert.Equal(t, tt.frozen, frozen)
+			assert.Equal(t, tt.reset, reset)
+			assert.Equal(t, tt.current, s.CurrentStreak)
+			assert.Equal(t, tt.freezes, s.Freezes)
+			assert.Equal(t, day(tt.lastGoalDay), *s.LastGoalDay)
+			assert.Equal(t, tt.frozen, s.FreezesUsed)
+		})
+	}
+}
+
+func TestMeetGoalAfterBreak(t *testing.T) {
+	s := &streaks.Streak{CurrentStreak: 5, LongestStreak: 8, LastGoalDay: dayPtr("2026-03-01")}
+	s.MeetGoal(day("2026-03-05"))
+	assert.Equal(t, 1, s.CurrentStreak)
+	assert.Equal(t, 8, s.LongestStreak)
+
+	s = &streaks.Streak{CurrentStreak: 5, LongestStreak: 5, LastGoalDay: dayPtr("2026-03-01"), Freezes: 1}
+	s.MeetGoal(day("2026-03-03"))
+	assert.Equal(t, 6, s.CurrentStreak, "the freeze bridges the missed day")
+	assert.Equal(t, 0, s.Freezes)
+}
@@ -0,0 +1,33 @@
+package vocabulary
+
+const (
+	DEFAULT_SORT_BY = "word"
+
+	QUESTION_KIND_READING = "reading"
+	QUESTION_KIND_WRITING = "writing"
+	QUESTION_KIND_MEANING = "meaning"
+	QUESTION_KIND_USAGE   = "usage"
+
+	// DISTRACTOR_COUNT is the number of wrong answers of a generated question.
+	DISTRACTOR_COUNT = 3
+
+	DEFAULT_GENERATE_LIMIT = 20
+	GENERATOR_QUIZ_TITLE   = "Generated vocabulary quiz"
+)
+
+var SORTABLE_COLUMNS = map[string]bool{
+	"word":        true,
+	"reading":     true,
+	"jlpt_level":  true,
+	"created_at":  true,
+	"modified_at": true,
+}
+
+// QUESTION_TEMPLATES are the question texts of the generated questions, %s is
+// the word or, for writing questions, its reading.
+var QUESTION_TEMPLATES = map[string]string{
+	QUESTION_KIND_READING: "「%s」の読み方として正しいものはどれですか。",
+	QUESTION_KIND_WRITING: "「%s」を漢字で書くとどれですか。",
+	QUESTION_KIND_MEANING: "「%s」の意味として正しいものはどれですか。",
+	QUESTION_KIND_USAGE:   "「%s」の使い方として最もよいものはどれですか。",
+}
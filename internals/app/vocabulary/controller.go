@@ -0,0 +1,214 @@
+package vocabulary
+
+import (
+	"wakuwaku_nihongo/internals/abstraction"
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+type IVocabularyService interface {
+	List(ctx echo.Context, filter *VocabularyFilter) (out []*VocabularyResponse, info *abstraction.PaginationInfo, err error)
+	Get(ctx echo.Context, id string) (out *VocabularyResponse, err error)
+	Create(ctx echo.Context, in *VocabularyRequest) (out *VocabularyResponse, err error)
+	Update(ctx echo.Context, id string, in *VocabularyRequest) (out *VocabularyResponse, err error)
+	Delete(ctx echo.Context, id string) (err error)
+	Restore(ctx echo.Context, id string) (out *VocabularyResponse, err error)
+	Generate(ctx echo.Context, in *GenerateRequest) (out *GenerateResponse, err error)
+}
+
+type handler struct {
+	service IVocabularyService
+}
+
+func NewHandler(f *factory.Factory) *handler {
+	return &handler{
+		service: NewService(f),
+	}
+}
+
+// @Summary Get List of Vocabulary
+// @Description Get list of vocabulary filtered by JLPT level, part of speech or text
+// @Tags vocabulary
+// @Produce json
+// @Param jlpt_level query string false "JLPT level" Enums(N5, N4, N3, N2, N1)
+// @Param part_of_speech query string false "Part of speech" Enums(noun, verb, i_adjective, na_adjective, adverb, expression, other)
+// @Param q query string false "Search word, reading or meaning"
+// @Param include_deleted query bool false "Include deleted vocabulary (admin only)"
+// @Param page query int false "Page"
+// @Param page_size query int false "Page size"
+// @Success 200 {object} response.SuccessResponseWithInfo{data=[]VocabularyResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/vocabulary [get]
+func (h *handler) List(c echo.Context) error {
+	req := &VocabularyFilter{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+	asc := "asc"
+	req.ChangeDefaultSortingClause(DEFAULT_SORT_BY, &asc)
+	req.Pagination.SetDefault()
+
+	res, info, err := h.service.List(c, req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponseInfo(res, info).Send(c)
+}
+
+// @Summary Get Vocabulary
+// @Description Get vocabulary
+// @Tags vocabulary
+// @Produce json
+// @Param id path string true "Vocabulary ID"
+// @Success 200 {object} response.Success{data=VocabularyResponse}
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/vocabulary/{id} [get]
+func (h *handler) Get(c echo.Context) error {
+	res, err := h.service.Get(c, c.Param("id"))
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Create Vocabulary
+// @Description Create new vocabulary (editor only)
+// @Tags vocabulary
+// @Accept json
+// @Produce json
+// @Param payload body VocabularyRequest true "Payload"
+// @Success 200 {object} response.Success{data=VocabularyResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/vocabulary [post]
+func (h *handler) Create(c echo.Context) error {
+	req := &VocabularyRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.Create(c, req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Update Vocabulary
+// @Description Update vocabulary (editor only)
+// @Tags vocabulary
+// @Accept json
+// @Produce json
+// @Param id path string true "Vocabulary ID"
+// @Param payload body VocabularyRequest true "Payload"
+// @Success 200 {object} response.Success{data=VocabularyResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/vocabulary/{id} [put]
+func (h *handler) Update(c echo.Context) error {
+	req := &VocabularyRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.Update(c, c.Param("id"), req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Delete Vocabulary
+// @Description Soft delete vocabulary (editor only)
+// @Tags vocabulary
+// @Produce json
+// @Param id path string true "Vocabulary ID"
+// @Success 200 {object} response.Success{data=string}
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/vocabulary/{id} [delete]
+func (h *handler) Delete(c echo.Context) error {
+	err := h.service.Delete(c, c.Param("id"))
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse("deleted").Send(c)
+}
+
+// @Summary Restore Vocabulary
+// @Description Restore deleted vocabulary (admin only)
+// @Tags vocabulary
+// @Produce json
+// @Param id path string true "Vocabulary ID"
+// @Success 200 {object} response.Success{data=VocabularyResponse}
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/vocabulary/{id}/restore [post]
+func (h *handler) Restore(c echo.Context) error {
+	res, err := h.service.Restore(c, c.Param("id"))
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
+
+// @Summary Generate Vocabulary Questions
+// @Description Generate reading, writing, meaning and usage questions from vocabulary and save them in a new draft quiz (editor only). Without vocabulary_ids random words of jlpt_level are used
+// @Tags vocabulary
+// @Accept json
+// @Produce json
+// @Param payload body GenerateRequest true "Payload"
+// @Success 200 {object} response.Success{data=GenerateResponse}
+// @Failure 400 {object} response.errorResponse
+// @Failure 403 {object} response.errorResponse
+// @Failure 404 {object} response.errorResponse
+// @Failure 500 {object} response.errorResponse
+// @Param Authorization header string true "Bearer Token"
+// @Router /api/v1/vocabulary/generate [post]
+func (h *handler) Generate(c echo.Context) error {
+	req := &GenerateRequest{}
+	err := c.Bind(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrUnprocessableEntity, err).Send(c)
+	}
+
+	err = c.Validate(req)
+	if err != nil {
+		return response.ErrorWrap(response.ErrValidation, err).Send(c)
+	}
+
+	res, err := h.service.Generate(c, req)
+	if err != nil {
+		return response.ErrorResponse(err).Send(c)
+	}
+	return response.SuccessResponse(res).Send(c)
+}
@@ -0,0 +1,98 @@
+package vocabulary
+
+import (
+	"wakuwaku_nihongo/internals/abstraction"
+	"wakuwaku_nihongo/internals/app/quizzes"
+	"wakuwaku_nihongo/internals/model"
+)
+
+type VocabularyRequest struct {
+	Word            string  `json:"word" validate:"required"`
+	Reading         string  `json:"reading" validate:"required,hiragana"`
+	Meaning         string  `json:"meaning" validate:"required"`
+	PartOfSpeech    string  `json:"part_of_speech" validate:"required,oneof=noun verb i_adjective na_adjective adverb expression other"`
+	JlptLevel       string  `json:"jlpt_level" validate:"required,oneof=N5 N4 N3 N2 N1"`
+	ExampleSentence *string `json:"example_sentence" validate:"omitempty,ruby"`
+	EntSeq          *int64  `json:"ent_seq"`
+}
+
+type VocabularyFilter struct {
+	JlptLevel    string `query:"jlpt_level"`
+	PartOfSpeech string `query:"part_of_speech"`
+	Search       string `query:"q"`
+	abstraction.DeletedFilter
+	abstraction.Pagination
+}
+
+type GenerateRequest struct {
+	VocabularyIDs []string `json:"vocabulary_ids" validate:"required_without=JlptLevel,omitempty,dive,uuid"`
+	JlptLevel     string   `json:"jlpt_level" validate:"omitempty,oneof=N5 N4 N3 N2 N1"`
+	Kinds         []string `json:"kinds" validate:"required,min=1,dive,oneof=reading writing meaning usage"`
+	Limit         int      `json:"limit" validate:"omitempty,min=1,max=100"`
+	Title         *string  `json:"title"`
+}
+
+type VocabularyResponse struct {
+	VocabularyID    string  `json:"vocabulary_id"`
+	Word            string  `json:"word"`
+	Reading         string  `json:"reading"`
+	Meaning         string  `json:"meaning"`
+	PartOfSpeech    string  `json:"part_of_speech"`
+	JlptLevel       string  `json:"jlpt_level"`
+	ExampleSentence *string `json:"example_sentence"`
+	EntSeq          *int64  `json:"ent_seq"`
+	DeletedAt       *int64  `json:"deleted_at,omitempty"`
+	DeletedBy       *string `json:"deleted_by,omitempty"`
+}
+
+type GenerateResponse struct {
+	QuizID    string                      `json:"quiz_id"`
+	Title     string                      `json:"title"`
+	Status    string                      `json:"status"`
+	JlptLevel *string                     `json:"jlpt_level"`
+	Questions []*quizzes.QuestionResponse `json:"questions"`
+	Skipped   []*SkippedResponse          `json:"skipped"`
+}
+
+type SkippedResponse struct {
+	VocabularyID string `json:"vocabulary_id"`
+	Word         string `json:"word"`
+	Kind         string `json:"kind"`
+	Reason       string `json:"reason"`
+}
+
+func (r *VocabularyRequest) MapToModel(m *Vocabulary) {
+	m.Word = r.Word
+	m.Reading = r.Reading
+	m.Meaning = r.Meaning
+	m.PartOfSpeech = r.PartOfSpeech
+	m.JlptLevel = r.JlptLevel
+	m.ExampleSentence = r.ExampleSentence
+	m.EntSeq = r.EntSeq
+}
+
+func (r *VocabularyResponse) MapFromModel(m *Vocabulary) {
+	r.VocabularyID = m.VocabularyID
+	r.Word = m.Word
+	r.Reading = m.Reading
+	r.Meaning = m.Meaning
+	r.PartOfSpeech = m.PartOfSpeech
+	r.JlptLevel = m.JlptLevel
+	r.ExampleSentence = m.ExampleSentence
+	r.EntSeq = m.EntSeq
+	r.DeletedAt = m.DeletedAt.Ptr()
+	r.DeletedBy = m.DeletedBy
+}
+
+func (r *GenerateResponse) MapFromModel(m *model.Quiz) {
+	r.QuizID = m.QuizID
+	r.Title = m.Title
+	r.Status = m.Status
+	r.JlptLevel = m.JlptLevel
+	r.Questions = []*quizzes.QuestionResponse{}
+	for _, q := range m.Questions {
+		question := &quizzes.QuestionResponse{}
+		question.MapFromModel(q)
+		r.Questions = append(r.Questions, question)
+	}
+}
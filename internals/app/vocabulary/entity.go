@@ -0,0 +1,45 @@
+package vocabulary
+
+import (
+	"time"
+
+	"wakuwaku_nihongo/internals/pkg/softdelete"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type Vocabulary struct {
+	VocabularyID    string               `gorm:"column:vocabulary_id;type:uuid;primaryKey" json:"vocabulary_id"`
+	CreatedAt       int64                `gorm:"column:created_at;type:bigint;not null" json:"created_at"`
+	ModifiedAt      *int64               `gorm:"column:modified_at;type:bigint" json:"modified_at"`
+	DeletedAt       softdelete.DeletedAt `gorm:"column:deleted_at;type:bigint" json:"deleted_at"`
+	CreatedBy       string               `gorm:"column:created_by;type:character varying;not null" json:"created_by"`
+	ModifiedBy      *string              `gorm:"column:modified_by;type:character varying" json:"modified_by"`
+	DeletedBy       *string              `gorm:"column:deleted_by;type:character varying" json:"deleted_by"`
+	Word            string               `gorm:"column:word;type:character varying;not null" json:"word"`
+	Reading         string               `gorm:"column:reading;type:character varying;not null" json:"reading"`
+	Meaning         string               `gorm:"column:meaning;type:character varying;not null" json:"meaning"`
+	PartOfSpeech    string               `gorm:"column:part_of_speech;type:character varying;not null" json:"part_of_speech"`
+	JlptLevel       string               `gorm:"column:jlpt_level;type:character varying;not null" json:"jlpt_level"`
+	ExampleSentence *string              `gorm:"column:example_sentence;type:character varying" json:"example_sentence"`
+	EntSeq          *int64               `gorm:"column:ent_seq;type:bigint" json:"ent_seq"`
+}
+
+func (*Vocabulary) TableName() string {
+	return "vocabularies"
+}
+
+func (m *Vocabulary) BeforeCreate(tx *gorm.DB) (err error) {
+	m.CreatedAt = time.Now().UnixMilli()
+	if m.VocabularyID == "" {
+		m.VocabularyID = uuid.NewString()
+	}
+	return
+}
+
+func (m *Vocabulary) BeforeUpdate(tx *gorm.DB) (err error) {
+	now := time.Now().UnixMilli()
+	m.ModifiedAt = &now
+	return
+}
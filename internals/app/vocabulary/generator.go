@@ -0,0 +1,237 @@
+package vocabulary
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+
+	"wakuwaku_nihongo/internals/app/quizzes"
+	"wakuwaku_nihongo/internals/model"
+	"wakuwaku_nihongo/internals/pkg/kana"
+	"wakuwaku_nihongo/internals/pkg/morph"
+	"wakuwaku_nihongo/internals/pkg/normalizer"
+	"wakuwaku_nihongo/internals/pkg/ruby"
+)
+
+var (
+	ErrNoKanji              = errors.New("word has no kanji")
+	ErrNoExampleSentence    = errors.New("word has no example sentence")
+	ErrNotEnoughDistractors = errors.New("not enough distractors")
+	ErrUnknownQuestionKind  = errors.New("unknown question kind")
+	ErrExampleWithoutWord   = errors.New("example sentence does not contain the word")
+)
+
+// Generator builds multiple choice questions from vocabulary. Distractors
+// are taken from the pool, preferring words of the same JLPT level and part
+// of speech.
+type Generator struct {
+	pool []*Vocabulary
+}
+
+func NewGenerator(pool []*Vocabulary) *Generator {
+	return &Generator{pool: pool}
+}
+
+// Generate returns an unsaved question of the given kind about v.
+func (g *Generator) Generate(v *Vocabulary, kind string) (out *model.Question, err error) {
+	var text string
+	var distractors []string
+	correct := v.Word
+
+	switch kind {
+	case QUESTION_KIND_READING:
+		if !kana.ContainsKanji(v.Word) {
+			return nil, ErrNoKanji
+		}
+		text = v.Word
+		correct = v.Reading
+		distractors = g.readingDistractors(v)
+
+	case QUESTION_KIND_WRITING:
+		if !kana.ContainsKanji(v.Word) {
+			return nil, ErrNoKanji
+		}
+		text = v.Reading
+		distractors = g.writingDistractors(v)
+
+	case QUESTION_KIND_MEANING:
+		text = ruby.Markup(morph.Align(v.Word, v.Reading))
+		correct = v.Meaning
+		distractors = g.meaningDistractors(v)
+
+	case QUESTION_KIND_USAGE:
+		if v.ExampleSentence == nil {
+			return nil, ErrNoExampleSentence
+		}
+		correct = ruby.Strip(*v.ExampleSentence)
+		if !strings.Contains(correct, v.Word) {
+			return nil, ErrExampleWithoutWord
+		}
+		text = v.Word
+		distractors = g.usageDistractors(v)
+
+	default:
+		return nil, ErrUnknownQuestionKind
+	}
+
+	if len(distractors) < DISTRACTOR_COUNT {
+		return nil, ErrNotEnoughDistractors
+	}
+
+	questionType := quizzes.QUESTION_TYPE_MULTIPLE_CHOICE
+	out = &model.Question{
+		QuestionText: fmt.Sprintf(QUESTION_TEMPLATES[kind], text),
+		QuestionType: &questionType,
+		GradingMode:  string(normalizer.DefaultMode),
+	}
+
+	// Place the correct answer at a position that depends on the word so a
+	// generated quiz does not always have the same answer position.
+	pos := int(hash(v.VocabularyID+kind) % uint32(DISTRACTOR_COUNT+1))
+	for i, d := range distractors[:DISTRACTOR_COUNT] {
+		if i == pos {
+			out.Answers = append(out.Answers, &model.Answer{AnswerText: correct, IsCorrect: true})
+		}
+		out.Answers = append(out.Answers, &model.Answer{AnswerText: d})
+	}
+	if pos == DISTRACTOR_COUNT {
+		out.Answers = append(out.Answers, &model.Answer{AnswerText: correct, IsCorrect: true})
+	}
+	return
+}
+
+// readingDistractors prefers readings that sound like the correct one, then
+// readings of words sharing a kanji, then readings of similar words of the
+// same length.
+func (g *Generator) readingDistractors(v *Vocabulary) []string {
+	exclude := append(g.readingsOf(v.Word), kana.ToHiragana(v.Reading))
+	out := newDistinct(exclude...)
+	out.add(SimilarReadings(v.Reading)...)
+	for _, c := range g.candidates(v) {
+		if sharesKanji(c.Word, v.Word) {
+			out.add(kana.ToHiragana(c.Reading))
+		}
+	}
+	for _, c := range g.candidates(v) {
+		if len([]rune(c.Reading)) == len([]rune(v.Reading)) {
+			out.add(kana.ToHiragana(c.Reading))
+		}
+	}
+	return out.values
+}
+
+// writingDistractors prefers words made of lookalike kanji, then homophones.
+func (g *Generator) writingDistractors(v *Vocabulary) []string {
+	out := newDistinct(v.Word)
+	out.add(LookalikeWords(v.Word)...)
+	for _, c := range g.candidates(v) {
+		if kana.ToHiragana(c.Reading) == kana.ToHiragana(v.Reading) {
+			out.add(c.Word)
+		}
+	}
+	return out.values
+}
+
+func (g *Generator) meaningDistractors(v *Vocabulary) []string {
+	out := newDistinct(v.Meaning)
+	for _, c := range g.candidates(v) {
+		out.add(c.Meaning)
+	}
+	return out.values
+}
+
+// usageDistractors put the word into example sentences of other words of the
+// same part of speech, which makes sentences where it is misused.
+func (g *Generator) usageDistractors(v *Vocabulary) []string {
+	out := newDistinct(ruby.Strip(*v.ExampleSentence))
+	for _, c := range g.candidates(v) {
+		if c.ExampleSentence == nil || c.PartOfSpeech != v.PartOfSpeech {
+			continue
+		}
+		sentence := ruby.Strip(*c.ExampleSentence)
+		if !strings.Contains(sentence, c.Word) {
+			continue
+		}
+		out.add(strings.Replace(sentence, c.Word, v.Word, 1))
+	}
+	return out.values
+}
+
+// candidates returns the other words of the pool, same JLPT level and part of
+// speech first, then same part of speech, then same level.
+func (g *Generator) candidates(v *Vocabulary) []*Vocabulary {
+	out := []*Vocabulary{}
+	for _, c := range g.pool {
+		if c.VocabularyID != v.VocabularyID && c.Word != v.Word {
+			out = append(out, c)
+		}
+	}
+
+	rank := func(c *Vocabulary) int {
+		r := 0
+		if c.PartOfSpeech != v.PartOfSpeech {
+			r += 2
+		}
+		if c.JlptLevel != v.JlptLevel {
+			r++
+		}
+		return r
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return rank(out[i]) < rank(out[j])
+	})
+	return out
+}
+
+// readingsOf returns every reading the pool knows for word, so a distractor
+// is never another valid reading.
+func (g *Generator) readingsOf(word string) []string {
+	out := []string{}
+	for _, c := range g.pool {
+		if c.Word == word {
+			out = append(out, kana.ToHiragana(c.Reading))
+		}
+	}
+	return out
+}
+
+func sharesKanji(a, b string) bool {
+	for _, r := range a {
+		if kana.IsKanjiRune(r) && strings.ContainsRune(b, r) {
+			return true
+		}
+	}
+	return false
+}
+
+func hash(s string) uint32 {
+	h := fnv.New32a()
+	h.Write([]byte(s))
+	return h.Sum32()
+}
+
+// distinct collects values in order, skipping duplicates and excluded ones.
+type distinct struct {
+	seen   map[string]bool
+	values []string
+}
+
+func newDistinct(exclude ...string) *distinct {
+	d := &distinct{seen: map[string]bool{}}
+	for _, e := range exclude {
+		d.seen[e] = true
+	}
+	return d
+}
+
+func (d *distinct) add(values ...string) {
+	for _, v := range values {
+		if v == "" || d.seen[v] {
+			continue
+		}
+		d.seen[v] = true
+		d.values = append(d.values, v)
+	}
+}
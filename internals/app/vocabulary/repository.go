@@ -0,0 +1,136 @@
+package vocabulary
+
+import (
+	"wakuwaku_nihongo/internals/app/revisions"
+	"wakuwaku_nihongo/internals/model"
+	"wakuwaku_nihongo/internals/pkg/softdelete"
+	"wakuwaku_nihongo/internals/pkg/sqlutil"
+	"wakuwaku_nihongo/internals/query"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type repo struct {
+	db *gorm.DB
+	*query.Query
+}
+
+func NewRepo(db *gorm.DB) *repo {
+	return &repo{
+		db:    db,
+		Query: query.Use(db),
+	}
+}
+
+func (r *repo) List(ctx echo.Context, filter *VocabularyFilter) (out []*Vocabulary, count int64, err error) {
+	db := r.db.Model(&Vocabulary{})
+	if filter.IncludeDeleted {
+		db = db.Unscoped()
+	}
+	if filter.JlptLevel != "" {
+		db = db.Where("jlpt_level = ?", filter.JlptLevel)
+	}
+	if filter.PartOfSpeech != "" {
+		db = db.Where("part_of_speech = ?", filter.PartOfSpeech)
+	}
+	if filter.Search != "" {
+		like := sqlutil.Contains(filter.Search)
+		db = db.Where("word ILIKE ? OR reading ILIKE ? OR meaning ILIKE ?", like, like, like)
+	}
+	db = db.Session(&gorm.Session{})
+
+	if err = db.Count(&count).Error; err != nil {
+		return
+	}
+
+	out = []*Vocabulary{}
+	sortBy := *filter.SortBy
+	if !SORTABLE_COLUMNS[sortBy] {
+		sortBy = DEFAULT_SORT_BY
+	}
+	err = db.Order(clause.OrderByColumn{
+		Column: clause.Column{Name: sortBy},
+		Desc:   filter.GetOrderBy() == "desc",
+	}).
+		Limit(filter.Limit()).
+		Offset(filter.Offset()).
+		Find(&out).Error
+	return
+}
+
+func (r *repo) GetByID(ctx echo.Context, id string) (out *Vocabulary, err error) {
+	out = &Vocabulary{}
+	err = r.db.Where("vocabulary_id = ?", id).First(out).Error
+	return
+}
+
+func (r *repo) GetByIDs(ctx echo.Context, ids []string) (out []*Vocabulary, err error) {
+	out = []*Vocabulary{}
+	err = r.db.Where("vocabulary_id IN ?", ids).
+		Order("word").
+		Find(&out).Error
+	return
+}
+
+func (r *repo) GetByLevel(ctx echo.Context, jlptLevel string, limit int) (out []*Vocabulary, err error) {
+	out = []*Vocabulary{}
+	err = r.db.Where("jlpt_level = ?", jlptLevel).
+		Order("RANDOM()").
+		Limit(limit).
+		Find(&out).Error
+	return
+}
+
+// GetPool returns the vocabulary distractors are picked from: every word of
+// the given levels and, for the part of speech fallback, of the given parts
+// of speech.
+func (r *repo) GetPool(ctx echo.Context, jlptLevels []string, partsOfSpeech []string) (out []*Vocabulary, err error) {
+	out = []*Vocabulary{}
+	err = r.db.Where("jlpt_level IN ? OR part_of_speech IN ?", jlptLevels, partsOfSpeech).
+		Find(&out).Error
+	return
+}
+
+func (r *repo) Create(ctx echo.Context, in *Vocabulary) (err error) {
+	return r.db.Create(in).Error
+}
+
+func (r *repo) Update(ctx echo.Context, in *Vocabulary) (err error) {
+	return r.db.Select("word", "reading", "meaning", "part_of_speech", "jlpt_level",
+		"example_sentence", "ent_seq", "modified_at", "modified_by").
+		Updates(in).Error
+}
+
+func (r *repo) Delete(ctx echo.Context, id string, deletedBy string) (err error) {
+	return softdelete.By(r.db, deletedBy).Where("vocabulary_id = ?", id).Delete(&Vocabulary{}).Error
+}
+
+// Restore undeletes the vocabulary, restored is false when it was not
+// deleted.
+func (r *repo) Restore(ctx echo.Context, id string) (restored bool, err error) {
+	res := softdelete.Restore(r.db.Model(&Vocabulary{}).Where("vocabulary_id = ?", id))
+	return res.RowsAffected > 0, res.Error
+}
+
+// CreateQuiz saves the quiz with its questions and their answers, each
+// question as its first revision.
+func (r *repo) CreateQuiz(ctx echo.Context, in *model.Quiz) (err error) {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := query.Use(tx).Quiz.Create(in); err != nil {
+			return err
+		}
+		for _, q := range in.Questions {
+			_, err := revisions.Record(ctx, tx, &revisions.Revision{
+				CreatedBy:  q.CreatedBy,
+				QuestionID: q.QuestionID,
+				Action:     revisions.ACTION_CREATE,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,252 @@
+package vocabulary
+
+import (
+	"errors"
+	"fmt"
+
+	"wakuwaku_nihongo/config"
+	"wakuwaku_nihongo/internals/abstraction"
+	"wakuwaku_nihongo/internals/app/quizzes"
+	"wakuwaku_nihongo/internals/app/search"
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/middleware"
+	"wakuwaku_nihongo/internals/model"
+	"wakuwaku_nihongo/internals/utils/response"
+	"wakuwaku_nihongo/internals/utils/token"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+type IVocabularyRepo interface {
+	List(ctx echo.Context, filter *VocabularyFilter) (out []*Vocabulary, count int64, err error)
+	GetByID(ctx echo.Context, id string) (out *Vocabulary, err error)
+	GetByIDs(ctx echo.Context, ids []string) (out []*Vocabulary, err error)
+	GetByLevel(ctx echo.Context, jlptLevel string, limit int) (out []*Vocabulary, err error)
+	GetPool(ctx echo.Context, jlptLevels []string, partsOfSpeech []string) (out []*Vocabulary, err error)
+	Create(ctx echo.Context, in *Vocabulary) (err error)
+	Update(ctx echo.Context, in *Vocabulary) (err error)
+	Delete(ctx echo.Context, id string, deletedBy string) (err error)
+	Restore(ctx echo.Context, id string) (restored bool, err error)
+	CreateQuiz(ctx echo.Context, in *model.Quiz) (err error)
+}
+
+type ISearchIndexer interface {
+	IndexQuestion(ctx echo.Context, questionID string) (err error)
+}
+
+type service struct {
+	repo   IVocabularyRepo
+	search ISearchIndexer
+}
+
+func NewService(f *factory.Factory) *service {
+	return &service{
+		repo:   NewRepo(f.Db),
+		search: search.NewService(f),
+	}
+}
+
+func (s *service) List(ctx echo.Context, filter *VocabularyFilter) (out []*VocabularyResponse, info *abstraction.PaginationInfo, err error) {
+	if filter.IncludeDeleted && middleware.GetRole(ctx) != token.ROLE_ADMIN {
+		err = response.ErrorWrap(response.ErrForbidden, fmt.Errorf("only admins can include deleted vocabulary"))
+		return
+	}
+
+	words, count, err := s.repo.List(ctx, filter)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	out = []*VocabularyResponse{}
+	for _, val := range words {
+		word := &VocabularyResponse{}
+		word.MapFromModel(val)
+		out = append(out, word)
+	}
+	info = filter.Pagination.CreatePageInfo(count)
+	return
+}
+
+func (s *service) Get(ctx echo.Context, id string) (out *VocabularyResponse, err error) {
+	word, err := s.getByID(ctx, id)
+	if err != nil {
+		return
+	}
+	out = &VocabularyResponse{}
+	out.MapFromModel(word)
+	return
+}
+
+func (s *service) Create(ctx echo.Context, in *VocabularyRequest) (out *VocabularyResponse, err error) {
+	word := &Vocabulary{}
+	in.MapToModel(word)
+	word.CreatedBy = middleware.GetUserID(ctx)
+
+	err = s.repo.Create(ctx, word)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	out = &VocabularyResponse{}
+	out.MapFromModel(word)
+	return
+}
+
+func (s *service) Update(ctx echo.Context, id string, in *VocabularyRequest) (out *VocabularyResponse, err error) {
+	word, err := s.getByID(ctx, id)
+	if err != nil {
+		return
+	}
+
+	in.MapToModel(word)
+	userID := middleware.GetUserID(ctx)
+	word.ModifiedBy = &userID
+
+	err = s.repo.Update(ctx, word)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	out = &VocabularyResponse{}
+	out.MapFromModel(word)
+	return
+}
+
+func (s *service) Delete(ctx echo.Context, id string) (err error) {
+	if _, err = s.getByID(ctx, id); err != nil {
+		return
+	}
+
+	err = s.repo.Delete(ctx, id, middleware.GetUserID(ctx))
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	return
+}
+
+// Restore undeletes vocabulary (admin only).
+func (s *service) Restore(ctx echo.Context, id string) (out *VocabularyResponse, err error) {
+	restored, err := s.repo.Restore(ctx, id)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	if !restored {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("deleted vocabulary not found"))
+		return
+	}
+	return s.Get(ctx, id)
+}
+
+// Generate builds questions of every requested kind for the selected words
+// and saves them in a new draft quiz. Questions that cannot be built, for
+// lack of kanji, example sentence or distractors, are reported as skipped.
+func (s *service) Generate(ctx echo.Context, in *GenerateRequest) (out *GenerateResponse, err error) {
+	limit := in.Limit
+	if limit == 0 {
+		limit = DEFAULT_GENERATE_LIMIT
+	}
+
+	var words []*Vocabulary
+	if len(in.VocabularyIDs) > 0 {
+		words, err = s.repo.GetByIDs(ctx, config.UniqueStrings(in.VocabularyIDs))
+	} else {
+		words, err = s.repo.GetByLevel(ctx, in.JlptLevel, limit)
+	}
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	if len(words) == 0 {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("no vocabulary found"))
+		return
+	}
+	if len(words) > limit {
+		words = words[:limit]
+	}
+
+	levels, partsOfSpeech := []string{}, []string{}
+	for _, w := range words {
+		levels = append(levels, w.JlptLevel)
+		partsOfSpeech = append(partsOfSpeech, w.PartOfSpeech)
+	}
+	levels = config.UniqueStrings(levels)
+	pool, err := s.repo.GetPool(ctx, levels, config.UniqueStrings(partsOfSpeech))
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+
+	userID := middleware.GetUserID(ctx)
+	generator := NewGenerator(pool)
+	skipped := []*SkippedResponse{}
+	questions := []*model.Question{}
+	for _, w := range words {
+		for _, kind := range config.UniqueStrings(in.Kinds) {
+			question, genErr := generator.Generate(w, kind)
+			if genErr != nil {
+				skipped = append(skipped, &SkippedResponse{
+					VocabularyID: w.VocabularyID,
+					Word:         w.Word,
+					Kind:         kind,
+					Reason:       genErr.Error(),
+				})
+				continue
+			}
+			question.CreatedBy = userID
+			for _, a := range question.Answers {
+				a.CreatedBy = userID
+			}
+			questions = append(questions, question)
+		}
+	}
+	if len(questions) == 0 {
+		err = response.ErrorWrap(response.ErrBadRequest, fmt.Errorf("no question could be generated"))
+		return
+	}
+
+	quiz := &model.Quiz{
+		CreatedBy: userID,
+		Title:     GENERATOR_QUIZ_TITLE,
+		Status:    quizzes.QUIZ_STATUS_DRAFT,
+		Questions: questions,
+	}
+	if in.Title != nil && *in.Title != "" {
+		quiz.Title = *in.Title
+	}
+	if len(levels) == 1 {
+		quiz.JlptLevel = &levels[0]
+	}
+
+	err = s.repo.CreateQuiz(ctx, quiz)
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+		return
+	}
+	for _, q := range quiz.Questions {
+		if err := s.search.IndexQuestion(ctx, q.QuestionID); err != nil {
+			log.Error().Err(err).Str("question_id", q.QuestionID).Msg("error indexing question")
+		}
+	}
+
+	out = &GenerateResponse{Skipped: skipped}
+	out.MapFromModel(quiz)
+	return
+}
+
+func (s *service) getByID(ctx echo.Context, id string) (out *Vocabulary, err error) {
+	out, err = s.repo.GetByID(ctx, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err = response.ErrorWrap(response.ErrNotFound, fmt.Errorf("vocabulary not found"))
+		return
+	}
+	if err != nil {
+		err = response.ErrorWrap(response.ErrInternalServerError, err)
+	}
+	return
+}
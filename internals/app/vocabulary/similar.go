@@ -0,0 +1,186 @@
+package vocabulary
+
+import (
+	"strings"
+
+	"wakuwaku_nihongo/internals/pkg/kana"
+)
+
+// similarKanjiGroups are kanji that learners commonly confuse because they
+// look alike.
+var similarKanjiGroups = []string{
+	"待持特時侍", "末未本", "土士上", "人入八", "大太犬天夫", "右石若", "日目白百旧",
+	"千干午牛", "力刀刃万方", "己已巳", "王玉主", "田由甲申", "問間聞開閉", "読続説話語",
+	"住注柱往", "晴清請情精", "験検険剣", "積績責", "復複腹", "官管館", "代伐化",
+	"会合今", "体休", "困因固", "学字", "買貸質", "員貝見", "使便", "洗先", "古占",
+	"計討", "考老孝", "貨貸", "義議儀犠", "減感", "暖援緩", "輸愉諭", "側測則",
+	"険験", "機械", "栄営", "借措", "約紙級", "場湯陽", "週調周", "銀根限眼",
+}
+
+var similarKanji = buildSimilarKanji()
+
+func buildSimilarKanji() map[rune][]rune {
+	out := map[rune][]rune{}
+	for _, group := range similarKanjiGroups {
+		rs := []rune(group)
+		for _, r := range rs {
+			for _, other := range rs {
+				if other != r && !containsRune(out[r], other) {
+					out[r] = append(out[r], other)
+				}
+			}
+		}
+	}
+	return out
+}
+
+// SimilarKanji returns the kanji that look like r.
+func SimilarKanji(r rune) []rune {
+	return similarKanji[r]
+}
+
+// LookalikeWords replaces one kanji of word at a time with a kanji that
+// looks like it, e.g. 時間 gives 待間, 持間, 時問, 時聞 and so on.
+func LookalikeWords(word string) []string {
+	rs := []rune(word)
+	out := []string{}
+	for i, r := range rs {
+		for _, other := range SimilarKanji(r) {
+			variant := make([]rune, len(rs))
+			copy(variant, rs)
+			variant[i] = other
+			out = append(out, string(variant))
+		}
+	}
+	return out
+}
+
+var voicing = map[rune]rune{}
+
+func init() {
+	pairs := []string{
+		"かが", "きぎ", "くぐ", "けげ", "こご", "さざ", "しじ", "すず", "せぜ", "そぞ",
+		"ただ", "ちぢ", "つづ", "てで", "とど", "はば", "ひび", "ふぶ", "へべ", "ほぼ",
+	}
+	for _, p := range pairs {
+		rs := []rune(p)
+		voicing[rs[0]] = rs[1]
+		voicing[rs[1]] = rs[0]
+	}
+}
+
+// SimilarReadings returns hiragana readings that sound like reading, the
+// mistakes learners make most: long and short vowels (こう/こ), the small
+// っ, voicing (か/が) and small ゅ/ょ.
+func SimilarReadings(reading string) []string {
+	reading = kana.ToHiragana(reading)
+	rs := []rune(reading)
+	out := []string{}
+	add := func(variant []rune) {
+		s := string(variant)
+		if s != reading && s != "" && !containsString(out, s) {
+			out = append(out, s)
+		}
+	}
+
+	for i, r := range rs {
+		// drop or add a lengthening vowel
+		long := i > 0 && lengthens(rs[i-1], r)
+		if long {
+			add(remove(rs, i))
+		}
+		if vowel := lengthener(r); vowel != 0 && !long {
+			if next := runeAt(rs, i+1); next != vowel && next != 'ん' && next != 'っ' && !isSmall(next) {
+				add(insert(rs, i+1, vowel))
+			}
+		}
+
+		// drop or add a small tsu
+		if r == 'っ' {
+			add(remove(rs, i))
+		} else if i > 0 && !strings.ContainsRune("んっー", rs[i-1]) && geminates(r) {
+			add(insert(rs, i, 'っ'))
+		}
+
+		// voice or unvoice
+		if v, ok := voicing[r]; ok {
+			variant := append([]rune{}, rs...)
+			variant[i] = v
+			add(variant)
+		}
+
+		// swap ゅ and ょ
+		if r == 'ゅ' || r == 'ょ' {
+			variant := append([]rune{}, rs...)
+			variant[i] = 'ゅ' + 'ょ' - r
+			add(variant)
+		}
+	}
+	return out
+}
+
+// lengthens reports whether v lengthens the vowel of prev, as う after こ.
+func lengthens(prev, v rune) bool {
+	return lengthener(prev) == v
+}
+
+// lengthener returns the kana that lengthens the vowel of r: う for the o and
+// u rows, い for the e row.
+func lengthener(r rune) rune {
+	romaji := kana.ToRomaji(string(r))
+	if romaji == "" || romaji == string(r) {
+		return 0
+	}
+	switch romaji[len(romaji)-1] {
+	case 'o', 'u':
+		return 'う'
+	case 'e':
+		return 'い'
+	}
+	return 0
+}
+
+func geminates(r rune) bool {
+	romaji := kana.ToRomaji(string(r))
+	return romaji != "" && strings.ContainsRune("kstcp", rune(romaji[0]))
+}
+
+func isSmall(r rune) bool {
+	return strings.ContainsRune("ゃゅょぁぃぅぇぉ", r)
+}
+
+func remove(rs []rune, i int) []rune {
+	out := append([]rune{}, rs[:i]...)
+	return append(out, rs[i+1:]...)
+}
+
+func insert(rs []rune, i int, r rune) []rune {
+	out := append([]rune{}, rs[:i]...)
+	out = append(out, r)
+	return append(out, rs[i:]...)
+}
+
+func runeAt(rs []rune, i int) rune {
+	if i >= len(rs) {
+		return 0
+	}
+	return rs[i]
+}
+
+func containsRune(rs []rune, r rune) bool {
+	for _, v := range rs {
+		if v == r {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,105 @@
+package tests
+
+import (
+	"testing"
+	"wakuwaku_nihongo/internals/app/vocabulary"
+	"wakuwaku_nihongo/internals/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func word(id, w, reading, meaning, pos, level string, example string) *vocabulary.Vocabulary {
+	v := &vocabulary.Vocabulary{
+		VocabularyID: id,
+		Word:         w,
+		Reading:      reading,
+		Meaning:      meaning,
+		PartOfSpeech: pos,
+		JlptLevel:    level,
+	}
+	if example != "" {
+		v.ExampleSentence = &example
+	}
+	return v
+}
+
+var pool = []*vocabulary.Vocabulary{
+	word("1", "学校", "がっこう", "school", "noun", "N5", "学校[がっこう]へ行きます。"),
+	word("2", "時間", "じかん", "time", "noun", "N5", "時間[じかん]がありません。"),
+	word("3", "先生", "せんせい", "teacher", "noun", "N5", "先生[せんせい]に聞きます。"),
+	word("4", "電話", "でんわ", "telephone", "noun", "N5", "電話[でんわ]をかけます。"),
+	word("5", "学生", "がくせい", "student", "noun", "N5", "学生[がくせい]が来ました。"),
+	word("6", "食べる", "たべる", "to eat", "verb", "N5", ""),
+	word("7", "飲む", "のむ", "to drink", "verb", "N5", ""),
+	word("8", "会社", "かいしゃ", "company", "noun", "N4", "会社[かいしゃ]で働きます。"),
+	word("9", "テレビ", "てれび", "television", "noun", "N5", ""),
+}
+
+func correctAnswer(q *model.Question) string {
+	for _, a := range q.Answers {
+		if a.IsCorrect {
+			return a.AnswerText
+		}
+	}
+	return ""
+}
+
+func texts(q *model.Question) []string {
+	out := []string{}
+	for _, a := range q.Answers {
+		out = append(out, a.AnswerText)
+	}
+	return out
+}
+
+func TestGenerateReading(t *testing.T) {
+	q, err := vocabulary.NewGenerator(pool).Generate(pool[0], vocabulary.QUESTION_KIND_READING)
+	assert.NoError(t, err)
+	assert.Equal(t, "「学校」の読み方として正しいものはどれですか。", q.QuestionText)
+	assert.Len(t, q.Answers, vocabulary.DISTRACTOR_COUNT+1)
+	assert.Equal(t, "がっこう", correctAnswer(q))
+	assert.ElementsMatch(t, []string{"がっこう", "かっこう", "がこう", "がっごう"}, texts(q))
+}
+
+func TestGenerateWriting(t *testing.T) {
+	q, err := vocabulary.NewGenerator(pool).Generate(pool[1], vocabulary.QUESTION_KIND_WRITING)
+	assert.NoError(t, err)
+	assert.Equal(t, "「じかん」を漢字で書くとどれですか。", q.QuestionText)
+	assert.Equal(t, "時間", correctAnswer(q))
+	assert.ElementsMatch(t, []string{"時間", "待間", "持間", "特間"}, texts(q))
+}
+
+func TestGenerateMeaningPrefersSameLevelAndPartOfSpeech(t *testing.T) {
+	q, err := vocabulary.NewGenerator(pool).Generate(pool[5], vocabulary.QUESTION_KIND_MEANING)
+	assert.NoError(t, err)
+	assert.Equal(t, "「食[た]べる」の意味として正しいものはどれですか。", q.QuestionText)
+	assert.Equal(t, "to eat", correctAnswer(q))
+	assert.Contains(t, texts(q), "to drink")
+	assert.NotContains(t, texts(q), "company")
+}
+
+func TestGenerateUsage(t *testing.T) {
+	q, err := vocabulary.NewGenerator(pool).Generate(pool[0], vocabulary.QUESTION_KIND_USAGE)
+	assert.NoError(t, err)
+	assert.Equal(t, "学校へ行きます。", correctAnswer(q))
+	assert.Contains(t, texts(q), "学校がありません。")
+	assert.Contains(t, texts(q), "学校に聞きます。")
+}
+
+func TestGenerateSkips(t *testing.T) {
+	g := vocabulary.NewGenerator(pool)
+
+	_, err := g.Generate(pool[8], vocabulary.QUESTION_KIND_READING)
+	assert.ErrorIs(t, err, vocabulary.ErrNoKanji)
+
+	_, err = g.Generate(pool[5], vocabulary.QUESTION_KIND_USAGE)
+	assert.ErrorIs(t, err, vocabulary.ErrNoExampleSentence)
+
+	_, err = vocabulary.NewGenerator(pool[:2]).Generate(pool[0], vocabulary.QUESTION_KIND_MEANING)
+	assert.ErrorIs(t, err, vocabulary.ErrNotEnoughDistractors)
+}
+
+func TestSimilarReadings(t *testing.T) {
+	assert.Equal(t, []string{"かっこう", "がこう", "がっごう", "がっこ"}, vocabulary.SimilarReadings("がっこう"))
+	assert.Equal(t, []string{"ぎょう", "きゅう", "きょ"}, vocabulary.SimilarReadings("きょう"))
+}
@@ -0,0 +1,9 @@
+package xp
+
+const (
+	// sources an event is earned from, an event is earned once per source
+	SOURCE_ATTEMPT = "attempt"
+	SOURCE_BADGE   = "badge"
+
+	XP_PER_CORRECT_ANSWER = 10
+)
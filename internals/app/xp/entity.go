@@ -0,0 +1,33 @@
+package xp
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Event is an entry of the XP ledger, the source of truth the leaderboards
+// are built from.
+type Event struct {
+	XPEventID  string  `gorm:"column:xp_event_id;type:uuid;primaryKey" json:"xp_event_id"`
+	CreatedAt  int64   `gorm:"column:created_at;type:bigint;not null" json:"created_at"`
+	CustomerID string  `gorm:"column:customer_id;type:uuid;not null" json:"customer_id"`
+	SourceType string  `gorm:"column:source_type;type:character varying;not null" json:"source_type"`
+	SourceID   string  `gorm:"column:source_id;type:character varying;not null" json:"source_id"`
+	JlptLevel  *string `gorm:"column:jlpt_level;type:character varying" json:"jlpt_level"`
+	Amount     int     `gorm:"column:amount;type:integer;not null" json:"amount"`
+	EarnedAt   int64   `gorm:"column:earned_at;type:bigint;not null" json:"earned_at"`
+}
+
+func (*Event) TableName() string {
+	return "xp_events"
+}
+
+func (m *Event) BeforeCreate(tx *gorm.DB) (err error) {
+	m.CreatedAt = time.Now().UnixMilli()
+	if m.XPEventID == "" {
+		m.XPEventID = uuid.NewString()
+	}
+	return
+}
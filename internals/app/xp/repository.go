@@ -0,0 +1,27 @@
+package xp
+
+import (
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type repo struct {
+	db *gorm.DB
+}
+
+func NewRepo(db *gorm.DB) *repo {
+	return &repo{
+		db: db,
+	}
+}
+
+// Create stores in unless the customer already earned XP from its source,
+// created is false in that case.
+func (r *repo) Create(ctx echo.Context, in *Event) (created bool, err error) {
+	res := r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "customer_id"}, {Name: "source_type"}, {Name: "source_id"}},
+		DoNothing: true,
+	}).Create(in)
+	return res.RowsAffected == 1, res.Error
+}
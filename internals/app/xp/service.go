@@ -0,0 +1,20 @@
+package xp
+
+import (
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+// ForAttempt returns the XP earned by a submitted attempt.
+func ForAttempt(score int) int {
+	return score * XP_PER_CORRECT_ANSWER
+}
+
+// Award adds in to the ledger unless its source already earned, awarded
+// is false then.
+func Award(ctx echo.Context, db *gorm.DB, in *Event) (awarded bool, err error) {
+	if in.Amount <= 0 {
+		return
+	}
+	return NewRepo(db).Create(ctx, in)
+}
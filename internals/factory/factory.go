@@ -1,17 +1,24 @@
 package factory
 
 import (
+	"fmt"
+
 	"gorm.io/gorm"
 
 	"wakuwaku_nihongo/internals/pkg/database"
 
 	"wakuwaku_nihongo/internals/pkg/redisutil"
+
+	"wakuwaku_nihongo/config"
+	"wakuwaku_nihongo/internals/pkg/storage"
 )
 
 type Factory struct {
 	Db *gorm.DB
 
 	Redis *redisutil.Redis
+
+	Storage storage.Storage
 }
 
 func NewFactory() *Factory {
@@ -20,7 +27,12 @@ func NewFactory() *Factory {
 
 	f.SetupDb()
 
-	// f.SetupRedis()
+	f.SetupStorage()
+
+	// redis is optional, features that need it report being unavailable
+	if config.Get().Redis.Address != "" {
+		f.SetupRedis()
+	}
 	return f
 }
 
@@ -33,6 +45,14 @@ func (f *Factory) SetupRedis() {
 	f.Redis = redisutil.NewRedis()
 }
 
+func (f *Factory) SetupStorage() {
+	s, err := storage.New(config.Get().Storage)
+	if err != nil {
+		panic(fmt.Errorf("failed setup storage, error: %v", err))
+	}
+	f.Storage = s
+}
+
 func (f *Factory) SetupRepository() {
 	if f.Db == nil {
 		panic("Failed setup repository, db is undefined")
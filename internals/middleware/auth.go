@@ -2,11 +2,14 @@ package middleware
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/labstack/echo/v4"
 	"wakuwaku_nihongo/config"
+	"wakuwaku_nihongo/internals/pkg/i18n"
 	res "wakuwaku_nihongo/internals/utils/response"
+	"wakuwaku_nihongo/internals/utils/token"
 
 	"github.com/golang-jwt/jwt"
 )
@@ -61,8 +64,59 @@ func Authentication(next echo.HandlerFunc) echo.HandlerFunc {
 			email = ""
 		}
 
+		var role string
+		destructName = token.Claims.(jwt.MapClaims)["role"]
+		if destructName != nil {
+			role = destructName.(string)
+		} else {
+			role = ""
+		}
+
 		c.Set("user_id", user_id)
 		c.Set("email", email)
+		c.Set("role", role)
+
+		if localeResolver != nil && user_id != "" {
+			if l, ok := localeResolver(c, user_id); ok {
+				c.Set(i18n.CONTEXT_KEY, l)
+			}
+		}
 		return next(c)
 	}
 }
+
+// LocaleResolver returns the locale a customer prefers for responses, ok is
+// false when they have none and Accept-Language should decide.
+type LocaleResolver func(c echo.Context, customerID string) (l i18n.Locale, ok bool)
+
+var localeResolver LocaleResolver
+
+// SetLocaleResolver registers the lookup Authentication uses to localize the
+// responses of logged in customers.
+func SetLocaleResolver(r LocaleResolver) {
+	localeResolver = r
+}
+
+// Authorization only lets through users whose role is one of roles. Admins
+// are always allowed. It must run after Authentication.
+func Authorization(roles ...string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			role := GetRole(c)
+			if role == token.ROLE_ADMIN || slices.Contains(roles, role) {
+				return next(c)
+			}
+			return res.ErrorWrap(res.ErrForbiddenApiPermission, fmt.Errorf("role %q is not allowed", role)).Send(c)
+		}
+	}
+}
+
+func GetUserID(c echo.Context) string {
+	userID, _ := c.Get("user_id").(string)
+	return userID
+}
+
+func GetRole(c echo.Context) string {
+	role, _ := c.Get("role").(string)
+	return role
+}
@@ -4,20 +4,32 @@
 
 package model
 
+import (
+	"wakuwaku_nihongo/internals/pkg/softdelete"
+)
+
 const TableNameQuiz = "quizzes"
 
 // Quiz mapped from table <quizzes>
 type Quiz struct {
-	QuizID      string      `gorm:"column:quiz_id;type:uuid;primaryKey" json:"quiz_id"`
-	CreatedAt   int64       `gorm:"column:created_at;type:bigint;not null" json:"created_at"`
-	ModifiedAt  *int64      `gorm:"column:modified_at;type:bigint" json:"modified_at"`
-	DeletedAt   *int64      `gorm:"column:deleted_at;type:bigint" json:"deleted_at"`
-	CreatedBy   string      `gorm:"column:created_by;type:character varying;not null" json:"created_by"`
-	ModifiedBy  *string     `gorm:"column:modified_by;type:character varying" json:"modified_by"`
-	DeletedBy   *string     `gorm:"column:deleted_by;type:character varying" json:"deleted_by"`
-	Title       string      `gorm:"column:title;type:character varying;not null" json:"title"`
-	Description *string     `gorm:"column:description;type:character varying" json:"description"`
-	Questions   []*Question `gorm:"foreignKey:quiz_id;references:quiz_id" json:"questions"`
+	QuizID      string               `gorm:"column:quiz_id;type:uuid;primaryKey" json:"quiz_id"`
+	CreatedAt   int64                `gorm:"column:created_at;type:bigint;not null" json:"created_at"`
+	ModifiedAt  *int64               `gorm:"column:modified_at;type:bigint" json:"modified_at"`
+	DeletedAt   softdelete.DeletedAt `gorm:"column:deleted_at;type:bigint" json:"deleted_at"`
+	CreatedBy   string               `gorm:"column:created_by;type:character varying;not null" json:"created_by"`
+	ModifiedBy  *string              `gorm:"column:modified_by;type:character varying" json:"modified_by"`
+	DeletedBy   *string              `gorm:"column:deleted_by;type:character varying" json:"deleted_by"`
+	Title       string               `gorm:"column:title;type:character varying;not null" json:"title"`
+	Description *string              `gorm:"column:description;type:character varying" json:"description"`
+	Status      string               `gorm:"column:status;type:character varying;not null" json:"status"`
+	JlptLevel   *string              `gorm:"column:jlpt_level;type:character varying" json:"jlpt_level"`
+	ReviewerID  *string              `gorm:"column:reviewer_id;type:uuid" json:"reviewer_id"`
+	SubmittedAt *int64               `gorm:"column:submitted_at;type:bigint" json:"submitted_at"`
+	SubmittedBy *string              `gorm:"column:submitted_by;type:character varying" json:"submitted_by"`
+	PublishAt   *int64               `gorm:"column:publish_at;type:bigint" json:"publish_at"`
+	PublishedAt *int64               `gorm:"column:published_at;type:bigint" json:"published_at"`
+	PublishedBy *string              `gorm:"column:published_by;type:character varying" json:"published_by"`
+	Questions   []*Question          `gorm:"foreignKey:quiz_id;references:quiz_id" json:"questions"`
 }
 
 // TableName Quiz's table name
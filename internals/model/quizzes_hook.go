@@ -0,0 +1,21 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+func (m *Quiz) BeforeCreate(tx *gorm.DB) (err error) {
+	m.CreatedAt = time.Now().UnixMilli()
+	if m.QuizID == "" {
+		m.QuizID = uuid.NewString()
+	}
+	// quizzes are drafts until they are reviewed and published
+	if m.Status == "" {
+		m.Status = QUIZ_STATUS_DRAFT
+	}
+
+	return
+}
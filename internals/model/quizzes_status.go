@@ -0,0 +1,10 @@
+package model
+
+// a quiz is a draft until an editor submits it for review, the reviewer
+// publishes it right away or schedules it, or sends it back to draft.
+const (
+	QUIZ_STATUS_DRAFT     = "draft"
+	QUIZ_STATUS_IN_REVIEW = "in_review"
+	QUIZ_STATUS_SCHEDULED = "scheduled"
+	QUIZ_STATUS_PUBLISHED = "published"
+)
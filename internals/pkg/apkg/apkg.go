@@ -0,0 +1,61 @@
+// Package apkg reads and writes Anki deck packages. A package is a zip
+// holding a SQLite collection and a media index, only the legacy collection
+// (schema 11) is supported, which Anki still exports with "Support older
+// Anki versions". Notes are read and written as plain text fields, media is
+// not carried over.
+package apkg
+
+import (
+	"errors"
+	"html"
+	"regexp"
+	"strings"
+)
+
+const (
+	// MAX_COLLECTION_SIZE bounds the unpacked collection so a small package
+	// can not fill the disk.
+	MAX_COLLECTION_SIZE = 256 << 20
+
+	FIELD_SEPARATOR = "\x1f"
+)
+
+var (
+	ErrInvalidPackage     = errors.New("apkg: invalid package")
+	ErrUnsupportedVersion = errors.New("apkg: unsupported collection version, export with \"Support older Anki versions\"")
+	ErrTooLarge           = errors.New("apkg: collection too large")
+)
+
+// Note is an Anki note, Fields are in the order of its note type.
+type Note struct {
+	Fields []string
+	Tags   []string
+}
+
+type Deck struct {
+	Name        string
+	Description string
+	Notes       []Note
+}
+
+var (
+	lineBreaks = regexp.MustCompile(`(?i)<br\s*/?>|</div>|</p>|</li>`)
+	tags       = regexp.MustCompile(`<[^>]*>`)
+	sounds     = regexp.MustCompile(`\[sound:[^\]]*\]`)
+)
+
+// Text turns the HTML of a field into plain text, line breaks are kept and
+// sound references dropped.
+func Text(field string) string {
+	s := lineBreaks.ReplaceAllString(field, "\n")
+	s = tags.ReplaceAllString(s, "")
+	s = sounds.ReplaceAllString(s, "")
+	s = html.UnescapeString(s)
+	s = strings.ReplaceAll(s, " ", " ")
+	return strings.TrimSpace(s)
+}
+
+// HTML turns plain text into the HTML of a field.
+func HTML(text string) string {
+	return strings.ReplaceAll(html.EscapeString(text), "\n", "<br>")
+}
@@ -0,0 +1,134 @@
+package apkg
+
+import (
+	"archive/zip"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	_ "modernc.org/sqlite"
+)
+
+// Read returns the deck of a package with most of its cards and every note
+// of the package. Nested deck names keep their last part.
+func Read(r io.ReaderAt, size int64) (out *Deck, err error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, ErrInvalidPackage
+	}
+	files := map[string]*zip.File{}
+	for _, f := range zr.File {
+		files[f.Name] = f
+	}
+	collection := files["collection.anki21"]
+	if collection == nil {
+		collection = files["collection.anki2"]
+	}
+	if collection == nil {
+		if files["collection.anki21b"] != nil {
+			return nil, ErrUnsupportedVersion
+		}
+		return nil, ErrInvalidPackage
+	}
+
+	name, err := unpack(collection)
+	if err != nil {
+		return
+	}
+	defer os.Remove(name)
+
+	db, err := sql.Open("sqlite", "file:"+name+"?mode=ro")
+	if err != nil {
+		return
+	}
+	defer db.Close()
+	if out, err = readDeck(db); err != nil {
+		return
+	}
+	out.Notes, err = readNotes(db)
+	return
+}
+
+// unpack copies the collection to a temporary file as SQLite needs one.
+func unpack(f *zip.File) (name string, err error) {
+	if f.UncompressedSize64 > MAX_COLLECTION_SIZE {
+		return "", ErrTooLarge
+	}
+	src, err := f.Open()
+	if err != nil {
+		return "", ErrInvalidPackage
+	}
+	defer src.Close()
+
+	tmp, err := os.CreateTemp("", "apkg-*.anki2")
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err != nil {
+			os.Remove(tmp.Name())
+		}
+	}()
+	n, err := io.Copy(tmp, io.LimitReader(src, MAX_COLLECTION_SIZE+1))
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return
+	}
+	if n > MAX_COLLECTION_SIZE {
+		return "", ErrTooLarge
+	}
+	return tmp.Name(), nil
+}
+
+func readDeck(db *sql.DB) (out *Deck, err error) {
+	var raw string
+	if err = db.QueryRow("SELECT decks FROM col").Scan(&raw); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPackage, err)
+	}
+	decks := map[string]struct {
+		Name string `json:"name"`
+		Desc string `json:"desc"`
+	}{}
+	if err = json.Unmarshal([]byte(raw), &decks); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPackage, err)
+	}
+
+	out = &Deck{}
+	var did int64
+	err = db.QueryRow("SELECT did FROM cards GROUP BY did ORDER BY COUNT(*) DESC, did LIMIT 1").Scan(&did)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPackage, err)
+	}
+	if d, ok := decks[fmt.Sprint(did)]; ok {
+		parts := strings.Split(d.Name, "::")
+		out.Name = strings.TrimSpace(parts[len(parts)-1])
+		out.Description = Text(d.Desc)
+	}
+	return out, nil
+}
+
+func readNotes(db *sql.DB) (out []Note, err error) {
+	rows, err := db.Query("SELECT flds, tags FROM notes ORDER BY id")
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPackage, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var flds, tags string
+		if err = rows.Scan(&flds, &tags); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidPackage, err)
+		}
+		note := Note{Tags: strings.Fields(tags)}
+		for _, field := range strings.Split(flds, FIELD_SEPARATOR) {
+			note.Fields = append(note.Fields, Text(field))
+		}
+		out = append(out, note)
+	}
+	return out, rows.Err()
+}
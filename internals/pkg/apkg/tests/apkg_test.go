@@ -0,0 +1,80 @@
+package tests
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+	"wakuwaku_nihongo/internals/pkg/apkg"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestText(t *testing.T) {
+	tests := []struct {
+		field string
+		want  string
+	}{
+		{"食べる", "食べる"},
+		{"to eat<br>to consume", "to eat\nto consume"},
+		{"<div>one</div><div>two</div>", "one\ntwo"},
+		{"<b>bold</b> &amp; &lt;tag&gt;", "bold & <tag>"},
+		{"たべる[sound:taberu.mp3]", "たべる"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			assert.Equal(t, tt.want, apkg.Text(tt.field))
+		})
+	}
+	assert.Equal(t, "a &lt; b<br>c", apkg.HTML("a < b\nc"))
+}
+
+func TestWriteAndRead(t *testing.T) {
+	deck := &apkg.Deck{
+		Name:        "JLPT N5",
+		Description: "Basic words",
+		Notes: []apkg.Note{
+			{Fields: []string{"食べる", "to eat\n(verb)"}, Tags: []string{"verb", "n5"}},
+			{Fields: []string{"水", "water & more"}},
+			{Fields: []string{"front only"}},
+		},
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, apkg.Write(&buf, deck))
+
+	got, err := apkg.Read(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	assert.NoError(t, err)
+	assert.Equal(t, "JLPT N5", got.Name)
+	assert.Equal(t, "Basic words", got.Description)
+	assert.Equal(t, []apkg.Note{
+		{Fields: []string{"食べる", "to eat\n(verb)"}, Tags: []string{"verb", "n5"}},
+		{Fields: []string{"水", "water & more"}, Tags: []string{}},
+		{Fields: []string{"front only", ""}, Tags: []string{}},
+	}, got.Notes)
+}
+
+func TestReadErrors(t *testing.T) {
+	_, err := apkg.Read(bytes.NewReader([]byte("not a zip")), 9)
+	assert.ErrorIs(t, err, apkg.ErrInvalidPackage)
+
+	pack := func(name string) []byte {
+		var buf bytes.Buffer
+		zw := zip.NewWriter(&buf)
+		f, _ := zw.Create(name)
+		f.Write([]byte("zstd"))
+		zw.Close()
+		return buf.Bytes()
+	}
+	b := pack("collection.anki21b")
+	_, err = apkg.Read(bytes.NewReader(b), int64(len(b)))
+	assert.ErrorIs(t, err, apkg.ErrUnsupportedVersion)
+
+	b = pack("media")
+	_, err = apkg.Read(bytes.NewReader(b), int64(len(b)))
+	assert.ErrorIs(t, err, apkg.ErrInvalidPackage)
+
+	b = pack("collection.anki2")
+	_, err = apkg.Read(bytes.NewReader(b), int64(len(b)))
+	assert.ErrorIs(t, err, apkg.ErrInvalidPackage, "not a sqlite database")
+}
@@ -0,0 +1,192 @@
+package apkg
+
+import (
+	"archive/zip"
+	"crypto/rand"
+	"crypto/sha1"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// schema is the legacy collection, version 11.
+const schema = `
+CREATE TABLE col (id integer primary key, crt integer not null, mod integer not null, scm integer not null, ver integer not null, dty integer not null, usn integer not null, ls integer not null, conf text not null, models text not null, decks text not null, dconf text not null, tags text not null);
+CREATE TABLE notes (id integer primary key, guid text not null, mid integer not null, mod integer not null, usn integer not null, tags text not null, flds text not null, sfld integer not null, csum integer not null, flags integer not null, data text not null);
+CREATE TABLE cards (id integer primary key, nid integer not null, did integer not null, ord integer not null, mod integer not null, usn integer not null, type integer not null, queue integer not null, due integer not null, ivl integer not null, factor integer not null, reps integer not null, lapses integer not null, left integer not null, odue integer not null, odid integer not null, flags integer not null, data text not null);
+CREATE TABLE revlog (id integer primary key, cid integer not null, usn integer not null, ease integer not null, ivl integer not null, lastIvl integer not null, factor integer not null, time integer not null, type integer not null);
+CREATE TABLE graves (usn integer not null, oid integer not null, type integer not null);
+CREATE INDEX ix_notes_usn on notes (usn);
+CREATE INDEX ix_cards_usn on cards (usn);
+CREATE INDEX ix_revlog_usn on revlog (usn);
+CREATE INDEX ix_cards_nid on cards (nid);
+CREATE INDEX ix_cards_sched on cards (did, queue, due);
+CREATE INDEX ix_revlog_cid on revlog (cid);
+CREATE INDEX ix_notes_csum on notes (csum);
+`
+
+// Write packages deck with the Basic note type, the first two fields of a
+// note are its front and back. Cards are written new.
+func Write(w io.Writer, deck *Deck) (err error) {
+	tmp, err := os.CreateTemp("", "apkg-*.anki2")
+	if err != nil {
+		return
+	}
+	name := tmp.Name()
+	tmp.Close()
+	defer os.Remove(name)
+
+	if err = writeCollection(name, deck, time.Now()); err != nil {
+		return
+	}
+
+	zw := zip.NewWriter(w)
+	f, err := zw.Create("collection.anki2")
+	if err != nil {
+		return
+	}
+	src, err := os.Open(name)
+	if err != nil {
+		return
+	}
+	defer src.Close()
+	if _, err = io.Copy(f, src); err != nil {
+		return
+	}
+	if f, err = zw.Create("media"); err != nil {
+		return
+	}
+	if _, err = f.Write([]byte("{}")); err != nil {
+		return
+	}
+	return zw.Close()
+}
+
+func writeCollection(name string, deck *Deck, now time.Time) (err error) {
+	db, err := sql.Open("sqlite", "file:"+name)
+	if err != nil {
+		return
+	}
+	defer db.Close()
+
+	tx, err := db.Begin()
+	if err != nil {
+		return
+	}
+	defer tx.Rollback()
+	if _, err = tx.Exec(schema); err != nil {
+		return
+	}
+
+	ms := now.UnixMilli()
+	secs := now.Unix()
+	modelID, deckID := ms, ms+1
+	models, decks, dconf, conf := collectionConfig(modelID, deckID, deck, secs)
+	_, err = tx.Exec(`INSERT INTO col VALUES (1, ?, ?, ?, 11, 0, 0, 0, ?, ?, ?, ?, '{}')`,
+		secs-secs%86400, ms, ms, conf, models, decks, dconf)
+	if err != nil {
+		return
+	}
+
+	for i, note := range deck.Notes {
+		fields := make([]string, 2)
+		copy(fields, note.Fields)
+		for j := range fields {
+			fields[j] = HTML(fields[j])
+		}
+		noteTags := ""
+		if len(note.Tags) > 0 {
+			noteTags = " " + strings.Join(note.Tags, " ") + " "
+		}
+
+		id := ms + int64(i)
+		_, err = tx.Exec(`INSERT INTO notes VALUES (?, ?, ?, ?, -1, ?, ?, ?, ?, 0, '')`,
+			id, guid(), modelID, secs, noteTags, strings.Join(fields, FIELD_SEPARATOR), Text(fields[0]), checksum(Text(fields[0])))
+		if err != nil {
+			return
+		}
+		_, err = tx.Exec(`INSERT INTO cards VALUES (?, ?, ?, 0, ?, -1, 0, 0, ?, 0, 0, 0, 0, 0, 0, 0, 0, '')`,
+			id, id, deckID, secs, i+1)
+		if err != nil {
+			return
+		}
+	}
+	return tx.Commit()
+}
+
+func collectionConfig(modelID, deckID int64, deck *Deck, secs int64) (models, decks, dconf, conf string) {
+	field := func(name string, ord int) map[string]any {
+		return map[string]any{"name": name, "ord": ord, "sticky": false, "rtl": false, "font": "Arial", "size": 20, "media": []any{}}
+	}
+	model := map[string]any{
+		"id": modelID, "name": "Basic", "type": 0, "mod": secs, "usn": -1, "sortf": 0, "did": deckID,
+		"tmpls": []any{map[string]any{
+			"name": "Card 1", "ord": 0, "qfmt": "{{Front}}", "afmt": "{{FrontSide}}\n\n<hr id=answer>\n\n{{Back}}",
+			"bqfmt": "", "bafmt": "", "did": nil, "bfont": "", "bsize": 0,
+		}},
+		"flds":      []any{field("Front", 0), field("Back", 1)},
+		"css":       ".card {\n font-family: arial;\n font-size: 20px;\n text-align: center;\n color: black;\n background-color: white;\n}\n",
+		"latexPre":  "\\documentclass[12pt]{article}\n\\special{papersize=3in,5in}\n\\usepackage[utf8]{inputenc}\n\\usepackage{amssymb,amsmath}\n\\pagestyle{empty}\n\\setlength{\\parindent}{0in}\n\\begin{document}\n",
+		"latexPost": "\\end{document}",
+		"latexsvg":  false,
+		"req":       []any{[]any{0, "any", []int{0}}},
+		"tags":      []any{},
+		"vers":      []any{},
+	}
+	deckConfig := func(id int64, name, desc string) map[string]any {
+		return map[string]any{
+			"id": id, "name": name, "desc": desc, "mod": secs, "usn": -1, "conf": 1, "dyn": 0,
+			"collapsed": false, "browserCollapsed": false, "extendNew": 0, "extendRev": 0,
+			"newToday": []int{0, 0}, "revToday": []int{0, 0}, "lrnToday": []int{0, 0}, "timeToday": []int{0, 0},
+		}
+	}
+	options := map[string]any{
+		"id": 1, "name": "Default", "mod": 0, "usn": 0, "maxTaken": 60, "autoplay": true, "timer": 0, "replayq": true, "dyn": false,
+		"new":   map[string]any{"delays": []float64{1, 10}, "ints": []int{1, 4, 0}, "initialFactor": 2500, "order": 1, "perDay": 20, "bury": false},
+		"rev":   map[string]any{"perDay": 200, "ease4": 1.3, "ivlFct": 1, "maxIvl": 36500, "bury": false, "hardFactor": 1.2},
+		"lapse": map[string]any{"delays": []float64{10}, "mult": 0, "minInt": 1, "leechFails": 8, "leechAction": 1},
+	}
+
+	name := deck.Name
+	if name == "" {
+		name = "Imported"
+	}
+	models = mustJSON(map[string]any{strconv.FormatInt(modelID, 10): model})
+	decks = mustJSON(map[string]any{
+		"1":                           deckConfig(1, "Default", ""),
+		strconv.FormatInt(deckID, 10): deckConfig(deckID, name, HTML(deck.Description)),
+	})
+	dconf = mustJSON(map[string]any{"1": options})
+	conf = mustJSON(map[string]any{
+		"activeDecks": []int64{deckID}, "curDeck": deckID, "curModel": modelID, "nextPos": len(deck.Notes) + 1,
+		"newSpread": 0, "collapseTime": 1200, "timeLim": 0, "estTimes": true, "dueCounts": true, "sortType": "noteFld", "sortBackwards": false,
+	})
+	return
+}
+
+func mustJSON(v any) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
+
+// checksum is the first 8 hex digits of the SHA-1 of the sort field, which
+// Anki uses to find duplicates.
+func checksum(field string) int64 {
+	sum := sha1.Sum([]byte(field))
+	n, _ := strconv.ParseInt(hex.EncodeToString(sum[:4]), 16, 64)
+	return n
+}
+
+func guid() string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,94 @@
+// Package audio identifies uploaded audio files from their content and reads
+// their duration from the container headers. Only the formats accepted for
+// listening questions are understood: MP3, WAV, Ogg (Vorbis and Opus) and
+// MP4/M4A.
+package audio
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"time"
+)
+
+const (
+	MIMEMpeg = "audio/mpeg"
+	MIMEWav  = "audio/wav"
+	MIMEOgg  = "audio/ogg"
+	MIMEMp4  = "audio/mp4"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("audio: unsupported format")
+	ErrInvalidAudio      = errors.New("audio: malformed or truncated file")
+)
+
+// Info describes an audio file. MIMEType is derived from the content, never
+// from the file name or the client supplied header.
+type Info struct {
+	MIMEType string
+	Duration time.Duration
+}
+
+// Detect returns the MIME type of the audio whose first bytes are head, or
+// an empty string when head is not a supported audio format.
+func Detect(head []byte) string {
+	switch {
+	case len(head) >= 12 && bytes.Equal(head[0:4], []byte("RIFF")) && bytes.Equal(head[8:12], []byte("WAVE")):
+		return MIMEWav
+	case bytes.HasPrefix(head, []byte("OggS")):
+		return MIMEOgg
+	case len(head) >= 8 && bytes.Equal(head[4:8], []byte("ftyp")):
+		return MIMEMp4
+	case bytes.HasPrefix(head, []byte("ID3")):
+		return MIMEMpeg
+	case len(head) >= 2 && head[0] == 0xFF && head[1]&0xE0 == 0xE0:
+		return MIMEMpeg
+	}
+	return ""
+}
+
+// Probe detects the format of r and measures its duration. r is read from
+// the start and left at an unspecified offset.
+func Probe(r io.ReadSeeker) (out Info, err error) {
+	if _, err = r.Seek(0, io.SeekStart); err != nil {
+		return
+	}
+	head := make([]byte, 12)
+	n, err := io.ReadFull(r, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		if errors.Is(err, io.EOF) {
+			err = ErrInvalidAudio
+		}
+		return
+	}
+	out.MIMEType = Detect(head[:n])
+	if _, err = r.Seek(0, io.SeekStart); err != nil {
+		return
+	}
+
+	switch out.MIMEType {
+	case MIMEWav:
+		out.Duration, err = wavDuration(r)
+	case MIMEOgg:
+		out.Duration, err = oggDuration(r)
+	case MIMEMp4:
+		out.Duration, err = mp4Duration(r)
+	case MIMEMpeg:
+		out.Duration, err = mp3Duration(r)
+	default:
+		err = ErrUnsupportedFormat
+	}
+	return
+}
+
+// seconds converts a sample or tick count at rate per second to a duration
+// without overflowing for long files.
+func seconds(count uint64, rate uint64) time.Duration {
+	if rate == 0 {
+		return 0
+	}
+	whole := count / rate
+	frac := count % rate
+	return time.Duration(whole)*time.Second + time.Duration(frac*uint64(time.Second)/rate)
+}
@@ -0,0 +1,137 @@
+package audio
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"time"
+)
+
+// maxSyncSearch bounds how far past the ID3 tag the first frame is searched
+// for, encoders sometimes pad the tag with zeros.
+const maxSyncSearch = 64 * 1024
+
+var (
+	// bitrates in kbit/s indexed by [mpeg1][layer II/III][index].
+	mp3Bitrates = [2][2][16]int{
+		{
+			{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160, 0},
+			{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160, 0},
+		},
+		{
+			{0, 32, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 384, 0},
+			{0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 0},
+		},
+	}
+	// sample rates indexed by the version bits, 1 is reserved.
+	mp3SampleRates = [4][3]int{
+		{11025, 12000, 8000},
+		{},
+		{22050, 24000, 16000},
+		{44100, 48000, 32000},
+	}
+)
+
+type mp3Frame struct {
+	size       int
+	samples    int
+	sampleRate int
+}
+
+// parseMP3Frame decodes a Layer II or III frame header.
+func parseMP3Frame(h []byte) (f mp3Frame, ok bool) {
+	if h[0] != 0xFF || h[1]&0xE0 != 0xE0 {
+		return
+	}
+	version := int(h[1]>>3) & 0x03
+	layer := int(h[1]>>1) & 0x03
+	bitrateIndex := int(h[2] >> 4)
+	rateIndex := int(h[2]>>2) & 0x03
+	padding := int(h[2]>>1) & 0x01
+	if version == 1 || rateIndex == 3 || (layer != 1 && layer != 2) {
+		return
+	}
+
+	mpeg1 := 0
+	if version == 3 {
+		mpeg1 = 1
+	}
+	layer3 := 0
+	if layer == 1 {
+		layer3 = 1
+	}
+	bitrate := mp3Bitrates[mpeg1][layer3][bitrateIndex] * 1000
+	f.sampleRate = mp3SampleRates[version][rateIndex]
+	if bitrate == 0 {
+		return
+	}
+
+	f.samples = 1152
+	coefficient := 144
+	if layer3 == 1 && mpeg1 == 0 {
+		f.samples = 576
+		coefficient = 72
+	}
+	f.size = coefficient*bitrate/f.sampleRate + padding
+	return f, f.size > 4
+}
+
+// mp3Duration skips an ID3v2 tag and sums the samples of every frame, which
+// is exact for both constant and variable bitrate files.
+func mp3Duration(r io.Reader) (time.Duration, error) {
+	br := bufio.NewReader(r)
+	if err := skipID3v2(br); err != nil {
+		return 0, err
+	}
+
+	header := make([]byte, 4)
+	var samples, rate uint64
+	for searched := 0; ; {
+		b, err := br.Peek(4)
+		if err != nil {
+			break
+		}
+		copy(header, b)
+		f, ok := parseMP3Frame(header)
+		if !ok {
+			// Trailing ID3v1 or APE tags end the stream, anything else is
+			// only tolerated before the first frame.
+			if rate != 0 || bytes.HasPrefix(header, []byte("TAG")) || bytes.HasPrefix(header, []byte("APET")) {
+				break
+			}
+			if searched++; searched > maxSyncSearch {
+				break
+			}
+			br.Discard(1)
+			continue
+		}
+		if rate == 0 {
+			rate = uint64(f.sampleRate)
+		}
+		samples += uint64(f.samples)
+		if n, _ := br.Discard(f.size); n < f.size {
+			break
+		}
+	}
+
+	if rate == 0 {
+		return 0, ErrInvalidAudio
+	}
+	return seconds(samples, rate), nil
+}
+
+func skipID3v2(br *bufio.Reader) error {
+	h, err := br.Peek(10)
+	if err != nil || !bytes.HasPrefix(h, []byte("ID3")) {
+		return nil
+	}
+	size := int(h[6]&0x7F)<<21 | int(h[7]&0x7F)<<14 | int(h[8]&0x7F)<<7 | int(h[9]&0x7F)
+	size += 10
+	if h[5]&0x10 != 0 {
+		size += 10
+	}
+	if n, _ := br.Discard(size); n < size {
+		return ErrInvalidAudio
+	}
+	return nil
+}
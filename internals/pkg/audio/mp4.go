@@ -0,0 +1,90 @@
+package audio
+
+import (
+	"encoding/binary"
+	"io"
+	"time"
+)
+
+// mp4Duration finds moov/mvhd and reads the movie timescale and duration.
+func mp4Duration(r io.ReadSeeker) (time.Duration, error) {
+	end, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return 0, ErrInvalidAudio
+	}
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return 0, ErrInvalidAudio
+	}
+
+	moov, err := findBox(r, end, "moov")
+	if err != nil {
+		return 0, err
+	}
+	if _, err := findBox(r, moov, "mvhd"); err != nil {
+		return 0, err
+	}
+
+	version := make([]byte, 4)
+	if _, err := io.ReadFull(r, version); err != nil {
+		return 0, ErrInvalidAudio
+	}
+	var timescale, duration uint64
+	if version[0] == 1 {
+		b := make([]byte, 28)
+		if _, err := io.ReadFull(r, b); err != nil {
+			return 0, ErrInvalidAudio
+		}
+		timescale = uint64(binary.BigEndian.Uint32(b[16:20]))
+		duration = binary.BigEndian.Uint64(b[20:28])
+	} else {
+		b := make([]byte, 16)
+		if _, err := io.ReadFull(r, b); err != nil {
+			return 0, ErrInvalidAudio
+		}
+		timescale = uint64(binary.BigEndian.Uint32(b[8:12]))
+		duration = uint64(binary.BigEndian.Uint32(b[12:16]))
+	}
+	if timescale == 0 {
+		return 0, ErrInvalidAudio
+	}
+	return seconds(duration, timescale), nil
+}
+
+// findBox scans the sibling boxes from the current offset up to limit for
+// one of type name. On success r is positioned at the start of its payload
+// and the offset of its end is returned.
+func findBox(r io.ReadSeeker, limit int64, name string) (int64, error) {
+	header := make([]byte, 8)
+	for {
+		start, err := r.Seek(0, io.SeekCurrent)
+		if err != nil || start+8 > limit {
+			return 0, ErrInvalidAudio
+		}
+		if _, err := io.ReadFull(r, header); err != nil {
+			return 0, ErrInvalidAudio
+		}
+		size := int64(binary.BigEndian.Uint32(header[0:4]))
+		headerSize := int64(8)
+		switch size {
+		case 0:
+			size = limit - start
+		case 1:
+			large := make([]byte, 8)
+			if _, err := io.ReadFull(r, large); err != nil {
+				return 0, ErrInvalidAudio
+			}
+			size = int64(binary.BigEndian.Uint64(large))
+			headerSize = 16
+		}
+		if size < headerSize || start+size > limit {
+			return 0, ErrInvalidAudio
+		}
+
+		if string(header[4:8]) == name {
+			return start + size, nil
+		}
+		if _, err := r.Seek(start+size, io.SeekStart); err != nil {
+			return 0, ErrInvalidAudio
+		}
+	}
+}
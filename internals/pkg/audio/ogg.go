@@ -0,0 +1,82 @@
+package audio
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"time"
+)
+
+const opusSampleRate = 48000
+
+// oggDuration reads the sample rate from the identification header of the
+// first logical stream and the granule position of its last page.
+func oggDuration(r io.Reader) (time.Duration, error) {
+	br := bufio.NewReader(r)
+	header := make([]byte, 27)
+
+	var (
+		serial   uint32
+		rate     uint64
+		preSkip  uint64
+		granule  uint64
+		first    = true
+		hasAudio bool
+	)
+	for {
+		if _, err := io.ReadFull(br, header); err != nil {
+			if errors.Is(err, io.EOF) && !first {
+				break
+			}
+			return 0, ErrInvalidAudio
+		}
+		if !bytes.Equal(header[0:4], []byte("OggS")) {
+			return 0, ErrInvalidAudio
+		}
+		pageSerial := binary.LittleEndian.Uint32(header[14:18])
+		pageGranule := binary.LittleEndian.Uint64(header[6:14])
+
+		segments := make([]byte, header[26])
+		if _, err := io.ReadFull(br, segments); err != nil {
+			return 0, ErrInvalidAudio
+		}
+		size := 0
+		for _, s := range segments {
+			size += int(s)
+		}
+		body := make([]byte, size)
+		if _, err := io.ReadFull(br, body); err != nil {
+			return 0, ErrInvalidAudio
+		}
+
+		if first {
+			first = false
+			serial = pageSerial
+			switch {
+			case len(body) >= 16 && bytes.HasPrefix(body, []byte("\x01vorbis")):
+				rate = uint64(binary.LittleEndian.Uint32(body[12:16]))
+			case len(body) >= 16 && bytes.HasPrefix(body, []byte("OpusHead")):
+				preSkip = uint64(binary.LittleEndian.Uint16(body[10:12]))
+				rate = opusSampleRate
+			default:
+				return 0, ErrUnsupportedFormat
+			}
+			continue
+		}
+		// A granule position of -1 marks a page on which no packet ends.
+		if pageSerial == serial && pageGranule != ^uint64(0) {
+			granule = pageGranule
+			hasAudio = true
+		}
+	}
+
+	if !hasAudio || rate == 0 {
+		return 0, ErrInvalidAudio
+	}
+	if granule < preSkip {
+		return 0, nil
+	}
+	return seconds(granule-preSkip, rate), nil
+}
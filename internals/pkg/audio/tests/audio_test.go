@@ -0,0 +1,146 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+	"wakuwaku_nihongo/internals/pkg/audio"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func wav(sampleRate uint32, dataSize uint32) []byte {
+	var b bytes.Buffer
+	b.WriteString("RIFF")
+	binary.Write(&b, binary.LittleEndian, uint32(0))
+	b.WriteString("WAVE")
+	b.WriteString("fmt ")
+	binary.Write(&b, binary.LittleEndian, uint32(16))
+	binary.Write(&b, binary.LittleEndian, uint16(1))
+	binary.Write(&b, binary.LittleEndian, uint16(1))
+	binary.Write(&b, binary.LittleEndian, sampleRate)
+	binary.Write(&b, binary.LittleEndian, sampleRate*2)
+	binary.Write(&b, binary.LittleEndian, uint16(2))
+	binary.Write(&b, binary.LittleEndian, uint16(16))
+	// an odd sized chunk is padded to an even length
+	b.WriteString("LIST")
+	binary.Write(&b, binary.LittleEndian, uint32(3))
+	b.Write([]byte{'a', 'b', 'c', 0})
+	b.WriteString("data")
+	binary.Write(&b, binary.LittleEndian, dataSize)
+	b.Write(make([]byte, dataSize))
+	return b.Bytes()
+}
+
+// mp3 returns an ID3 tag followed by MPEG-1 Layer III frames at 128 kbit/s
+// and 44.1 kHz, 417 bytes and 1152 samples each.
+func mp3(frames int) []byte {
+	var b bytes.Buffer
+	b.Write([]byte{'I', 'D', '3', 3, 0, 0, 0, 0, 0, 10})
+	b.Write(make([]byte, 10))
+	for i := 0; i < frames; i++ {
+		frame := make([]byte, 417)
+		copy(frame, []byte{0xFF, 0xFB, 0x90, 0x00})
+		b.Write(frame)
+	}
+	b.WriteString("TAG")
+	b.Write(make([]byte, 125))
+	return b.Bytes()
+}
+
+func oggPage(granule uint64, body []byte) []byte {
+	var b bytes.Buffer
+	b.WriteString("OggS")
+	b.Write([]byte{0, 0})
+	binary.Write(&b, binary.LittleEndian, granule)
+	binary.Write(&b, binary.LittleEndian, uint32(1))
+	binary.Write(&b, binary.LittleEndian, uint32(0))
+	binary.Write(&b, binary.LittleEndian, uint32(0))
+	b.WriteByte(1)
+	b.WriteByte(byte(len(body)))
+	b.Write(body)
+	return b.Bytes()
+}
+
+func vorbis(rate uint32, granule uint64) []byte {
+	head := []byte("\x01vorbis\x00\x00\x00\x00\x01")
+	head = binary.LittleEndian.AppendUint32(head, rate)
+	head = append(head, make([]byte, 14)...)
+	out := oggPage(0, head)
+	out = append(out, oggPage(^uint64(0), make([]byte, 20))...)
+	out = append(out, oggPage(granule/2, make([]byte, 50))...)
+	return append(out, oggPage(granule, make([]byte, 50))...)
+}
+
+func opus(preSkip uint16, granule uint64) []byte {
+	head := []byte("OpusHead\x01\x01")
+	head = binary.LittleEndian.AppendUint16(head, preSkip)
+	head = binary.LittleEndian.AppendUint32(head, 44100)
+	head = append(head, 0, 0, 0)
+	out := oggPage(0, head)
+	return append(out, oggPage(granule, make([]byte, 50))...)
+}
+
+func box(name string, payload []byte) []byte {
+	out := binary.BigEndian.AppendUint32(nil, uint32(8+len(payload)))
+	out = append(out, name...)
+	return append(out, payload...)
+}
+
+func m4a(timescale uint32, duration uint32) []byte {
+	mvhd := make([]byte, 4+16+80)
+	binary.BigEndian.PutUint32(mvhd[12:16], timescale)
+	binary.BigEndian.PutUint32(mvhd[16:20], duration)
+	out := box("ftyp", []byte("M4A \x00\x00\x00\x00isom"))
+	out = append(out, box("free", make([]byte, 7))...)
+	return append(out, box("moov", append(box("trak", nil), box("mvhd", mvhd)...))...)
+}
+
+func TestProbe(t *testing.T) {
+	tests := []struct {
+		name     string
+		in       []byte
+		mime     string
+		duration time.Duration
+	}{
+		{"wav", wav(8000, 32000), audio.MIMEWav, 2 * time.Second},
+		{"mp3", mp3(100), audio.MIMEMpeg, 2612244897 * time.Nanosecond},
+		{"mp3 without id3", mp3(10)[20:], audio.MIMEMpeg, 261224489 * time.Nanosecond},
+		{"ogg vorbis", vorbis(44100, 88200), audio.MIMEOgg, 2 * time.Second},
+		{"ogg opus", opus(312, 96312), audio.MIMEOgg, 2 * time.Second},
+		{"m4a", m4a(1000, 2500), audio.MIMEMp4, 2500 * time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := audio.Probe(bytes.NewReader(tt.in))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.mime, info.MIMEType)
+			assert.Equal(t, tt.duration, info.Duration)
+		})
+	}
+}
+
+func TestProbeErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []byte
+		err  error
+	}{
+		{"text", []byte("hello, this is not audio"), audio.ErrUnsupportedFormat},
+		{"png", []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\x0dIHDR"), audio.ErrUnsupportedFormat},
+		{"empty", nil, audio.ErrInvalidAudio},
+		{"wav without data", wav(8000, 0)[:44], audio.ErrInvalidAudio},
+		{"mp4 without moov", box("ftyp", []byte("isom\x00\x00\x00\x00")), audio.ErrInvalidAudio},
+		{"ogg theora", oggPage(0, []byte("\x80theora-video-header")), audio.ErrUnsupportedFormat},
+		{"id3 without frames", []byte("ID3\x03\x00\x00\x00\x00\x00\x00"), audio.ErrInvalidAudio},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := audio.Probe(bytes.NewReader(tt.in))
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
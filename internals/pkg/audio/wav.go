@@ -0,0 +1,61 @@
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"time"
+)
+
+// wavDuration walks the RIFF chunks for the byte rate in "fmt " and the size
+// of "data".
+func wavDuration(r io.ReadSeeker) (time.Duration, error) {
+	header := make([]byte, 12)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return 0, ErrInvalidAudio
+	}
+
+	var byteRate uint32
+	chunk := make([]byte, 8)
+	for {
+		if _, err := io.ReadFull(r, chunk); err != nil {
+			return 0, ErrInvalidAudio
+		}
+		id := chunk[0:4]
+		size := binary.LittleEndian.Uint32(chunk[4:8])
+
+		switch {
+		case bytes.Equal(id, []byte("fmt ")):
+			if size < 16 {
+				return 0, ErrInvalidAudio
+			}
+			format := make([]byte, 16)
+			if _, err := io.ReadFull(r, format); err != nil {
+				return 0, ErrInvalidAudio
+			}
+			byteRate = binary.LittleEndian.Uint32(format[8:12])
+			if err := skip(r, int64(size)-16+int64(size%2)); err != nil {
+				return 0, err
+			}
+		case bytes.Equal(id, []byte("data")):
+			if byteRate == 0 {
+				return 0, ErrInvalidAudio
+			}
+			return seconds(uint64(size), uint64(byteRate)), nil
+		default:
+			if err := skip(r, int64(size)+int64(size%2)); err != nil {
+				return 0, err
+			}
+		}
+	}
+}
+
+func skip(r io.ReadSeeker, n int64) error {
+	if n == 0 {
+		return nil
+	}
+	if _, err := r.Seek(n, io.SeekCurrent); err != nil {
+		return ErrInvalidAudio
+	}
+	return nil
+}
@@ -0,0 +1,14 @@
+package i18n
+
+// Catalog holds the translated messages of each locale keyed by a numeric
+// code, such as the API status codes.
+type Catalog map[Locale]map[int]string
+
+// Lookup returns the message in l, falling back to the default locale.
+func (c Catalog) Lookup(l Locale, code int) (string, bool) {
+	if msg, ok := c[l][code]; ok {
+		return msg, true
+	}
+	msg, ok := c[Default][code]
+	return msg, ok
+}
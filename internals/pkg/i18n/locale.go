@@ -0,0 +1,37 @@
+// Package i18n holds the locales the API is translated to.
+package i18n
+
+import "strings"
+
+type Locale string
+
+const (
+	En Locale = "en"
+	Id Locale = "id"
+	Ja Locale = "ja"
+
+	Default = En
+)
+
+// Supported lists the locales in their preferred fallback order.
+var Supported = []Locale{En, Id, Ja}
+
+func (l Locale) IsValid() bool {
+	for _, s := range Supported {
+		if l == s {
+			return true
+		}
+	}
+	return false
+}
+
+// Parse returns the supported locale of a language tag such as ja-JP or
+// en_US, matching on the primary language subtag only.
+func Parse(tag string) (Locale, bool) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if i := strings.IndexAny(tag, "-_"); i >= 0 {
+		tag = tag[:i]
+	}
+	l := Locale(tag)
+	return l, l.IsValid()
+}
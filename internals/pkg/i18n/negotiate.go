@@ -0,0 +1,37 @@
+package i18n
+
+import (
+	"strconv"
+	"strings"
+)
+
+// CONTEXT_KEY is the echo context key of the locale chosen for the request.
+const CONTEXT_KEY = "locale"
+
+// Negotiate picks the supported locale with the highest quality from an
+// Accept-Language header, e.g. "ja-JP,ja;q=0.9,en;q=0.8". Languages with
+// equal quality keep their order and Default is returned when none match.
+func Negotiate(header string) Locale {
+	best := Default
+	bestQ := 0.0
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		l, ok := Parse(tag)
+		if !ok {
+			continue
+		}
+		q := 1.0
+		for _, p := range strings.Split(params, ";") {
+			key, value, found := strings.Cut(strings.TrimSpace(p), "=")
+			if found && key == "q" {
+				if v, err := strconv.ParseFloat(value, 64); err == nil {
+					q = v
+				}
+			}
+		}
+		if q > bestQ {
+			best, bestQ = l, q
+		}
+	}
+	return best
+}
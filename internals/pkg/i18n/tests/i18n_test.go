@@ -0,0 +1,70 @@
+package tests
+
+import (
+	"testing"
+	"wakuwaku_nihongo/internals/pkg/i18n"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		tag  string
+		want i18n.Locale
+		ok   bool
+	}{
+		{"ja", i18n.Ja, true},
+		{"ja-JP", i18n.Ja, true},
+		{" en_US ", i18n.En, true},
+		{"ID", i18n.Id, true},
+		{"fr-FR", "fr", false},
+		{"*", "*", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			got, ok := i18n.Parse(tt.tag)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		header string
+		want   i18n.Locale
+	}{
+		{"", i18n.En},
+		{"ja-JP,ja;q=0.9,en;q=0.8", i18n.Ja},
+		{"en;q=0.5, id;q=0.8", i18n.Id},
+		{"fr-FR,fr;q=0.9,ja;q=0.7", i18n.Ja},
+		{"id, ja", i18n.Id},
+		{"de,fr", i18n.En},
+		{"ja;q=0", i18n.En},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.header, func(t *testing.T) {
+			assert.Equal(t, tt.want, i18n.Negotiate(tt.header))
+		})
+	}
+}
+
+func TestCatalogLookup(t *testing.T) {
+	c := i18n.Catalog{
+		i18n.En: {1: "one", 2: "two"},
+		i18n.Ja: {1: "いち"},
+	}
+
+	msg, ok := c.Lookup(i18n.Ja, 1)
+	assert.True(t, ok)
+	assert.Equal(t, "いち", msg)
+
+	msg, ok = c.Lookup(i18n.Ja, 2)
+	assert.True(t, ok)
+	assert.Equal(t, "two", msg, "falls back to the default locale")
+
+	_, ok = c.Lookup(i18n.Id, 3)
+	assert.False(t, ok)
+}
@@ -0,0 +1,55 @@
+package irt
+
+import "math"
+
+// Answer is a response to an item with known parameters.
+type Answer struct {
+	Item    Item
+	Correct bool
+}
+
+// Estimate returns the expected a posteriori ability of a learner from
+// their answers under a standard normal prior, and its standard error, the
+// standard deviation of the posterior. Without answers it returns the
+// prior, about 0 and 1.
+func Estimate(answers []Answer) (theta float64, se float64) {
+	points, weights := quadrature()
+	posterior := make([]float64, len(points))
+	posteriorOf(posterior, points, weights, answers)
+
+	for q, w := range posterior {
+		theta += w * points[q]
+	}
+	for q, w := range posterior {
+		se += w * (points[q] - theta) * (points[q] - theta)
+	}
+	return theta, math.Sqrt(se)
+}
+
+// posteriorOf writes the posterior of an ability over the quadrature points
+// given the answers into out.
+func posteriorOf(out []float64, points []float64, weights []float64, answers []Answer) {
+	peak := math.Inf(-1)
+	for q, theta := range points {
+		ll := math.Log(weights[q])
+		for _, a := range answers {
+			p := a.Item.P(theta)
+			if a.Correct {
+				ll += math.Log(p)
+			} else {
+				ll += math.Log1p(-p)
+			}
+		}
+		out[q] = ll
+		peak = math.Max(peak, ll)
+	}
+
+	sum := 0.0
+	for q := range out {
+		out[q] = math.Exp(out[q] - peak)
+		sum += out[q]
+	}
+	for q := range out {
+		out[q] /= sum
+	}
+}
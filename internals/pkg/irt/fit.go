@@ -0,0 +1,185 @@
+package irt
+
+import (
+	"errors"
+	"math"
+)
+
+const (
+	MAX_ITERATIONS = 500
+	// TOLERANCE is the largest change of any item parameter at which the
+	// fit stops.
+	TOLERANCE = 1e-4
+
+	// the priors keep estimates finite for items with few or one sided
+	// answers. Discrimination is pulled towards 1 but left free to turn
+	// negative.
+	PRIOR_DISCRIMINATION    = 1.0
+	PRIOR_DISCRIMINATION_SD = 1.0
+	PRIOR_DIFFICULTY_SD     = 2.0
+
+	// MAX_STEP limits a single Newton step, which overshoots far from the
+	// optimum.
+	MAX_STEP = 1.0
+)
+
+var (
+	ErrNoResponses  = errors.New("irt: no responses")
+	ErrInvalidIndex = errors.New("irt: response refers to an unknown person or item")
+)
+
+// Response is the answer of a person to an item, both given by their index.
+type Response struct {
+	Person  int
+	Item    int
+	Correct bool
+}
+
+type Result struct {
+	Items []Item
+	// Abilities are the expected a posteriori abilities of the persons.
+	Abilities []float64
+	// Iterations is the number of EM cycles, Converged is false when
+	// MAX_ITERATIONS passed first.
+	Iterations int
+	Converged  bool
+}
+
+// Fit estimates the items from the responses by marginal maximum likelihood
+// with the EM algorithm of Bock and Aitkin: abilities are integrated out
+// over a standard normal population, which also fixes the scale, so persons
+// answering only a few items do not bias the items.
+func Fit(responses []Response, persons int, items int) (out *Result, err error) {
+	if len(responses) == 0 {
+		return nil, ErrNoResponses
+	}
+	byPerson := make([][]int, persons)
+	correct := make([]float64, items)
+	total := make([]float64, items)
+	for n, r := range responses {
+		if r.Person < 0 || r.Person >= persons || r.Item < 0 || r.Item >= items {
+			return nil, ErrInvalidIndex
+		}
+		byPerson[r.Person] = append(byPerson[r.Person], n)
+		correct[r.Item] += score(r.Correct)
+		total[r.Item]++
+	}
+
+	out = &Result{Items: make([]Item, items), Abilities: make([]float64, persons)}
+	for i := range out.Items {
+		share := (correct[i] + 0.5) / (total[i] + 1)
+		out.Items[i] = Item{
+			Discrimination: PRIOR_DISCRIMINATION,
+			Difficulty:     clamp(-logit(share), MIN_THETA, MAX_THETA),
+		}
+	}
+
+	points, weights := quadrature()
+	expected := make([][]float64, items)
+	expectedCorrect := make([][]float64, items)
+	for i := range expected {
+		expected[i] = make([]float64, len(points))
+		expectedCorrect[i] = make([]float64, len(points))
+	}
+	posterior := make([]float64, len(points))
+	answers := []Answer{}
+
+	for out.Iterations < MAX_ITERATIONS {
+		out.Iterations++
+
+		// E step: spread every person over the quadrature points by their
+		// posterior and count the expected answers at each point
+		for i := range expected {
+			clear(expected[i])
+			clear(expectedCorrect[i])
+		}
+		for _, ns := range byPerson {
+			if len(ns) == 0 {
+				continue
+			}
+			answers = answersOf(answers, out.Items, responses, ns)
+			posteriorOf(posterior, points, weights, answers)
+			for _, n := range ns {
+				r := responses[n]
+				for q, w := range posterior {
+					expected[r.Item][q] += w
+					if r.Correct {
+						expectedCorrect[r.Item][q] += w
+					}
+				}
+			}
+		}
+
+		// M step: refit every item to its expected answers
+		change := 0.0
+		for i := range out.Items {
+			change = math.Max(change, fitItem(&out.Items[i], points, expected[i], expectedCorrect[i]))
+		}
+		if change < TOLERANCE {
+			out.Converged = true
+			break
+		}
+	}
+
+	for p, ns := range byPerson {
+		answers = answersOf(answers, out.Items, responses, ns)
+		posteriorOf(posterior, points, weights, answers)
+		for q, w := range posterior {
+			out.Abilities[p] += w * points[q]
+		}
+	}
+	return
+}
+
+// fitItem takes a Fisher scoring step for an item on the expected answers
+// at the quadrature points and returns the largest change of its
+// parameters.
+func fitItem(item *Item, points []float64, expected []float64, expectedCorrect []float64) float64 {
+	a, b := item.Discrimination, item.Difficulty
+	ga := -(a - PRIOR_DISCRIMINATION) / (PRIOR_DISCRIMINATION_SD * PRIOR_DISCRIMINATION_SD)
+	gb := -b / (PRIOR_DIFFICULTY_SD * PRIOR_DIFFICULTY_SD)
+	iaa := 1 / (PRIOR_DISCRIMINATION_SD * PRIOR_DISCRIMINATION_SD)
+	ibb := 1 / (PRIOR_DIFFICULTY_SD * PRIOR_DIFFICULTY_SD)
+	iab := 0.0
+	for q, theta := range points {
+		d := theta - b
+		p := item.P(theta)
+		w := expected[q] * p * (1 - p)
+		r := expectedCorrect[q] - expected[q]*p
+		ga += r * d
+		gb -= a * r
+		iaa += w * d * d
+		ibb += w * a * a
+		iab -= w * a * d
+	}
+
+	det := iaa*ibb - iab*iab
+	if det <= 0 {
+		return 0
+	}
+	da := clamp((ibb*ga-iab*gb)/det, -MAX_STEP, MAX_STEP)
+	db := clamp((iaa*gb-iab*ga)/det, -MAX_STEP, MAX_STEP)
+	item.Discrimination = clamp(a+da, -MAX_DISCRIMINATION, MAX_DISCRIMINATION)
+	item.Difficulty = clamp(b+db, MIN_THETA, MAX_THETA)
+	return math.Max(math.Abs(item.Discrimination-a), math.Abs(item.Difficulty-b))
+}
+
+// answersOf fills buf with the responses ns and the current items.
+func answersOf(buf []Answer, items []Item, responses []Response, ns []int) []Answer {
+	buf = buf[:0]
+	for _, n := range ns {
+		buf = append(buf, Answer{Item: items[responses[n].Item], Correct: responses[n].Correct})
+	}
+	return buf
+}
+
+func logit(p float64) float64 {
+	return math.Log(p / (1 - p))
+}
+
+func score(correct bool) float64 {
+	if correct {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,63 @@
+// Package irt implements the two parameter logistic (2PL) model of Item
+// Response Theory, where the chance a learner of ability theta answers an
+// item correctly is 1 / (1 + exp(-a(theta - b))) for the item's
+// discrimination a and difficulty b.
+package irt
+
+import "math"
+
+const (
+	// MIN_THETA and MAX_THETA bound abilities and difficulties, learners
+	// answering everything right or wrong have no finite estimate.
+	MIN_THETA = -4.0
+	MAX_THETA = 4.0
+
+	// MAX_DISCRIMINATION bounds the slope of an item either way.
+	MAX_DISCRIMINATION = 4.0
+
+	// QUADRATURE_POINTS is the number of evenly spaced abilities between
+	// MIN_THETA and MAX_THETA the ability distribution is approximated
+	// with.
+	QUADRATURE_POINTS = 41
+)
+
+// Item holds the parameters of an item. A negative discrimination means
+// stronger learners fail the item more often, usually a wrong answer key.
+type Item struct {
+	Discrimination float64
+	Difficulty     float64
+}
+
+// P returns the chance of a correct answer at ability theta.
+func (i Item) P(theta float64) float64 {
+	return 1 / (1 + math.Exp(-i.Discrimination*(theta-i.Difficulty)))
+}
+
+// Information returns the Fisher information of the item at ability theta,
+// the higher it is the more an answer narrows the estimate of theta.
+func (i Item) Information(theta float64) float64 {
+	p := i.P(theta)
+	return i.Discrimination * i.Discrimination * p * (1 - p)
+}
+
+func clamp(v, lo, hi float64) float64 {
+	return math.Max(lo, math.Min(hi, v))
+}
+
+// quadrature returns the quadrature points with their standard normal
+// weights.
+func quadrature() (points []float64, weights []float64) {
+	points = make([]float64, QUADRATURE_POINTS)
+	weights = make([]float64, QUADRATURE_POINTS)
+	step := (MAX_THETA - MIN_THETA) / (QUADRATURE_POINTS - 1)
+	sum := 0.0
+	for q := range points {
+		points[q] = MIN_THETA + float64(q)*step
+		weights[q] = math.Exp(-points[q] * points[q] / 2)
+		sum += weights[q]
+	}
+	for q := range weights {
+		weights[q] /= sum
+	}
+	return
+}
@@ -0,0 +1,118 @@
+package tests
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"wakuwaku_nihongo/internals/pkg/irt"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestItemP(t *testing.T) {
+	item := irt.Item{Discrimination: 1.5, Difficulty: 0.5}
+	assert.InDelta(t, 0.5, item.P(0.5), 1e-9)
+	assert.Greater(t, item.P(2), item.P(0))
+	assert.InDelta(t, 1.5*1.5*0.25, item.Information(0.5), 1e-9)
+	assert.Greater(t, item.Information(0.5), item.Information(2))
+
+	miskeyed := irt.Item{Discrimination: -1, Difficulty: 0}
+	assert.Less(t, miskeyed.P(2), miskeyed.P(-2))
+}
+
+func TestFitErrors(t *testing.T) {
+	_, err := irt.Fit(nil, 1, 1)
+	assert.ErrorIs(t, err, irt.ErrNoResponses)
+
+	_, err = irt.Fit([]irt.Response{{Person: 0, Item: 2}}, 1, 2)
+	assert.ErrorIs(t, err, irt.ErrInvalidIndex)
+}
+
+func TestFitRecoversItems(t *testing.T) {
+	truth := []irt.Item{
+		{Discrimination: 1.2, Difficulty: -1.5},
+		{Discrimination: 1.0, Difficulty: -0.5},
+		{Discrimination: 1.5, Difficulty: 0},
+		{Discrimination: 0.8, Difficulty: 0.7},
+		{Discrimination: 1.3, Difficulty: 1.5},
+		{Discrimination: 2.0, Difficulty: 0.3},
+		// miskeyed, strong learners pick the answer marked wrong
+		{Discrimination: -1.2, Difficulty: 0},
+	}
+	rng := rand.New(rand.NewPCG(1, 2))
+	persons := 1000
+	abilities := make([]float64, persons)
+	responses := []irt.Response{}
+	for p := range persons {
+		abilities[p] = rng.NormFloat64()
+		for i, item := range truth {
+			responses = append(responses, irt.Response{Person: p, Item: i, Correct: rng.Float64() < item.P(abilities[p])})
+		}
+	}
+
+	out, err := irt.Fit(responses, persons, len(truth))
+	assert.NoError(t, err)
+	assert.True(t, out.Converged)
+	assert.Len(t, out.Abilities, persons)
+
+	for i, item := range truth {
+		assert.InDelta(t, item.Difficulty, out.Items[i].Difficulty, 0.35, "difficulty of item %d", i)
+		assert.InDelta(t, item.Discrimination, out.Items[i].Discrimination, 0.4, "discrimination of item %d", i)
+	}
+	assert.Negative(t, out.Items[6].Discrimination)
+
+	// estimated abilities follow the true ones
+	above, below := 0.0, 0.0
+	for p := range persons {
+		if abilities[p] > 1 {
+			above += out.Abilities[p]
+		}
+		if abilities[p] < -1 {
+			below += out.Abilities[p]
+		}
+	}
+	assert.Positive(t, above)
+	assert.Negative(t, below)
+}
+
+func TestFitOneSided(t *testing.T) {
+	// everyone answers right, the estimates stay finite
+	responses := []irt.Response{}
+	for p := range 20 {
+		responses = append(responses, irt.Response{Person: p, Item: 0, Correct: true})
+	}
+	out, err := irt.Fit(responses, 20, 1)
+	assert.NoError(t, err)
+	assert.GreaterOrEqual(t, out.Items[0].Difficulty, irt.MIN_THETA)
+	assert.Less(t, out.Items[0].Difficulty, 0.0)
+}
+
+func TestEstimate(t *testing.T) {
+	theta, se := irt.Estimate(nil)
+	assert.InDelta(t, 0, theta, 1e-9)
+	assert.InDelta(t, 1, se, 0.01)
+
+	items := []irt.Item{
+		{Discrimination: 1.5, Difficulty: -1},
+		{Discrimination: 1.5, Difficulty: 0},
+		{Discrimination: 1.5, Difficulty: 1},
+	}
+	strong := []irt.Answer{}
+	weak := []irt.Answer{}
+	for _, item := range items {
+		strong = append(strong, irt.Answer{Item: item, Correct: true})
+		weak = append(weak, irt.Answer{Item: item, Correct: false})
+	}
+
+	strongTheta, strongSE := irt.Estimate(strong)
+	weakTheta, _ := irt.Estimate(weak)
+	assert.Greater(t, strongTheta, 0.5)
+	assert.InDelta(t, -strongTheta, weakTheta, 1e-9)
+	assert.Less(t, strongSE, 1.0)
+
+	// answering more items narrows the estimate
+	mixed := append([]irt.Answer{}, strong[:2]...)
+	mixed = append(mixed, weak[2], irt.Answer{Item: irt.Item{Discrimination: 2, Difficulty: 0.5}, Correct: true})
+	_, mixedSE := irt.Estimate(mixed)
+	assert.Less(t, mixedSE, strongSE)
+}
@@ -0,0 +1,115 @@
+package kana
+
+import (
+	"strings"
+	"unicode"
+)
+
+const (
+	hiraganaStart = 'ぁ'
+	hiraganaEnd   = 'ゖ'
+	katakanaStart = 'ァ'
+	katakanaEnd   = 'ヶ'
+	kanaOffset    = katakanaStart - hiraganaStart
+
+	ProlongedSoundMark = 'ー'
+)
+
+func IsHiraganaRune(r rune) bool {
+	return (r >= hiraganaStart && r <= hiraganaEnd) || r == 'ゝ' || r == 'ゞ'
+}
+
+func IsKatakanaRune(r rune) bool {
+	return (r >= katakanaStart && r <= 'ヺ') || r == 'ヽ' || r == 'ヾ' || r == ProlongedSoundMark
+}
+
+func IsKanaRune(r rune) bool {
+	return IsHiraganaRune(r) || IsKatakanaRune(r)
+}
+
+// IsKanjiRune reports whether r is a CJK ideograph, including 々.
+func IsKanjiRune(r rune) bool {
+	return unicode.Is(unicode.Han, r) || r == '々'
+}
+
+// IsHiragana reports whether s is non-empty and only made of hiragana, the
+// prolonged sound mark and spaces.
+func IsHiragana(s string) bool {
+	return isAll(s, func(r rune) bool { return IsHiraganaRune(r) || r == ProlongedSoundMark })
+}
+
+// IsKatakana reports whether s is non-empty and only made of katakana and
+// spaces.
+func IsKatakana(s string) bool {
+	return isAll(s, IsKatakanaRune)
+}
+
+// IsKana reports whether s is non-empty and only made of hiragana, katakana
+// and spaces.
+func IsKana(s string) bool {
+	return isAll(s, IsKanaRune)
+}
+
+// IsRomaji reports whether s only contains latin letters (with macrons or
+// circumflexes), apostrophes, hyphens and spaces.
+func IsRomaji(s string) bool {
+	return isAll(s, func(r rune) bool {
+		if r < unicode.MaxASCII {
+			return unicode.IsLetter(r) || r == '\'' || r == '-'
+		}
+		_, ok := longVowels[unicode.ToLower(r)]
+		return ok
+	})
+}
+
+// ContainsKana reports whether s has at least one kana.
+func ContainsKana(s string) bool {
+	return strings.IndexFunc(s, IsKanaRune) >= 0
+}
+
+// ContainsKanji reports whether s has at least one kanji.
+func ContainsKanji(s string) bool {
+	return strings.IndexFunc(s, IsKanjiRune) >= 0
+}
+
+// ToHiragana converts every katakana in s to hiragana. Katakana without a
+// hiragana counterpart (ヷ, ヸ, ヹ, ヺ) and the prolonged sound mark are kept.
+func ToHiragana(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= katakanaStart && r <= katakanaEnd:
+			return r - kanaOffset
+		case r == 'ヽ' || r == 'ヾ':
+			return r - kanaOffset
+		}
+		return r
+	}, s)
+}
+
+// ToKatakana converts every hiragana in s to katakana.
+func ToKatakana(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= hiraganaStart && r <= hiraganaEnd:
+			return r + kanaOffset
+		case r == 'ゝ' || r == 'ゞ':
+			return r + kanaOffset
+		}
+		return r
+	}, s)
+}
+
+func isAll(s string, fn func(r rune) bool) bool {
+	if strings.TrimSpace(s) == "" {
+		return false
+	}
+	for _, r := range s {
+		if unicode.IsSpace(r) {
+			continue
+		}
+		if !fn(r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,36 @@
+package kana
+
+import "strings"
+
+// jlptKanji lists the kanji commonly expected at each JLPT level. The exam
+// has no official list since 2010, N5 follows the widely used pre-2010 one.
+var jlptKanji = map[string]string{
+	"N5": "一二三四五六七八九十百千万円日月火水木金土曜年時分半午前後間週毎今" +
+		"人男女子父母友名先生学校語本書読話聞見言食飲行来出入休立" +
+		"上下左右中外東西南北大小高長安新古多少白天気雨山川田花電車国何",
+}
+
+// JLPTKanji returns the kanji of a JLPT level such as "N5", nil for a level
+// without a list.
+func JLPTKanji(level string) []string {
+	list, ok := jlptKanji[level]
+	if !ok {
+		return nil
+	}
+	return strings.Split(list, "")
+}
+
+// Kanji returns the distinct kanji of s in order of appearance. The
+// iteration mark 々 is not a kanji of its own and is left out.
+func Kanji(s string) []string {
+	seen := map[rune]bool{}
+	out := []string{}
+	for _, r := range s {
+		if r == '々' || !IsKanjiRune(r) || seen[r] {
+			continue
+		}
+		seen[r] = true
+		out = append(out, string(r))
+	}
+	return out
+}
@@ -0,0 +1,239 @@
+package kana
+
+import (
+	"strings"
+)
+
+type System int
+
+const (
+	Hepburn System = iota
+	Kunrei
+)
+
+type Options struct {
+	System System
+	// LongVowelMarks writes long vowels with a macron (Hepburn, ō) or a
+	// circumflex (Kunrei, ô) instead of doubling them.
+	LongVowelMarks bool
+}
+
+// ToRomaji converts kana to modified Hepburn romaji without long vowel marks.
+// Characters that are not kana are kept as they are.
+func ToRomaji(s string) string {
+	return ToRomajiWith(s, Options{System: Hepburn})
+}
+
+func ToRomajiWith(s string, opt Options) string {
+	rs := []rune(ToHiragana(s))
+	out := []rune{}
+	var lastVowel byte
+
+	for i := 0; i < len(rs); {
+		r := rs[i]
+		switch r {
+		case 'っ':
+			next, _ := syllableAt(rs, i+1, opt.System)
+			if next != "" && !isVowel(next[0]) {
+				if opt.System == Hepburn && strings.HasPrefix(next, "ch") {
+					out = append(out, 't')
+				} else {
+					out = append(out, rune(next[0]))
+				}
+			}
+			lastVowel = 0
+			i++
+			continue
+		case 'ん':
+			out = append(out, 'n')
+			next, _ := syllableAt(rs, i+1, opt.System)
+			if next != "" && (isVowel(next[0]) || next[0] == 'y') {
+				out = append(out, '\'')
+			}
+			lastVowel = 0
+			i++
+			continue
+		case ProlongedSoundMark:
+			if lastVowel != 0 {
+				if opt.LongVowelMarks {
+					out = append(out[:len(out)-1], []rune(longMark(lastVowel, opt.System))...)
+					lastVowel = 0
+				} else {
+					out = append(out, rune(lastVowel))
+				}
+			}
+			i++
+			continue
+		}
+
+		syl, n := syllableAt(rs, i, opt.System)
+		if n == 0 {
+			out = append(out, r)
+			lastVowel = 0
+			i++
+			continue
+		}
+
+		if opt.LongVowelMarks && lastVowel != 0 && len(syl) == 1 && isLongPair(lastVowel, syl[0]) {
+			out = append(out[:len(out)-1], []rune(longMark(lastVowel, opt.System))...)
+			lastVowel = 0
+			i += n
+			continue
+		}
+
+		out = append(out, []rune(syl)...)
+		lastVowel = 0
+		if isVowel(syl[len(syl)-1]) {
+			lastVowel = syl[len(syl)-1]
+		}
+		i += n
+	}
+	return string(out)
+}
+
+// RomajiToHiragana converts Hepburn or Kunrei romaji to hiragana. Macrons and
+// circumflexes become doubled vowels (ō becomes おう), "n'" or a lone n becomes
+// ん and doubled consonants become っ. Unknown characters are kept.
+func RomajiToHiragana(s string) string {
+	return romajiToKana(s, false)
+}
+
+// RomajiToKatakana converts romaji to katakana, writing long vowels with ー.
+func RomajiToKatakana(s string) string {
+	return romajiToKana(s, true)
+}
+
+func romajiToKana(s string, katakana bool) string {
+	expanded := strings.Builder{}
+	for _, r := range strings.ToLower(s) {
+		v, ok := longVowels[r]
+		if !ok {
+			expanded.WriteRune(r)
+			continue
+		}
+		expanded.WriteRune(v)
+		switch {
+		case katakana:
+			expanded.WriteRune('-')
+		case v == 'o':
+			expanded.WriteRune('u')
+		default:
+			expanded.WriteRune(v)
+		}
+	}
+
+	rs := []rune(expanded.String())
+	out := strings.Builder{}
+	for i := 0; i < len(rs); {
+		r := rs[i]
+		next := runeAt(rs, i+1)
+		switch {
+		case r == 'n' && next == '\'':
+			out.WriteRune('ん')
+			i += 2
+			continue
+		case r == 'n' && next == 'n' && !isVowelOrY(runeAt(rs, i+2)):
+			out.WriteRune('ん')
+			i += 2
+			continue
+		case r == 'n' && !isVowelOrY(next):
+			out.WriteRune('ん')
+			i++
+			continue
+		case r == 'm' && (next == 'b' || next == 'p' || next == 'm'):
+			out.WriteRune('ん')
+			i++
+			continue
+		case r == next && isConsonantRune(r):
+			out.WriteRune('っ')
+			i++
+			continue
+		case r == 't' && next == 'c' && runeAt(rs, i+2) == 'h':
+			out.WriteRune('っ')
+			i++
+			continue
+		case r == '-':
+			out.WriteRune(ProlongedSoundMark)
+			i++
+			continue
+		}
+
+		matched := false
+		for size := 4; size > 0; size-- {
+			if i+size > len(rs) {
+				continue
+			}
+			if k, ok := romajiTable[string(rs[i:i+size])]; ok {
+				out.WriteString(k)
+				i += size
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			out.WriteRune(r)
+			i++
+		}
+	}
+
+	if katakana {
+		return ToKatakana(out.String())
+	}
+	return out.String()
+}
+
+// syllableAt returns the romaji of the (possibly two rune) syllable starting
+// at i and the number of runes it spans.
+func syllableAt(rs []rune, i int, system System) (string, int) {
+	for size := 2; size > 0; size-- {
+		if i+size > len(rs) {
+			continue
+		}
+		key := string(rs[i : i+size])
+		if system == Kunrei {
+			if v, ok := kunreiOverrides[key]; ok {
+				return v, size
+			}
+		}
+		if v, ok := hepburnTable[key]; ok {
+			return v, size
+		}
+	}
+	return "", 0
+}
+
+func longMark(vowel byte, system System) string {
+	if system == Kunrei {
+		return circumflexes[vowel]
+	}
+	return macrons[vowel]
+}
+
+// isLongPair reports whether a vowel following prev lengthens it, as in おう,
+// おお, うう, ああ and ええ. い is left alone since Hepburn keeps "ii" and
+// "ei".
+func isLongPair(prev, cur byte) bool {
+	if prev == 'o' && cur == 'u' {
+		return true
+	}
+	return prev == cur && cur != 'i'
+}
+
+func runeAt(rs []rune, i int) rune {
+	if i < 0 || i >= len(rs) {
+		return 0
+	}
+	return rs[i]
+}
+
+func isVowel(b byte) bool {
+	return b == 'a' || b == 'i' || b == 'u' || b == 'e' || b == 'o'
+}
+
+func isVowelOrY(r rune) bool {
+	return r == 'a' || r == 'i' || r == 'u' || r == 'e' || r == 'o' || r == 'y'
+}
+
+func isConsonantRune(r rune) bool {
+	return r >= 'b' && r <= 'z' && !isVowelOrY(r) && r != 'n'
+}
@@ -0,0 +1,292 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+	"wakuwaku_nihongo/internals/pkg/kana"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToHiraganaAndKatakana(t *testing.T) {
+	tests := []struct {
+		katakana string
+		hiragana string
+	}{
+		{"アイウエオ", "あいうえお"},
+		{"ガギグゲゴ", "がぎぐげご"},
+		{"パピプペポ", "ぱぴぷぺぽ"},
+		{"キャッチ", "きゃっち"},
+		{"ヴァイオリン", "ゔぁいおりん"},
+		{"ラーメン", "らーめん"},
+		{"ヵヶ", "ゕゖ"},
+		{"漢字とカナ", "漢字とかな"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.katakana, func(t *testing.T) {
+			assert.Equal(t, tt.hiragana, kana.ToHiragana(tt.katakana))
+		})
+	}
+
+	for _, tt := range tests {
+		if kana.ContainsKanji(tt.katakana) {
+			continue
+		}
+		t.Run(tt.hiragana, func(t *testing.T) {
+			assert.Equal(t, tt.katakana, kana.ToKatakana(tt.hiragana))
+		})
+	}
+}
+
+func TestToRomajiHepburn(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		// gojūon
+		{"あいうえお", "aiueo"},
+		{"かきくけこ", "kakikukeko"},
+		{"さしすせそ", "sashisuseso"},
+		{"たちつてと", "tachitsuteto"},
+		{"なにぬねの", "naninuneno"},
+		{"はひふへほ", "hahifuheho"},
+		{"まみむめも", "mamimumemo"},
+		{"やゆよ", "yayuyo"},
+		{"らりるれろ", "rarirurero"},
+		{"わを", "wao"},
+		// dakuten and handakuten
+		{"がぎぐげご", "gagigugego"},
+		{"ざじずぜぞ", "zajizuzezo"},
+		{"だぢづでど", "dajizudedo"},
+		{"ばびぶべぼ", "babibubebo"},
+		{"ぱぴぷぺぽ", "papipupepo"},
+		// yōon
+		{"きゃきゅきょ", "kyakyukyo"},
+		{"しゃしゅしょ", "shashusho"},
+		{"ちゃちゅちょ", "chachucho"},
+		{"じゃじゅじょ", "jajujo"},
+		{"にゃにゅにょ", "nyanyunyo"},
+		{"ひゃひゅひょ", "hyahyuhyo"},
+		{"みゃみゅみょ", "myamyumyo"},
+		{"りゃりゅりょ", "ryaryuryo"},
+		{"ぎゃびゃぴゃ", "gyabyapya"},
+		// small tsu
+		{"きって", "kitte"},
+		{"ざっし", "zasshi"},
+		{"まっちゃ", "matcha"},
+		{"いっぱい", "ippai"},
+		{"あっ", "a"},
+		// ん before vowels and y
+		{"きんえん", "kin'en"},
+		{"こんや", "kon'ya"},
+		{"しんぶん", "shinbun"},
+		{"ほん", "hon"},
+		{"こんにちは", "konnichiha"},
+		// long vowels without marks
+		{"とうきょう", "toukyou"},
+		{"おかあさん", "okaasan"},
+		{"ラーメン", "raamen"},
+		{"コーヒー", "koohii"},
+		// loanword combinations
+		{"ファイル", "fairu"},
+		{"パーティー", "paatii"},
+		{"ヴァイオリン", "vaiorin"},
+		{"ウィキ", "wiki"},
+		{"チェック", "chekku"},
+		// katakana input and passthrough
+		{"カタカナ", "katakana"},
+		{"日本語です", "日本語desu"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			assert.Equal(t, tt.want, kana.ToRomaji(tt.in))
+		})
+	}
+}
+
+func TestToRomajiKunrei(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"さしすせそ", "sasisuseso"},
+		{"たちつてと", "tatituteto"},
+		{"はひふへほ", "hahihuheho"},
+		{"じ", "zi"},
+		{"しゃしゅしょ", "syasyusyo"},
+		{"ちゃちゅちょ", "tyatyutyo"},
+		{"じゃじゅじょ", "zyazyuzyo"},
+		{"まっちゃ", "mattya"},
+		{"きんえん", "kin'en"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			assert.Equal(t, tt.want, kana.ToRomajiWith(tt.in, kana.Options{System: kana.Kunrei}))
+		})
+	}
+}
+
+func TestToRomajiLongVowelMarks(t *testing.T) {
+	tests := []struct {
+		in     string
+		system kana.System
+		want   string
+	}{
+		{"とうきょう", kana.Hepburn, "tōkyō"},
+		{"おおさか", kana.Hepburn, "ōsaka"},
+		{"くうき", kana.Hepburn, "kūki"},
+		{"おかあさん", kana.Hepburn, "okāsan"},
+		{"おねえさん", kana.Hepburn, "onēsan"},
+		{"おにいさん", kana.Hepburn, "oniisan"},
+		{"せんせい", kana.Hepburn, "sensei"},
+		{"ラーメン", kana.Hepburn, "rāmen"},
+		{"スーパー", kana.Hepburn, "sūpā"},
+		{"とうきょう", kana.Kunrei, "tôkyô"},
+		{"ラーメン", kana.Kunrei, "râmen"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got := kana.ToRomajiWith(tt.in, kana.Options{System: tt.system, LongVowelMarks: true})
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRomajiToHiragana(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		// both systems
+		{"sashisuseso", "さしすせそ"},
+		{"sasisuseso", "さしすせそ"},
+		{"tachitsuteto", "たちつてと"},
+		{"tatituteto", "たちつてと"},
+		{"fuji", "ふじ"},
+		{"huzi", "ふじ"},
+		{"shashusho", "しゃしゅしょ"},
+		{"syasyusyo", "しゃしゅしょ"},
+		{"chachucho", "ちゃちゅちょ"},
+		{"tyatyutyo", "ちゃちゅちょ"},
+		{"jajujo", "じゃじゅじょ"},
+		{"zyazyuzyo", "じゃじゅじょ"},
+		{"jyajyujyo", "じゃじゅじょ"},
+		// small tsu
+		{"kitte", "きって"},
+		{"zasshi", "ざっし"},
+		{"matcha", "まっちゃ"},
+		{"mattya", "まっちゃ"},
+		{"ippai", "いっぱい"},
+		// ん
+		{"kin'en", "きんえん"},
+		{"kinen", "きねん"},
+		{"kon'ya", "こんや"},
+		{"konya", "こにゃ"},
+		{"shinbun", "しんぶん"},
+		{"shimbun", "しんぶん"},
+		{"konnichiwa", "こんにちわ"},
+		{"onna", "おんな"},
+		{"hon", "ほん"},
+		{"kann", "かん"},
+		{"konnyaku", "こんにゃく"},
+		// long vowels
+		{"tōkyō", "とうきょう"},
+		{"tôkyô", "とうきょう"},
+		{"okāsan", "おかあさん"},
+		{"kūki", "くうき"},
+		{"ra-men", "らーめん"},
+		// case and particles
+		{"Nihongo", "にほんご"},
+		{"watashi wo", "わたし を"},
+		{"xtsu", "っ"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			assert.Equal(t, tt.want, kana.RomajiToHiragana(tt.in))
+		})
+	}
+}
+
+func TestRomajiToKatakana(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"rāmen", "ラーメン"},
+		{"ra-men", "ラーメン"},
+		{"sūpā", "スーパー"},
+		{"fairu", "ファイル"},
+		{"vaiorin", "ヴァイオリン"},
+		{"chekku", "チェック"},
+		{"konpyu-ta-", "コンピューター"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			assert.Equal(t, tt.want, kana.RomajiToKatakana(tt.in))
+		})
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	words := []string{
+		"にほんご", "べんきょう", "がっこう", "きんえん", "こんや", "しゃしん",
+		"ちゅうごく", "じゅぎょう", "きって", "まっちゃ", "ひゃく", "りょこう",
+	}
+
+	for _, w := range words {
+		t.Run(w, func(t *testing.T) {
+			assert.Equal(t, w, kana.RomajiToHiragana(kana.ToRomaji(w)))
+			kunrei := kana.ToRomajiWith(w, kana.Options{System: kana.Kunrei})
+			assert.Equal(t, w, kana.RomajiToHiragana(kunrei))
+		})
+	}
+}
+
+func TestPredicates(t *testing.T) {
+	tests := []struct {
+		in       string
+		hiragana bool
+		katakana bool
+		isKana   bool
+		romaji   bool
+	}{
+		{"ひらがな", true, false, true, false},
+		{"カタカナ", false, true, true, false},
+		{"ひらがな カタカナ", false, false, true, false},
+		{"らーめん", true, false, true, false},
+		{"漢字", false, false, false, false},
+		{"tōkyō", false, false, false, true},
+		{"kin'en", false, false, false, true},
+		{"", false, false, false, false},
+		{"  ", false, false, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			assert.Equal(t, tt.hiragana, kana.IsHiragana(tt.in), "IsHiragana")
+			assert.Equal(t, tt.katakana, kana.IsKatakana(tt.in), "IsKatakana")
+			assert.Equal(t, tt.isKana, kana.IsKana(tt.in), "IsKana")
+			assert.Equal(t, tt.romaji, kana.IsRomaji(tt.in), "IsRomaji")
+		})
+	}
+}
+
+func TestKanji(t *testing.T) {
+	assert.Equal(t, []string{"日", "本", "語", "人"}, kana.Kanji("日本語の日本人"))
+	assert.Equal(t, []string{"時"}, kana.Kanji("時々"))
+	assert.Empty(t, kana.Kanji("ひらがなとカタカナ"))
+}
+
+func TestJLPTKanji(t *testing.T) {
+	n5 := kana.JLPTKanji("N5")
+	assert.Len(t, n5, 92)
+	assert.Equal(t, n5, kana.Kanji(strings.Join(n5, "")), "no duplicates")
+	assert.Nil(t, kana.JLPTKanji("N0"))
+}
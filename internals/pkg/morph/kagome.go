@@ -0,0 +1,52 @@
+package morph
+
+import (
+	"sync"
+
+	"github.com/ikawaha/kagome-dict/ipa"
+	"github.com/ikawaha/kagome/v2/tokenizer"
+
+	"wakuwaku_nihongo/internals/pkg/kana"
+)
+
+type kagomeAnalyzer struct {
+	tokenizer *tokenizer.Tokenizer
+}
+
+var (
+	defaultAnalyzer Analyzer
+	defaultOnce     sync.Once
+)
+
+// Default returns the shared analyzer backed by kagome and the embedded IPA
+// dictionary. The dictionary is loaded on first use.
+func Default() Analyzer {
+	defaultOnce.Do(func() {
+		t, err := tokenizer.New(ipa.Dict(), tokenizer.OmitBosEos())
+		if err != nil {
+			panic(err)
+		}
+		defaultAnalyzer = &kagomeAnalyzer{tokenizer: t}
+	})
+	return defaultAnalyzer
+}
+
+func (a *kagomeAnalyzer) Analyze(text string) []Token {
+	out := []Token{}
+	for _, t := range a.tokenizer.Tokenize(text) {
+		token := Token{
+			Surface: t.Surface,
+			POS:     t.POS(),
+			Start:   t.Start,
+			End:     t.End,
+		}
+		if base, ok := t.BaseForm(); ok && base != "*" {
+			token.BaseForm = base
+		}
+		if reading, ok := t.Reading(); ok && reading != "*" {
+			token.Reading = kana.ToHiragana(reading)
+		}
+		out = append(out, token)
+	}
+	return out
+}
@@ -0,0 +1,145 @@
+// Package morph splits Japanese text into morphemes. The analyzer is hidden
+// behind the Analyzer interface so the tokenizer or its dictionary can be
+// swapped without touching the callers.
+package morph
+
+import (
+	"strings"
+
+	"wakuwaku_nihongo/internals/pkg/kana"
+	"wakuwaku_nihongo/internals/pkg/ruby"
+)
+
+// Main parts of speech, as named by the IPA dictionary.
+const (
+	PosNoun      = "名詞"
+	PosVerb      = "動詞"
+	PosAdjective = "形容詞"
+	PosAdverb    = "副詞"
+	PosParticle  = "助詞"
+	PosAuxiliary = "助動詞"
+	PosSymbol    = "記号"
+)
+
+// Token is a morpheme. Reading is in hiragana and empty when the dictionary
+// does not know the word. Start and End are rune offsets in the input.
+type Token struct {
+	Surface  string
+	BaseForm string
+	Reading  string
+	POS      []string
+	Start    int
+	End      int
+}
+
+type Analyzer interface {
+	Analyze(text string) []Token
+}
+
+// PartOfSpeech returns the main part of speech, e.g. 名詞.
+func (t Token) PartOfSpeech() string {
+	if len(t.POS) == 0 {
+		return ""
+	}
+	return t.POS[0]
+}
+
+// Is reports whether the token's part of speech starts with pos, so
+// Is("名詞") and Is("名詞", "固有名詞") both match a proper noun.
+func (t Token) Is(pos ...string) bool {
+	if len(pos) > len(t.POS) {
+		return false
+	}
+	for i, p := range pos {
+		if t.POS[i] != p {
+			return false
+		}
+	}
+	return true
+}
+
+// Furigana returns the segments of the analyzed text with a reading on every
+// kanji. Okurigana is kept outside the reading, so 食べる is 食[た]べる.
+func Furigana(tokens []Token) []ruby.Segment {
+	out := []ruby.Segment{}
+	for _, t := range tokens {
+		for _, seg := range Align(t.Surface, t.Reading) {
+			if seg.Reading == "" && len(out) > 0 && out[len(out)-1].Reading == "" {
+				out[len(out)-1].Text += seg.Text
+				continue
+			}
+			out = append(out, seg)
+		}
+	}
+	return out
+}
+
+// Align splits a word into segments giving each run of kanji its part of the
+// reading. A word without kanji or without reading is a single plain segment,
+// and a reading that cannot be aligned is put on the whole word.
+func Align(surface, reading string) []ruby.Segment {
+	reading = kana.ToHiragana(reading)
+	if !kana.ContainsKanji(surface) || reading == "" {
+		return []ruby.Segment{{Text: surface}}
+	}
+
+	parts := splitRuns(surface)
+	if segs, ok := align(parts, []rune(reading)); ok {
+		return segs
+	}
+	return []ruby.Segment{{Text: surface, Reading: reading}}
+}
+
+// splitRuns splits s into alternating runs of kana and of anything else.
+func splitRuns(s string) []string {
+	out := []string{}
+	var cur strings.Builder
+	last := -1
+	for _, r := range s {
+		isKana := 0
+		if kana.IsKanaRune(r) {
+			isKana = 1
+		}
+		if last >= 0 && isKana != last {
+			out = append(out, cur.String())
+			cur.Reset()
+		}
+		cur.WriteRune(r)
+		last = isKana
+	}
+	if cur.Len() > 0 {
+		out = append(out, cur.String())
+	}
+	return out
+}
+
+func align(parts []string, reading []rune) ([]ruby.Segment, bool) {
+	if len(parts) == 0 {
+		return nil, len(reading) == 0
+	}
+
+	part := parts[0]
+	if kana.IsKana(part) {
+		want := []rune(kana.ToHiragana(part))
+		if len(want) > len(reading) || string(reading[:len(want)]) != string(want) {
+			return nil, false
+		}
+		rest, ok := align(parts[1:], reading[len(want):])
+		if !ok {
+			return nil, false
+		}
+		return append([]ruby.Segment{{Text: part}}, rest...), true
+	}
+
+	// Give the run the shortest reading that lets the rest line up.
+	for n := 1; n <= len(reading); n++ {
+		if len(parts) == 1 && n < len(reading) {
+			continue
+		}
+		rest, ok := align(parts[1:], reading[n:])
+		if ok {
+			return append([]ruby.Segment{{Text: part, Reading: string(reading[:n])}}, rest...), true
+		}
+	}
+	return nil, false
+}
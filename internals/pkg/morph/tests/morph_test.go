@@ -0,0 +1,54 @@
+package tests
+
+import (
+	"testing"
+	"wakuwaku_nihongo/internals/pkg/morph"
+	"wakuwaku_nihongo/internals/pkg/ruby"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlign(t *testing.T) {
+	tests := []struct {
+		surface string
+		reading string
+		want    []ruby.Segment
+	}{
+		{"学校", "ガッコウ", []ruby.Segment{{Text: "学校", Reading: "がっこう"}}},
+		{"食べる", "たべる", []ruby.Segment{{Text: "食", Reading: "た"}, {Text: "べる"}}},
+		{"取り消し", "トリケシ", []ruby.Segment{{Text: "取", Reading: "と"}, {Text: "り"}, {Text: "消", Reading: "け"}, {Text: "し"}}},
+		{"お茶", "オチャ", []ruby.Segment{{Text: "お"}, {Text: "茶", Reading: "ちゃ"}}},
+		{"ひらがな", "ヒラガナ", []ruby.Segment{{Text: "ひらがな"}}},
+		{"漢字", "", []ruby.Segment{{Text: "漢字"}}},
+		{"行っ", "イッ", []ruby.Segment{{Text: "行", Reading: "い"}, {Text: "っ"}}},
+		{"気をつけ", "キヲツケ", []ruby.Segment{{Text: "気", Reading: "き"}, {Text: "をつけ"}}},
+		{"今日は", "キョウハ", []ruby.Segment{{Text: "今日", Reading: "きょう"}, {Text: "は"}}},
+		{"見る", "ミタ", []ruby.Segment{{Text: "見る", Reading: "みた"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.surface, func(t *testing.T) {
+			assert.Equal(t, tt.want, morph.Align(tt.surface, tt.reading))
+		})
+	}
+}
+
+func TestAnalyze(t *testing.T) {
+	tokens := morph.Default().Analyze("私は毎朝学校へ行きます。")
+
+	surfaces := []string{}
+	for _, tok := range tokens {
+		surfaces = append(surfaces, tok.Surface)
+	}
+	assert.Equal(t, []string{"私", "は", "毎朝", "学校", "へ", "行き", "ます", "。"}, surfaces)
+
+	assert.True(t, tokens[1].Is(morph.PosParticle))
+	assert.True(t, tokens[5].Is(morph.PosVerb))
+	assert.Equal(t, "行く", tokens[5].BaseForm)
+	assert.Equal(t, "いき", tokens[5].Reading)
+	assert.Equal(t, 7, tokens[5].Start)
+	assert.Equal(t, 9, tokens[5].End)
+
+	markup := ruby.Markup(morph.Furigana(tokens))
+	assert.Equal(t, "私[わたし]は毎朝[まいあさ]学校[がっこう]へ行[い]きます。", markup)
+}
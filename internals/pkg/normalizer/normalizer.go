@@ -0,0 +1,229 @@
+package normalizer
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+
+	"wakuwaku_nihongo/internals/pkg/kana"
+)
+
+// Mode is a grading strictness preset stored per question.
+type Mode string
+
+const (
+	// ModeExact only folds full/half width and trims surrounding spaces.
+	ModeExact Mode = "exact"
+	// ModeStandard also folds katakana to hiragana, ignores case, whitespace
+	// and punctuation.
+	ModeStandard Mode = "standard"
+	// ModeLenient also tolerates missing or extra okurigana and long vowels.
+	ModeLenient Mode = "lenient"
+
+	DefaultMode = ModeStandard
+)
+
+func (m Mode) IsValid() bool {
+	return m == ModeExact || m == ModeStandard || m == ModeLenient
+}
+
+type Options struct {
+	FoldKana         bool
+	FoldCase         bool
+	StripWhitespace  bool
+	StripPunctuation bool
+	IgnoreLongVowels bool
+	IgnoreOkurigana  bool
+}
+
+func (m Mode) Options() Options {
+	switch m {
+	case ModeExact:
+		return Options{}
+	case ModeLenient:
+		return Options{
+			FoldKana:         true,
+			FoldCase:         true,
+			StripWhitespace:  true,
+			StripPunctuation: true,
+			IgnoreLongVowels: true,
+			IgnoreOkurigana:  true,
+		}
+	default:
+		return Options{
+			FoldKana:         true,
+			FoldCase:         true,
+			StripWhitespace:  true,
+			StripPunctuation: true,
+		}
+	}
+}
+
+// Normalize runs s through the pipeline selected by opt. NFKC is always
+// applied so full-width latin and half-width katakana compare equal to their
+// usual forms. Okurigana tolerance cannot be expressed as a normalization and
+// is only honoured by Match.
+func Normalize(s string, opt Options) string {
+	t := []transform.Transformer{norm.NFKC}
+	if opt.StripWhitespace {
+		t = append(t, runes.Remove(runes.In(unicode.White_Space)))
+	}
+	if opt.StripPunctuation {
+		t = append(t, runes.Remove(runes.Predicate(isPunctuation)))
+	}
+	if opt.FoldCase {
+		t = append(t, runes.Map(unicode.ToLower))
+	}
+
+	out, _, err := transform.String(transform.Chain(t...), s)
+	if err != nil {
+		out = s
+	}
+	out = strings.TrimSpace(out)
+
+	if opt.FoldKana {
+		out = kana.ToHiragana(out)
+	}
+	if opt.IgnoreLongVowels {
+		out = foldLongVowels(out)
+	}
+	return out
+}
+
+// Match reports whether a typed answer is equal to the expected answer once
+// both are normalized with opt.
+func Match(input, expected string, opt Options) bool {
+	a := Normalize(input, opt)
+	b := Normalize(expected, opt)
+	if a == b {
+		return true
+	}
+	if !opt.IgnoreOkurigana {
+		return false
+	}
+	return matchOkurigana([]rune(a), []rune(b))
+}
+
+// isPunctuation matches ASCII and Japanese punctuation and symbols such as
+// 、。「」・〜. The prolonged sound mark is a letter and is kept.
+func isPunctuation(r rune) bool {
+	return unicode.IsPunct(r) || unicode.IsSymbol(r) || r == '〜' || r == '～'
+}
+
+// foldLongVowels drops the kana that only lengthen the previous vowel, so
+// とうきょう, ときょ and トーキョー all become ときょ.
+func foldLongVowels(s string) string {
+	rs := []rune(s)
+	out := make([]rune, 0, len(rs))
+	var last byte
+	for i := 0; i < len(rs); {
+		if rs[i] == kana.ProlongedSoundMark {
+			i++
+			continue
+		}
+
+		n := 1
+		if kana.IsHiraganaRune(rs[i]) && isSmallKana(runeAt(rs, i+1)) {
+			n = 2
+		}
+		syllable := string(rs[i : i+n])
+		romaji := kana.ToRomaji(syllable)
+		if n == 1 && last != 0 && len(romaji) == 1 && lengthens(last, romaji[0]) {
+			i++
+			continue
+		}
+
+		out = append(out, rs[i:i+n]...)
+		last = 0
+		if romaji != "" && romaji != syllable {
+			last = romaji[len(romaji)-1]
+		}
+		i += n
+	}
+	return string(out)
+}
+
+func lengthens(prev, cur byte) bool {
+	switch prev {
+	case 'o':
+		return cur == 'o' || cur == 'u'
+	case 'e':
+		return cur == 'e' || cur == 'i'
+	case 'a', 'i', 'u':
+		return cur == prev
+	}
+	return false
+}
+
+func isSmallKana(r rune) bool {
+	return strings.ContainsRune("ゃゅょぁぃぅぇぉゎ", r)
+}
+
+func runeAt(rs []rune, i int) rune {
+	if i >= len(rs) {
+		return 0
+	}
+	return rs[i]
+}
+
+// matchOkurigana compares a and b allowing hiragana that directly follow a
+// kanji to be missing on either side, as in 申込み/申し込み or 行なう/行う.
+// The last kana of a trailing run is never skipped, so 食べる and 食べた stay
+// different.
+func matchOkurigana(a, b []rune) bool {
+	skipA := skippable(a)
+	skipB := skippable(b)
+
+	memo := map[[2]int]bool{}
+	var match func(i, j int) bool
+	match = func(i, j int) bool {
+		if i == len(a) && j == len(b) {
+			return true
+		}
+		key := [2]int{i, j}
+		if v, ok := memo[key]; ok {
+			return v
+		}
+
+		ok := false
+		if i < len(a) && j < len(b) && a[i] == b[j] {
+			ok = match(i+1, j+1)
+		}
+		if !ok && i < len(a) && skipA[i] {
+			ok = match(i+1, j)
+		}
+		if !ok && j < len(b) && skipB[j] {
+			ok = match(i, j+1)
+		}
+		memo[key] = ok
+		return ok
+	}
+	return match(0, 0)
+}
+
+// skippable marks the hiragana that belong to a run right after a kanji and
+// are followed by another kanji, or that are not the last kana of the run.
+func skippable(rs []rune) []bool {
+	out := make([]bool, len(rs))
+	for i := 0; i < len(rs); i++ {
+		if !kana.IsKanjiRune(rs[i]) {
+			continue
+		}
+		j := i + 1
+		for j < len(rs) && kana.IsHiraganaRune(rs[j]) {
+			j++
+		}
+		end := j
+		if j == len(rs) || !kana.IsKanjiRune(rs[j]) {
+			end = j - 1
+		}
+		for k := i + 1; k < end; k++ {
+			out[k] = true
+		}
+		i = j - 1
+	}
+	return out
+}
@@ -0,0 +1,72 @@
+package tests
+
+import (
+	"testing"
+	"wakuwaku_nihongo/internals/pkg/normalizer"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		mode normalizer.Mode
+		want string
+	}{
+		{"exact folds width", "ＡＢＣ１２３", normalizer.ModeExact, "ABC123"},
+		{"exact folds half-width katakana", "ｶﾀｶﾅ", normalizer.ModeExact, "カタカナ"},
+		{"exact keeps kana script", "カタカナ", normalizer.ModeExact, "カタカナ"},
+		{"exact trims", "  たべる ", normalizer.ModeExact, "たべる"},
+		{"standard folds katakana", "カタカナ", normalizer.ModeStandard, "かたかな"},
+		{"standard strips spaces", "わたし は　がくせい", normalizer.ModeStandard, "わたしはがくせい"},
+		{"standard strips punctuation", "「はい、そうです。」", normalizer.ModeStandard, "はいそうです"},
+		{"standard strips wave dash", "〜ている", normalizer.ModeStandard, "ている"},
+		{"standard folds case", "Tokyo", normalizer.ModeStandard, "tokyo"},
+		{"standard keeps long vowel mark", "ラーメン", normalizer.ModeStandard, "らーめん"},
+		{"lenient folds long vowels", "とうきょう", normalizer.ModeLenient, "ときょ"},
+		{"lenient folds prolonged mark", "トーキョー", normalizer.ModeLenient, "ときょ"},
+		{"lenient folds ei", "せんせい", normalizer.ModeLenient, "せんせ"},
+		{"lenient keeps kanji", "先生", normalizer.ModeLenient, "先生"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, normalizer.Normalize(tt.in, tt.mode.Options()))
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		mode     normalizer.Mode
+		want     bool
+	}{
+		{"exact equal", "食べる", "食べる", normalizer.ModeExact, true},
+		{"exact full-width digits", "３つ", "3つ", normalizer.ModeExact, true},
+		{"exact rejects katakana", "タベル", "たべる", normalizer.ModeExact, false},
+		{"exact rejects punctuation", "はい。", "はい", normalizer.ModeExact, false},
+		{"standard accepts katakana", "タベル", "たべる", normalizer.ModeStandard, true},
+		{"standard accepts half-width", "ﾀﾍﾞﾙ", "たべる", normalizer.ModeStandard, true},
+		{"standard accepts punctuation", "はい。", "はい", normalizer.ModeStandard, true},
+		{"standard rejects long vowel", "とうきょ", "とうきょう", normalizer.ModeStandard, false},
+		{"standard rejects okurigana", "申込み", "申し込み", normalizer.ModeStandard, false},
+		{"lenient long vowel", "とうきょ", "とうきょう", normalizer.ModeLenient, true},
+		{"lenient prolonged mark", "コーヒー", "こおひい", normalizer.ModeLenient, true},
+		{"lenient okurigana between kanji", "申込み", "申し込み", normalizer.ModeLenient, true},
+		{"lenient okurigana extra kana", "行なう", "行う", normalizer.ModeLenient, true},
+		{"lenient okurigana noun form", "取消し", "取り消し", normalizer.ModeLenient, true},
+		{"lenient keeps conjugation", "食べた", "食べる", normalizer.ModeLenient, false},
+		{"lenient keeps different word", "飲む", "読む", normalizer.ModeLenient, false},
+		{"lenient keeps different reading", "きょう", "きのう", normalizer.ModeLenient, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, normalizer.Match(tt.input, tt.expected, tt.mode.Options()))
+		})
+	}
+}
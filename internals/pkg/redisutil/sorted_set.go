@@ -0,0 +1,189 @@
+package redisutil
+
+import (
+	"github.com/gomodule/redigo/redis"
+)
+
+// Member is a member of a sorted set with its score.
+type Member struct {
+	Member string
+	Score  float64
+}
+
+func (r *Redis) ZAdd(key string, score float64, member string) (int64, error) {
+	conn := r.client.Get()
+	defer func(conn redis.Conn) {
+		_ = conn.Close()
+	}(conn)
+	return redis.Int64(conn.Do("ZADD", key, score, member))
+}
+
+// ZIncrBy adds increment to the score of member and returns the new score.
+func (r *Redis) ZIncrBy(key string, increment float64, member string) (float64, error) {
+	conn := r.client.Get()
+	defer func(conn redis.Conn) {
+		_ = conn.Close()
+	}(conn)
+	return redis.Float64(conn.Do("ZINCRBY", key, increment, member))
+}
+
+// ZScore returns the score of member, ok is false when it is not in the set.
+func (r *Redis) ZScore(key, member string) (score float64, ok bool, err error) {
+	conn := r.client.Get()
+	defer func(conn redis.Conn) {
+		_ = conn.Close()
+	}(conn)
+	score, err = redis.Float64(conn.Do("ZSCORE", key, member))
+	if err == redis.ErrNil {
+		return 0, false, nil
+	}
+	return score, err == nil, err
+}
+
+// ZScores returns the scores of members in one round trip, members that are
+// not in the set are left out.
+func (r *Redis) ZScores(key string, members []string) (map[string]float64, error) {
+	out := map[string]float64{}
+	if len(members) == 0 {
+		return out, nil
+	}
+	conn := r.client.Get()
+	defer func(conn redis.Conn) {
+		_ = conn.Close()
+	}(conn)
+
+	for _, m := range members {
+		if err := conn.Send("ZSCORE", key, m); err != nil {
+			return nil, err
+		}
+	}
+	if err := conn.Flush(); err != nil {
+		return nil, err
+	}
+	for _, m := range members {
+		score, err := redis.Float64(conn.Receive())
+		if err == redis.ErrNil {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		out[m] = score
+	}
+	return out, nil
+}
+
+// ZRevRank returns the 0 based rank of member ordered by descending score,
+// ok is false when it is not in the set.
+func (r *Redis) ZRevRank(key, member string) (rank int64, ok bool, err error) {
+	conn := r.client.Get()
+	defer func(conn redis.Conn) {
+		_ = conn.Close()
+	}(conn)
+	rank, err = redis.Int64(conn.Do("ZREVRANK", key, member))
+	if err == redis.ErrNil {
+		return 0, false, nil
+	}
+	return rank, err == nil, err
+}
+
+// ZRevRangeWithScores returns the members from rank start to stop, both
+// inclusive, ordered by descending score.
+func (r *Redis) ZRevRangeWithScores(key string, start, stop int64) ([]Member, error) {
+	conn := r.client.Get()
+	defer func(conn redis.Conn) {
+		_ = conn.Close()
+	}(conn)
+	values, err := redis.Values(conn.Do("ZREVRANGE", key, start, stop, "WITHSCORES"))
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]Member, 0, len(values)/2)
+	for i := 0; i+1 < len(values); i += 2 {
+		member, err := redis.String(values[i], nil)
+		if err != nil {
+			return nil, err
+		}
+		score, err := redis.Float64(values[i+1], nil)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, Member{Member: member, Score: score})
+	}
+	return out, nil
+}
+
+func (r *Redis) ZCard(key string) (int64, error) {
+	conn := r.client.Get()
+	defer func(conn redis.Conn) {
+		_ = conn.Close()
+	}(conn)
+	return redis.Int64(conn.Do("ZCARD", key))
+}
+
+func (r *Redis) ZRem(key string, members ...string) (int64, error) {
+	conn := r.client.Get()
+	defer func(conn redis.Conn) {
+		_ = conn.Close()
+	}(conn)
+	args := redis.Args{}.Add(key).AddFlat(members)
+	return redis.Int64(conn.Do("ZREM", args...))
+}
+
+// ZIncrByMany adds each increment to its key in one transaction and sets the
+// keys to expire after their ttl in seconds, a ttl of 0 keeps the key.
+func (r *Redis) ZIncrByMany(member string, increment float64, ttls map[string]int) error {
+	conn := r.client.Get()
+	defer func(conn redis.Conn) {
+		_ = conn.Close()
+	}(conn)
+
+	if err := conn.Send("MULTI"); err != nil {
+		return err
+	}
+	for key, ttl := range ttls {
+		if err := conn.Send("ZINCRBY", key, increment, member); err != nil {
+			return err
+		}
+		if ttl > 0 {
+			if err := conn.Send("EXPIRE", key, ttl); err != nil {
+				return err
+			}
+		}
+	}
+	_, err := conn.Do("EXEC")
+	return err
+}
+
+// ZReplace atomically replaces key with members, used to rebuild a sorted
+// set from another source.
+func (r *Redis) ZReplace(key string, members []Member, ttl int) error {
+	conn := r.client.Get()
+	defer func(conn redis.Conn) {
+		_ = conn.Close()
+	}(conn)
+
+	if err := conn.Send("MULTI"); err != nil {
+		return err
+	}
+	if err := conn.Send("DEL", key); err != nil {
+		return err
+	}
+	if len(members) > 0 {
+		args := redis.Args{}.Add(key)
+		for _, m := range members {
+			args = args.Add(m.Score, m.Member)
+		}
+		if err := conn.Send("ZADD", args...); err != nil {
+			return err
+		}
+		if ttl > 0 {
+			if err := conn.Send("EXPIRE", key, ttl); err != nil {
+				return err
+			}
+		}
+	}
+	_, err := conn.Do("EXEC")
+	return err
+}
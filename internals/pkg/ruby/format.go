@@ -0,0 +1,56 @@
+package ruby
+
+// Format selects how marked up text is returned by the API.
+type Format string
+
+const (
+	// FormatMarkup returns the text as stored, e.g. 漢字[かんじ].
+	FormatMarkup Format = "markup"
+	// FormatHTML renders readings as <ruby> elements.
+	FormatHTML Format = "html"
+	// FormatSegments returns the plain text and a segment array.
+	FormatSegments Format = "segments"
+	// FormatStrip removes every reading.
+	FormatStrip Format = "strip"
+)
+
+func (f Format) IsValid() bool {
+	switch f {
+	case FormatMarkup, FormatHTML, FormatSegments, FormatStrip:
+		return true
+	}
+	return false
+}
+
+// Rendered is the result of Render. Segments is only set for FormatSegments.
+type Rendered struct {
+	Text     string
+	Segments []Segment
+}
+
+// Render parses s and renders it with f. Malformed markup, which can only
+// exist in rows saved before validation, is treated as plain text. hide may
+// be nil; otherwise readings it returns true for are dropped first.
+func Render(s string, f Format, hide func(Segment) bool) Rendered {
+	segs, err := Parse(s)
+	if err != nil {
+		segs = []Segment{{Text: s}}
+	}
+	if hide != nil {
+		segs = HideReadings(segs, hide)
+	}
+
+	switch f {
+	case FormatHTML:
+		return Rendered{Text: HTML(segs)}
+	case FormatSegments:
+		return Rendered{Text: Text(segs), Segments: segs}
+	case FormatStrip:
+		return Rendered{Text: Text(segs)}
+	default:
+		if hide == nil || err != nil {
+			return Rendered{Text: s}
+		}
+		return Rendered{Text: Markup(segs)}
+	}
+}
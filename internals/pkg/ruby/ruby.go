@@ -0,0 +1,271 @@
+// Package ruby parses the furigana markup used in question and answer text.
+//
+// A reading is written in square brackets right after the kanji it belongs
+// to, as in 漢字[かんじ]の読み方. The base is the run of kanji directly before
+// the bracket. When the base is not only kanji, its start is marked with a
+// vertical bar: |お茶[おちゃ]. A literal bracket, bar or backslash is escaped
+// with a backslash.
+package ruby
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"strings"
+
+	"wakuwaku_nihongo/internals/pkg/kana"
+)
+
+const (
+	openReading  = '['
+	closeReading = ']'
+	baseMarker   = '|'
+	wideMarker   = '｜'
+	escape       = '\\'
+)
+
+var (
+	ErrUnclosedReading = errors.New("reading is not closed")
+	ErrUnopenedReading = errors.New("closing bracket without reading")
+	ErrMissingBase     = errors.New("reading has no base text")
+	ErrEmptyReading    = errors.New("reading is empty")
+	ErrInvalidReading  = errors.New("reading must only contain kana")
+	ErrDanglingMarker  = errors.New("base marker is not followed by a reading")
+)
+
+// Segment is a piece of text with an optional reading.
+type Segment struct {
+	Text    string `json:"text"`
+	Reading string `json:"reading,omitempty"`
+}
+
+// SyntaxError reports the position, in runes, of the first invalid markup.
+type SyntaxError struct {
+	Pos int
+	Err error
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("invalid ruby markup at position %d: %s", e.Pos, e.Err)
+}
+
+func (e *SyntaxError) Unwrap() error {
+	return e.Err
+}
+
+// Parse splits s into segments. Adjacent text without a reading is merged into
+// a single segment.
+func Parse(s string) ([]Segment, error) {
+	rs := []rune(s)
+	out := []Segment{}
+
+	var text []rune
+	marker := -1 // index in text where an explicit base starts
+	markerPos := 0
+
+	flush := func(upto int) {
+		if upto > 0 {
+			out = appendText(out, string(text[:upto]))
+		}
+		text = text[upto:]
+	}
+
+	for i := 0; i < len(rs); i++ {
+		r := rs[i]
+		switch {
+		case r == escape && i+1 < len(rs) && isSpecial(rs[i+1]):
+			i++
+			text = append(text, rs[i])
+
+		case r == baseMarker || r == wideMarker:
+			if marker >= 0 {
+				return nil, &SyntaxError{Pos: markerPos, Err: ErrDanglingMarker}
+			}
+			marker = len(text)
+			markerPos = i
+
+		case r == openReading:
+			end := indexClose(rs, i+1)
+			if end < 0 {
+				return nil, &SyntaxError{Pos: i, Err: ErrUnclosedReading}
+			}
+			reading := strings.TrimSpace(string(rs[i+1 : end]))
+			if reading == "" {
+				return nil, &SyntaxError{Pos: i, Err: ErrEmptyReading}
+			}
+			if !kana.IsKana(reading) {
+				return nil, &SyntaxError{Pos: i, Err: ErrInvalidReading}
+			}
+
+			start := marker
+			if start < 0 {
+				start = len(text)
+				for start > 0 && kana.IsKanjiRune(text[start-1]) {
+					start--
+				}
+			}
+			if start == len(text) {
+				return nil, &SyntaxError{Pos: i, Err: ErrMissingBase}
+			}
+
+			flush(start)
+			out = append(out, Segment{Text: string(text), Reading: reading})
+			text = text[:0]
+			marker = -1
+			i = end
+
+		case r == closeReading:
+			return nil, &SyntaxError{Pos: i, Err: ErrUnopenedReading}
+
+		default:
+			text = append(text, r)
+		}
+	}
+	if marker >= 0 {
+		return nil, &SyntaxError{Pos: markerPos, Err: ErrDanglingMarker}
+	}
+	flush(len(text))
+	return out, nil
+}
+
+// Validate reports whether s is well formed markup.
+func Validate(s string) error {
+	_, err := Parse(s)
+	return err
+}
+
+// IsValid is Validate for use as a predicate.
+func IsValid(s string) bool {
+	return Validate(s) == nil
+}
+
+// Strip returns s without readings. Malformed markup is returned unchanged.
+func Strip(s string) string {
+	segs, err := Parse(s)
+	if err != nil {
+		return s
+	}
+	return Text(segs)
+}
+
+// Text joins the segments without their readings.
+func Text(segs []Segment) string {
+	var b strings.Builder
+	for _, seg := range segs {
+		b.WriteString(seg.Text)
+	}
+	return b.String()
+}
+
+// Reading joins the segments using the reading in place of the base text,
+// so 漢字[かんじ]の becomes かんじの.
+func Reading(segs []Segment) string {
+	var b strings.Builder
+	for _, seg := range segs {
+		if seg.Reading != "" {
+			b.WriteString(seg.Reading)
+			continue
+		}
+		b.WriteString(seg.Text)
+	}
+	return b.String()
+}
+
+// HTML renders the segments with <ruby> elements. All text is escaped.
+func HTML(segs []Segment) string {
+	var b strings.Builder
+	for _, seg := range segs {
+		if seg.Reading == "" {
+			b.WriteString(html.EscapeString(seg.Text))
+			continue
+		}
+		b.WriteString("<ruby>")
+		b.WriteString(html.EscapeString(seg.Text))
+		b.WriteString("<rp>(</rp><rt>")
+		b.WriteString(html.EscapeString(seg.Reading))
+		b.WriteString("</rt><rp>)</rp></ruby>")
+	}
+	return b.String()
+}
+
+// Markup writes the segments back in markup syntax, escaping literal
+// brackets, bars and backslashes.
+func Markup(segs []Segment) string {
+	var b strings.Builder
+	for i, seg := range segs {
+		text := escapeText(seg.Text)
+		if seg.Reading == "" {
+			b.WriteString(text)
+			continue
+		}
+		if !isAllKanji(seg.Text) || (i > 0 && segs[i-1].Reading == "" && endsWithKanji(segs[i-1].Text)) {
+			b.WriteRune(baseMarker)
+		}
+		b.WriteString(text)
+		b.WriteRune(openReading)
+		b.WriteString(seg.Reading)
+		b.WriteRune(closeReading)
+	}
+	return b.String()
+}
+
+// HideReadings drops the readings of the segments for which hide returns
+// true.
+func HideReadings(segs []Segment, hide func(Segment) bool) []Segment {
+	out := []Segment{}
+	for _, seg := range segs {
+		if seg.Reading != "" && hide(seg) {
+			seg.Reading = ""
+		}
+		if seg.Reading == "" {
+			out = appendText(out, seg.Text)
+			continue
+		}
+		out = append(out, seg)
+	}
+	return out
+}
+
+func appendText(segs []Segment, text string) []Segment {
+	if n := len(segs); n > 0 && segs[n-1].Reading == "" {
+		segs[n-1].Text += text
+		return segs
+	}
+	return append(segs, Segment{Text: text})
+}
+
+func indexClose(rs []rune, from int) int {
+	for i := from; i < len(rs); i++ {
+		switch rs[i] {
+		case closeReading:
+			return i
+		case openReading:
+			return -1
+		}
+	}
+	return -1
+}
+
+func isSpecial(r rune) bool {
+	return r == openReading || r == closeReading || r == baseMarker || r == wideMarker || r == escape
+}
+
+func escapeText(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if isSpecial(r) {
+			b.WriteRune(escape)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func isAllKanji(s string) bool {
+	return s != "" && strings.IndexFunc(s, func(r rune) bool { return !kana.IsKanjiRune(r) }) < 0
+}
+
+func endsWithKanji(s string) bool {
+	rs := []rune(s)
+	return len(rs) > 0 && kana.IsKanjiRune(rs[len(rs)-1])
+}
@@ -0,0 +1,128 @@
+package tests
+
+import (
+	"testing"
+	"wakuwaku_nihongo/internals/pkg/ruby"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []ruby.Segment
+	}{
+		{"", []ruby.Segment{}},
+		{"ひらがな", []ruby.Segment{{Text: "ひらがな"}}},
+		{"漢字[かんじ]", []ruby.Segment{{Text: "漢字", Reading: "かんじ"}}},
+		{"漢字[かんじ]の読[よ]み方[かた]", []ruby.Segment{
+			{Text: "漢字", Reading: "かんじ"},
+			{Text: "の"},
+			{Text: "読", Reading: "よ"},
+			{Text: "み"},
+			{Text: "方", Reading: "かた"},
+		}},
+		{"今日は日曜日[にちようび]です", []ruby.Segment{
+			{Text: "今日は"},
+			{Text: "日曜日", Reading: "にちようび"},
+			{Text: "です"},
+		}},
+		{"|お茶[おちゃ]を飲む", []ruby.Segment{{Text: "お茶", Reading: "おちゃ"}, {Text: "を飲む"}}},
+		{"東京｜大学[だいがく]", []ruby.Segment{{Text: "東京"}, {Text: "大学", Reading: "だいがく"}}},
+		{"人々[ひとびと]", []ruby.Segment{{Text: "人々", Reading: "ひとびと"}}},
+		{"珈琲[コーヒー]", []ruby.Segment{{Text: "珈琲", Reading: "コーヒー"}}},
+		{`\[A\] or \|B\|`, []ruby.Segment{{Text: "[A] or |B|"}}},
+		{`a\b`, []ruby.Segment{{Text: `a\b`}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ruby.Parse(tt.in)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		in   string
+		want error
+	}{
+		{"漢字[かんじ", ruby.ErrUnclosedReading},
+		{"漢字[か[ん]じ]", ruby.ErrUnclosedReading},
+		{"漢字]", ruby.ErrUnopenedReading},
+		{"かんじ[かんじ]", ruby.ErrMissingBase},
+		{"[かんじ]", ruby.ErrMissingBase},
+		{"漢字[]", ruby.ErrEmptyReading},
+		{"漢字[kanji]", ruby.ErrInvalidReading},
+		{"|漢字", ruby.ErrDanglingMarker},
+		{"|漢|字[かんじ]", ruby.ErrDanglingMarker},
+		{"|[かんじ]", ruby.ErrMissingBase},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			err := ruby.Validate(tt.in)
+			assert.ErrorIs(t, err, tt.want)
+			assert.False(t, ruby.IsValid(tt.in))
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	in := "漢字[かんじ]の<読>[よ]"
+	segs, err := ruby.Parse("漢字[かんじ]の")
+	assert.NoError(t, err)
+
+	assert.Equal(t, "漢字の", ruby.Strip("漢字[かんじ]の"))
+	assert.Equal(t, "漢字[かんじ", ruby.Strip("漢字[かんじ"))
+	assert.Equal(t, "<ruby>漢字<rp>(</rp><rt>かんじ</rt><rp>)</rp></ruby>の", ruby.HTML(segs))
+
+	assert.Equal(t, in, ruby.Render(in, ruby.FormatMarkup, nil).Text)
+	assert.Equal(t, "漢字[かんじ]の&lt;読&gt;[よ]", ruby.Render(in, ruby.FormatHTML, nil).Text)
+	assert.Equal(t, "漢字[かんじ]の<読>[よ]", ruby.Render(in, ruby.FormatStrip, nil).Text)
+
+	rendered := ruby.Render("漢字[かんじ]の", ruby.FormatSegments, nil)
+	assert.Equal(t, "漢字の", rendered.Text)
+	assert.Equal(t, segs, rendered.Segments)
+}
+
+func TestHideReadings(t *testing.T) {
+	in := "漢字[かんじ]の読[よ]み方[かた]"
+	hide := func(seg ruby.Segment) bool { return seg.Reading == "かんじ" }
+
+	assert.Equal(t, "漢字の読[よ]み方[かた]", ruby.Render(in, ruby.FormatMarkup, hide).Text)
+	assert.Equal(t, "漢字の読み方", ruby.Render(in, ruby.FormatStrip, hide).Text)
+	assert.Equal(t, []ruby.Segment{
+		{Text: "漢字の"},
+		{Text: "読", Reading: "よ"},
+		{Text: "み"},
+		{Text: "方", Reading: "かた"},
+	}, ruby.Render(in, ruby.FormatSegments, hide).Segments)
+}
+
+func TestMarkupRoundTrip(t *testing.T) {
+	inputs := []string{
+		"漢字[かんじ]の読[よ]み方[かた]",
+		"|お茶[おちゃ]を飲む",
+		"東京|大学[だいがく]",
+		`\[A\] \\ \|`,
+	}
+
+	for _, in := range inputs {
+		t.Run(in, func(t *testing.T) {
+			segs, err := ruby.Parse(in)
+			assert.NoError(t, err)
+			again, err := ruby.Parse(ruby.Markup(segs))
+			assert.NoError(t, err)
+			assert.Equal(t, segs, again)
+		})
+	}
+}
+
+func TestReading(t *testing.T) {
+	segs, err := ruby.Parse("漢字[かんじ]の読[よ]み方[かた]")
+	assert.NoError(t, err)
+	assert.Equal(t, "かんじのよみかた", ruby.Reading(segs))
+}
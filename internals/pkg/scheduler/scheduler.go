@@ -0,0 +1,86 @@
+// Package scheduler runs background jobs at a fixed interval inside the API
+// process.
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Job does one run of a scheduled task, it should stop early when ctx is
+// done.
+type Job func(ctx context.Context) error
+
+type entry struct {
+	name     string
+	interval time.Duration
+	job      Job
+}
+
+type Scheduler struct {
+	entries []entry
+	wg      sync.WaitGroup
+}
+
+func New() *Scheduler {
+	return &Scheduler{}
+}
+
+// Every schedules job to run on every multiple of interval of the wall
+// clock, so a 15 minute job runs at :00, :15, :30 and :45. A run that is
+// still busy delays the next one instead of overlapping it.
+func (s *Scheduler) Every(interval time.Duration, name string, job Job) {
+	s.entries = append(s.entries, entry{name: name, interval: interval, job: job})
+}
+
+// Start runs every job in its own goroutine until ctx is done.
+func (s *Scheduler) Start(ctx context.Context) {
+	for _, e := range s.entries {
+		s.wg.Add(1)
+		go func(e entry) {
+			defer s.wg.Done()
+			s.loop(ctx, e)
+		}(e)
+	}
+}
+
+// Wait blocks until every job has stopped after ctx is done.
+func (s *Scheduler) Wait() {
+	s.wg.Wait()
+}
+
+func (s *Scheduler) loop(ctx context.Context, e entry) {
+	for {
+		now := time.Now()
+		next := now.Truncate(e.interval).Add(e.interval)
+		timer := time.NewTimer(next.Sub(now))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		Run(ctx, e.name, e.job)
+	}
+}
+
+// Run runs job once, logging its duration and error and recovering from a
+// panic so a broken job cannot take the API down.
+func Run(ctx context.Context, name string, job Job) (err error) {
+	start := time.Now()
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+		if err != nil {
+			log.Error().Err(err).Str("job", name).Dur("duration", time.Since(start)).Msg("scheduled job failed")
+			return
+		}
+		log.Info().Str("job", name).Dur("duration", time.Since(start)).Msg("scheduled job finished")
+	}()
+	return job(ctx)
+}
@@ -0,0 +1,46 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+	"wakuwaku_nihongo/internals/pkg/scheduler"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	ctx := context.Background()
+	assert.NoError(t, scheduler.Run(ctx, "ok", func(ctx context.Context) error { return nil }))
+
+	failure := errors.New("boom")
+	assert.ErrorIs(t, scheduler.Run(ctx, "fail", func(ctx context.Context) error { return failure }), failure)
+
+	err := scheduler.Run(ctx, "panic", func(ctx context.Context) error { panic("broken") })
+	assert.EqualError(t, err, "panic: broken")
+}
+
+func TestEvery(t *testing.T) {
+	var runs atomic.Int32
+	ctx, cancel := context.WithCancel(context.Background())
+
+	s := scheduler.New()
+	s.Every(10*time.Millisecond, "count", func(ctx context.Context) error {
+		runs.Add(1)
+		return nil
+	})
+	s.Every(10*time.Millisecond, "panic", func(ctx context.Context) error {
+		panic("keeps the other jobs running")
+	})
+	s.Start(ctx)
+
+	assert.Eventually(t, func() bool { return runs.Load() >= 3 }, time.Second, 5*time.Millisecond)
+	cancel()
+	s.Wait()
+
+	stopped := runs.Load()
+	time.Sleep(30 * time.Millisecond)
+	assert.Equal(t, stopped, runs.Load(), "no runs after the context is done")
+}
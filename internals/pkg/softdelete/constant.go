@@ -0,0 +1,21 @@
+package softdelete
+
+// Content tables carry DELETED_AT_COLUMN and DELETED_BY_COLUMN. Tables whose
+// rows are never deleted through the app go without them:
+//   - state derived from other rows or kept once per customer and updated in
+//     place: customer_preferences, learner_stats, customer_streaks,
+//     customer_badges, customer_kanji, question_calibrations,
+//     question_search_documents and leaderboard_snapshots
+//   - append only history: xp_events, question_revisions, quiz_reviews,
+//     question_reports and notifications, which are resolved and read rather
+//     than deleted
+//   - rows owned by a soft deleted parent and replaced with it:
+//     dictionary_tags, dictionary_kanji, dictionary_readings,
+//     dictionary_reading_restrictions, dictionary_senses, dictionary_glosses,
+//     grammar_examples, question_grammar_points and media_attachments
+const (
+	DELETED_AT_COLUMN = "deleted_at"
+	DELETED_BY_COLUMN = "deleted_by"
+
+	deletedByKey = "softdelete:deleted_by"
+)
@@ -0,0 +1,204 @@
+// Package softdelete deletes rows by setting their deleted_at, in unix
+// milliseconds, and deleted_by instead of removing them. Queries, updates
+// and deletes made through gorm on a model with a DeletedAt field leave the
+// deleted rows out unless they are Unscoped.
+package softdelete
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+// DeletedAt is when a row was deleted in unix milliseconds, it is null
+// while the row is not deleted.
+type DeletedAt sql.NullInt64
+
+// At returns the DeletedAt of a row deleted at the unix milliseconds at.
+func At(at int64) DeletedAt {
+	return DeletedAt{Int64: at, Valid: true}
+}
+
+// Ptr returns when the row was deleted, nil when it is not.
+func (n DeletedAt) Ptr() *int64 {
+	if !n.Valid {
+		return nil
+	}
+	at := n.Int64
+	return &at
+}
+
+// Scan implements the Scanner interface.
+func (n *DeletedAt) Scan(value any) error {
+	return (*sql.NullInt64)(n).Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (n DeletedAt) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Int64, nil
+}
+
+func (n DeletedAt) MarshalJSON() ([]byte, error) {
+	return json.Marshal(n.Ptr())
+}
+
+func (n *DeletedAt) UnmarshalJSON(b []byte) error {
+	var at *int64
+	if err := json.Unmarshal(b, &at); err != nil {
+		return err
+	}
+	*n = DeletedAt{}
+	if at != nil {
+		*n = At(*at)
+	}
+	return nil
+}
+
+// By records who deletes the rows deleted with the returned db in their
+// deleted_by.
+func By(db *gorm.DB, deletedBy string) *gorm.DB {
+	return db.Set(deletedByKey, deletedBy)
+}
+
+// Restore undeletes the deleted rows db selects, db must have a model.
+func Restore(db *gorm.DB) *gorm.DB {
+	return db.Unscoped().
+		Where(clause.Expr{SQL: "? IS NOT NULL", Vars: []any{clause.Column{Table: clause.CurrentTable, Name: DELETED_AT_COLUMN}}}).
+		Updates(map[string]any{
+			DELETED_AT_COLUMN: nil,
+			DELETED_BY_COLUMN: nil,
+		})
+}
+
+func (DeletedAt) QueryClauses(f *schema.Field) []clause.Interface {
+	return []clause.Interface{queryClause{field: f}}
+}
+
+func (DeletedAt) UpdateClauses(f *schema.Field) []clause.Interface {
+	return []clause.Interface{updateClause{field: f}}
+}
+
+func (DeletedAt) DeleteClauses(f *schema.Field) []clause.Interface {
+	return []clause.Interface{deleteClause{field: f}}
+}
+
+// queryClause leaves the deleted rows out of a statement.
+type queryClause struct {
+	field *schema.Field
+}
+
+func (queryClause) Name() string {
+	return ""
+}
+
+func (queryClause) Build(clause.Builder) {
+}
+
+func (queryClause) MergeClause(*clause.Clause) {
+}
+
+func (c queryClause) ModifyStatement(stmt *gorm.Statement) {
+	if _, ok := stmt.Clauses["soft_delete_enabled"]; ok || stmt.Statement.Unscoped {
+		return
+	}
+
+	// a single OR condition would otherwise be OR-ed with deleted_at
+	if where, ok := stmt.Clauses["WHERE"]; ok {
+		if exprs, ok := where.Expression.(clause.Where); ok && len(exprs.Exprs) >= 1 {
+			for _, expr := range exprs.Exprs {
+				if or, ok := expr.(clause.OrConditions); ok && len(or.Exprs) == 1 {
+					exprs.Exprs = []clause.Expression{clause.And(exprs.Exprs...)}
+					where.Expression = exprs
+					stmt.Clauses["WHERE"] = where
+					break
+				}
+			}
+		}
+	}
+
+	stmt.AddClause(clause.Where{Exprs: []clause.Expression{
+		clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: c.field.DBName}, Value: nil},
+	}})
+	stmt.Clauses["soft_delete_enabled"] = clause.Clause{}
+}
+
+// updateClause keeps updates off the deleted rows.
+type updateClause struct {
+	field *schema.Field
+}
+
+func (updateClause) Name() string {
+	return ""
+}
+
+func (updateClause) Build(clause.Builder) {
+}
+
+func (updateClause) MergeClause(*clause.Clause) {
+}
+
+func (c updateClause) ModifyStatement(stmt *gorm.Statement) {
+	if stmt.SQL.Len() == 0 && !stmt.Statement.Unscoped {
+		queryClause(c).ModifyStatement(stmt)
+	}
+}
+
+// deleteClause turns a delete into an update of deleted_at and, when the
+// statement was made By someone, deleted_by.
+type deleteClause struct {
+	field *schema.Field
+}
+
+func (deleteClause) Name() string {
+	return ""
+}
+
+func (deleteClause) Build(clause.Builder) {
+}
+
+func (deleteClause) MergeClause(*clause.Clause) {
+}
+
+func (c deleteClause) ModifyStatement(stmt *gorm.Statement) {
+	if stmt.SQL.Len() > 0 || stmt.Statement.Unscoped {
+		return
+	}
+
+	at := stmt.DB.NowFunc().UnixMilli()
+	set := clause.Set{{Column: clause.Column{Name: c.field.DBName}, Value: at}}
+	stmt.SetColumn(c.field.DBName, at, true)
+	if by, ok := stmt.Get(deletedByKey); ok && stmt.Schema != nil {
+		if f := stmt.Schema.LookUpField(DELETED_BY_COLUMN); f != nil {
+			set = append(set, clause.Assignment{Column: clause.Column{Name: f.DBName}, Value: by})
+		}
+	}
+	stmt.AddClause(set)
+
+	if stmt.Schema != nil {
+		_, values := schema.GetIdentityFieldValuesMap(stmt.Context, stmt.ReflectValue, stmt.Schema.PrimaryFields)
+		column, ids := schema.ToQueryValues(stmt.Table, stmt.Schema.PrimaryFieldDBNames, values)
+		if len(ids) > 0 {
+			stmt.AddClause(clause.Where{Exprs: []clause.Expression{clause.IN{Column: column, Values: ids}}})
+		}
+
+		if stmt.ReflectValue.CanAddr() && stmt.Dest != stmt.Model && stmt.Model != nil {
+			_, values = schema.GetIdentityFieldValuesMap(stmt.Context, reflect.ValueOf(stmt.Model), stmt.Schema.PrimaryFields)
+			column, ids = schema.ToQueryValues(stmt.Table, stmt.Schema.PrimaryFieldDBNames, values)
+			if len(ids) > 0 {
+				stmt.AddClause(clause.Where{Exprs: []clause.Expression{clause.IN{Column: column, Values: ids}}})
+			}
+		}
+	}
+
+	queryClause(c).ModifyStatement(stmt)
+	stmt.AddClauseIfNotExists(clause.Update{})
+	stmt.Build(stmt.DB.Callback().Update().Clauses...)
+}
@@ -0,0 +1,82 @@
+package tests
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"wakuwaku_nihongo/internals/pkg/softdelete"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type item struct {
+	ItemID    string               `gorm:"column:item_id;primaryKey"`
+	Name      string               `gorm:"column:name"`
+	DeletedAt softdelete.DeletedAt `gorm:"column:deleted_at"`
+	DeletedBy *string              `gorm:"column:deleted_by"`
+}
+
+func dryRun(t *testing.T) *gorm.DB {
+	now := time.UnixMilli(1000)
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{
+		DryRun:                 true,
+		SkipDefaultTransaction: true,
+		DisableAutomaticPing:   true,
+		NowFunc:                func() time.Time { return now },
+	})
+	assert.NoError(t, err)
+	return db
+}
+
+func TestQueryLeavesDeletedOut(t *testing.T) {
+	db := dryRun(t)
+
+	stmt := db.Where("name = ?", "a").Find(&[]*item{}).Statement
+	assert.Equal(t, `SELECT * FROM "items" WHERE name = $1 AND "items"."deleted_at" IS NULL`, stmt.SQL.String())
+
+	stmt = db.Table("items i").Where("i.name = ?", "a").Find(&[]*item{}).Statement
+	assert.Equal(t, `SELECT * FROM items i WHERE i.name = $1 AND "i"."deleted_at" IS NULL`, stmt.SQL.String())
+
+	stmt = db.Unscoped().Where("name = ?", "a").Find(&[]*item{}).Statement
+	assert.Equal(t, `SELECT * FROM "items" WHERE name = $1`, stmt.SQL.String())
+}
+
+func TestDeleteRecordsDeletedBy(t *testing.T) {
+	db := dryRun(t)
+
+	stmt := softdelete.By(db, "editor").Where("item_id = ?", "x").Delete(&item{}).Statement
+	assert.Equal(t, `UPDATE "items" SET "deleted_at"=$1,"deleted_by"=$2 WHERE item_id = $3 AND "items"."deleted_at" IS NULL`, stmt.SQL.String())
+	assert.Equal(t, []any{int64(1000), "editor", "x"}, stmt.Vars)
+
+	stmt = db.Where("item_id = ?", "x").Delete(&item{}).Statement
+	assert.Equal(t, `UPDATE "items" SET "deleted_at"=$1 WHERE item_id = $2 AND "items"."deleted_at" IS NULL`, stmt.SQL.String())
+}
+
+func TestUpdateAndRestore(t *testing.T) {
+	db := dryRun(t)
+
+	stmt := db.Model(&item{}).Where("item_id = ?", "x").Update("name", "b").Statement
+	assert.Equal(t, `UPDATE "items" SET "name"=$1 WHERE item_id = $2 AND "items"."deleted_at" IS NULL`, stmt.SQL.String())
+
+	stmt = softdelete.Restore(db.Model(&item{}).Where("item_id = ?", "x")).Statement
+	assert.Equal(t, `UPDATE "items" SET "deleted_at"=$1,"deleted_by"=$2 WHERE item_id = $3 AND "items"."deleted_at" IS NOT NULL`, stmt.SQL.String())
+}
+
+func TestDeletedAtJSON(t *testing.T) {
+	b, err := json.Marshal(softdelete.At(1000))
+	assert.NoError(t, err)
+	assert.Equal(t, "1000", string(b))
+
+	b, err = json.Marshal(softdelete.DeletedAt{})
+	assert.NoError(t, err)
+	assert.Equal(t, "null", string(b))
+
+	var at softdelete.DeletedAt
+	assert.NoError(t, json.Unmarshal([]byte("2000"), &at))
+	assert.Equal(t, int64(2000), *at.Ptr())
+	assert.NoError(t, json.Unmarshal([]byte("null"), &at))
+	assert.Nil(t, at.Ptr())
+}
@@ -0,0 +1,17 @@
+// Package sqlutil holds helpers to build SQL conditions.
+package sqlutil
+
+import "strings"
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// EscapeLike escapes the LIKE wildcards in s so they match themselves,
+// with the default backslash escape character.
+func EscapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
+
+// Contains returns the LIKE pattern matching values that contain s.
+func Contains(s string) string {
+	return "%" + EscapeLike(s) + "%"
+}
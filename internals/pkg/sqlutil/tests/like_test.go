@@ -0,0 +1,21 @@
+package tests
+
+import (
+	"testing"
+
+	"wakuwaku_nihongo/internals/pkg/sqlutil"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEscapeLike(t *testing.T) {
+	assert.Equal(t, "plain", sqlutil.EscapeLike("plain"))
+	assert.Equal(t, `100\%`, sqlutil.EscapeLike("100%"))
+	assert.Equal(t, `a\_b`, sqlutil.EscapeLike("a_b"))
+	assert.Equal(t, `c:\\dir`, sqlutil.EscapeLike(`c:\dir`))
+}
+
+func TestContains(t *testing.T) {
+	assert.Equal(t, `%\%off%`, sqlutil.Contains("%off"))
+	assert.Equal(t, "%%", sqlutil.Contains(""))
+}
@@ -0,0 +1,108 @@
+// Package srs schedules flashcard reviews with the SM-2 algorithm, answered
+// with the four ratings Anki uses.
+package srs
+
+import (
+	"math"
+	"time"
+)
+
+type Rating int
+
+const (
+	AGAIN Rating = iota + 1
+	HARD
+	GOOD
+	EASY
+)
+
+const (
+	DEFAULT_EASE = 2.5
+	MIN_EASE     = 1.3
+
+	// GRADUATING_INTERVAL and EASY_INTERVAL are the days before the second
+	// review of a new card answered good or easy.
+	GRADUATING_INTERVAL = 1
+	EASY_INTERVAL       = 4
+	// SECOND_INTERVAL is the days before the third review answered good.
+	SECOND_INTERVAL = 6
+
+	HARD_FACTOR = 1.2
+	EASY_BONUS  = 1.3
+
+	// RELEARN_DELAY is when a card answered again is shown again.
+	RELEARN_DELAY = 10 * time.Minute
+)
+
+// State is what the schedule of a card depends on. Interval is in days, 0
+// while the card is new or being relearnt.
+type State struct {
+	Interval    int
+	Ease        float64
+	Repetitions int
+	Lapses      int
+}
+
+// New returns the state of a card never reviewed.
+func New() State {
+	return State{Ease: DEFAULT_EASE}
+}
+
+// Next returns the state after a review rated r.
+func Next(s State, r Rating) State {
+	if s.Ease < MIN_EASE {
+		s.Ease = DEFAULT_EASE
+	}
+
+	switch r {
+	case AGAIN:
+		if s.Repetitions > 0 {
+			s.Lapses++
+		}
+		s.Repetitions = 0
+		s.Interval = 0
+		s.Ease -= 0.2
+	case HARD:
+		s.Interval = max(s.Interval+1, round(float64(s.Interval)*HARD_FACTOR), GRADUATING_INTERVAL)
+		s.Repetitions++
+		s.Ease -= 0.15
+	case GOOD:
+		switch s.Repetitions {
+		case 0:
+			s.Interval = GRADUATING_INTERVAL
+		case 1:
+			s.Interval = max(s.Interval+1, SECOND_INTERVAL)
+		default:
+			s.Interval = max(s.Interval+1, round(float64(s.Interval)*s.Ease))
+		}
+		s.Repetitions++
+	case EASY:
+		if s.Repetitions == 0 {
+			s.Interval = EASY_INTERVAL
+		} else {
+			s.Interval = max(s.Interval+1, round(float64(max(s.Interval, 1))*s.Ease*EASY_BONUS))
+		}
+		s.Repetitions++
+		s.Ease += 0.15
+	}
+
+	s.Ease = math.Max(MIN_EASE, math.Round(s.Ease*100)/100)
+	return s
+}
+
+// Due returns when a card reviewed at is due again.
+func Due(at time.Time, s State) time.Time {
+	if s.Interval == 0 {
+		return at.Add(RELEARN_DELAY)
+	}
+	return at.AddDate(0, 0, s.Interval)
+}
+
+// Valid reports whether r is one of the four ratings.
+func (r Rating) Valid() bool {
+	return r >= AGAIN && r <= EASY
+}
+
+func round(f float64) int {
+	return int(math.Round(f))
+}
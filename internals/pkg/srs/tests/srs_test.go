@@ -0,0 +1,57 @@
+package tests
+
+import (
+	"testing"
+	"time"
+	"wakuwaku_nihongo/internals/pkg/srs"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNext(t *testing.T) {
+	s := srs.New()
+
+	s = srs.Next(s, srs.GOOD)
+	assert.Equal(t, srs.State{Interval: 1, Ease: 2.5, Repetitions: 1}, s)
+	s = srs.Next(s, srs.GOOD)
+	assert.Equal(t, 6, s.Interval)
+	s = srs.Next(s, srs.GOOD)
+	assert.Equal(t, 15, s.Interval)
+	s = srs.Next(s, srs.HARD)
+	assert.Equal(t, 18, s.Interval)
+	assert.Equal(t, 2.35, s.Ease)
+
+	s = srs.Next(s, srs.AGAIN)
+	assert.Equal(t, srs.State{Interval: 0, Ease: 2.15, Repetitions: 0, Lapses: 1}, s)
+	s = srs.Next(s, srs.AGAIN)
+	assert.Equal(t, 1, s.Lapses, "a card being relearnt does not lapse again")
+}
+
+func TestNextEasy(t *testing.T) {
+	s := srs.Next(srs.New(), srs.EASY)
+	assert.Equal(t, srs.State{Interval: 4, Ease: 2.65, Repetitions: 1}, s)
+	s = srs.Next(s, srs.EASY)
+	assert.Equal(t, 14, s.Interval)
+}
+
+func TestNextMinEase(t *testing.T) {
+	s := srs.New()
+	for i := 0; i < 10; i++ {
+		s = srs.Next(s, srs.AGAIN)
+	}
+	assert.Equal(t, srs.MIN_EASE, s.Ease)
+	assert.Equal(t, srs.DEFAULT_EASE, srs.Next(srs.State{}, srs.GOOD).Ease, "an unset ease starts at the default")
+}
+
+func TestDue(t *testing.T) {
+	at := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	assert.Equal(t, at.Add(10*time.Minute), srs.Due(at, srs.State{}))
+	assert.Equal(t, time.Date(2026, 3, 7, 9, 0, 0, 0, time.UTC), srs.Due(at, srs.State{Interval: 6}))
+}
+
+func TestRatingValid(t *testing.T) {
+	assert.True(t, srs.AGAIN.Valid())
+	assert.True(t, srs.EASY.Valid())
+	assert.False(t, srs.Rating(0).Valid())
+	assert.False(t, srs.Rating(5).Valid())
+}
@@ -0,0 +1,113 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+type local struct {
+	root string
+}
+
+// NewLocal stores objects as files below root, creating it when missing.
+func NewLocal(root string) (Storage, error) {
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, err
+	}
+	return &local{root: root}, nil
+}
+
+func (s *local) path(key string) (string, error) {
+	clean, err := CleanKey(key)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(s.root, filepath.FromSlash(clean)), nil
+}
+
+// Put writes to a temporary file first so a failed upload never leaves a
+// partial object behind.
+func (s *local) Put(ctx context.Context, key string, r io.Reader) (n int64, err error) {
+	name, err := s.path(key)
+	if err != nil {
+		return
+	}
+	dir := filepath.Dir(name)
+	if err = os.MkdirAll(dir, 0o755); err != nil {
+		return
+	}
+
+	tmp, err := os.CreateTemp(dir, ".upload-*")
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err != nil {
+			os.Remove(tmp.Name())
+		}
+	}()
+
+	n, err = io.Copy(tmp, r)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return
+	}
+	err = os.Rename(tmp.Name(), name)
+	return
+}
+
+func (s *local) Open(ctx context.Context, key string) (Object, error) {
+	name, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	if info.IsDir() {
+		f.Close()
+		return nil, ErrNotFound
+	}
+	return &localObject{File: f, info: info}, nil
+}
+
+func (s *local) Delete(ctx context.Context, key string) error {
+	name, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	err = os.Remove(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return ErrNotFound
+	}
+	return err
+}
+
+type localObject struct {
+	*os.File
+	info fs.FileInfo
+}
+
+func (o *localObject) Size() int64 {
+	return o.info.Size()
+}
+
+func (o *localObject) ModTime() time.Time {
+	return o.info.ModTime()
+}
@@ -0,0 +1,61 @@
+// Package storage keeps uploaded files behind the Storage interface so the
+// backend can be swapped through configuration. The local filesystem is the
+// default and currently only driver.
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"wakuwaku_nihongo/config"
+)
+
+const DRIVER_LOCAL = "local"
+
+var (
+	ErrNotFound   = errors.New("storage: object not found")
+	ErrInvalidKey = errors.New("storage: invalid key")
+)
+
+// Object is a stored file opened for reading. It is seekable so it can be
+// served with HTTP range requests.
+type Object interface {
+	io.ReadSeekCloser
+	Size() int64
+	ModTime() time.Time
+}
+
+type Storage interface {
+	// Put stores r under key, replacing an existing object, and returns the
+	// number of bytes written.
+	Put(ctx context.Context, key string, r io.Reader) (int64, error)
+	Open(ctx context.Context, key string) (Object, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// New returns the storage selected by the configuration.
+func New(cfg config.StorageConfig) (Storage, error) {
+	switch cfg.Driver {
+	case "", DRIVER_LOCAL:
+		return NewLocal(cfg.LocalPath)
+	}
+	return nil, fmt.Errorf("storage: unknown driver %q", cfg.Driver)
+}
+
+// CleanKey validates a slash separated key. Keys are relative, must not
+// escape the storage root and are returned in their cleaned form.
+func CleanKey(key string) (string, error) {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return "", ErrInvalidKey
+	}
+	clean := path.Clean(key)
+	if clean == "." || clean == ".." || strings.HasPrefix(clean, "../") {
+		return "", ErrInvalidKey
+	}
+	return clean, nil
+}
@@ -0,0 +1,86 @@
+package tests
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"wakuwaku_nihongo/internals/pkg/storage"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCleanKey(t *testing.T) {
+	tests := []struct {
+		key  string
+		want string
+		err  bool
+	}{
+		{"audio/a.mp3", "audio/a.mp3", false},
+		{"audio//b/../a.mp3", "audio/a.mp3", false},
+		{"", "", true},
+		{"/etc/passwd", "", true},
+		{"../secret", "", true},
+		{"audio/../../secret", "", true},
+		{"audio\\..\\secret", "", true},
+		{".", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			got, err := storage.CleanKey(tt.key)
+			if tt.err {
+				assert.ErrorIs(t, err, storage.ErrInvalidKey)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLocal(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	s, err := storage.NewLocal(root)
+	assert.NoError(t, err)
+
+	n, err := s.Put(ctx, "audio/a.mp3", strings.NewReader("0123456789"))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), n)
+	_, err = os.Stat(filepath.Join(root, "audio", "a.mp3"))
+	assert.NoError(t, err)
+
+	obj, err := s.Open(ctx, "audio/a.mp3")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), obj.Size())
+	_, err = obj.Seek(5, io.SeekStart)
+	assert.NoError(t, err)
+	rest, err := io.ReadAll(obj)
+	assert.NoError(t, err)
+	assert.Equal(t, "56789", string(rest))
+	assert.NoError(t, obj.Close())
+
+	_, err = s.Put(ctx, "audio/a.mp3", strings.NewReader("replaced"))
+	assert.NoError(t, err)
+	obj, err = s.Open(ctx, "audio/a.mp3")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(8), obj.Size())
+	obj.Close()
+
+	assert.NoError(t, s.Delete(ctx, "audio/a.mp3"))
+	_, err = s.Open(ctx, "audio/a.mp3")
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+	assert.ErrorIs(t, s.Delete(ctx, "audio/a.mp3"), storage.ErrNotFound)
+
+	_, err = s.Open(ctx, "audio")
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+	_, err = s.Put(ctx, "../escape.mp3", strings.NewReader("x"))
+	assert.ErrorIs(t, err, storage.ErrInvalidKey)
+
+	entries, err := os.ReadDir(filepath.Join(root, "audio"))
+	assert.NoError(t, err)
+	assert.Empty(t, entries, "no temporary files are left behind")
+}
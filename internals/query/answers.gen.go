@@ -31,7 +31,7 @@ func newAnswer(db *gorm.DB, opts ...gen.DOOption) answer {
 	_answer.AnswerID = field.NewString(tableName, "answer_id")
 	_answer.CreatedAt = field.NewInt64(tableName, "created_at")
 	_answer.ModifiedAt = field.NewInt64(tableName, "modified_at")
-	_answer.DeletedAt = field.NewInt64(tableName, "deleted_at")
+	_answer.DeletedAt = field.NewField(tableName, "deleted_at")
 	_answer.CreatedBy = field.NewString(tableName, "created_by")
 	_answer.ModifiedBy = field.NewString(tableName, "modified_by")
 	_answer.DeletedBy = field.NewString(tableName, "deleted_by")
@@ -74,7 +74,7 @@ type answer struct {
 	AnswerID   field.String
 	CreatedAt  field.Int64
 	ModifiedAt field.Int64
-	DeletedAt  field.Int64
+	DeletedAt  field.Field
 	CreatedBy  field.String
 	ModifiedBy field.String
 	DeletedBy  field.String
@@ -101,7 +101,7 @@ func (a *answer) updateTableName(table string) *answer {
 	a.AnswerID = field.NewString(table, "answer_id")
 	a.CreatedAt = field.NewInt64(table, "created_at")
 	a.ModifiedAt = field.NewInt64(table, "modified_at")
-	a.DeletedAt = field.NewInt64(table, "deleted_at")
+	a.DeletedAt = field.NewField(table, "deleted_at")
 	a.CreatedBy = field.NewString(table, "created_by")
 	a.ModifiedBy = field.NewString(table, "modified_by")
 	a.DeletedBy = field.NewString(table, "deleted_by")
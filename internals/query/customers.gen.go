@@ -31,7 +31,7 @@ func newCustomer(db *gorm.DB, opts ...gen.DOOption) customer {
 	_customer.CustomerID = field.NewString(tableName, "customer_id")
 	_customer.CreatedAt = field.NewInt64(tableName, "created_at")
 	_customer.ModifiedAt = field.NewInt64(tableName, "modified_at")
-	_customer.DeletedAt = field.NewInt64(tableName, "deleted_at")
+	_customer.DeletedAt = field.NewField(tableName, "deleted_at")
 	_customer.CreatedBy = field.NewString(tableName, "created_by")
 	_customer.ModifiedBy = field.NewString(tableName, "modified_by")
 	_customer.DeletedBy = field.NewString(tableName, "deleted_by")
@@ -39,6 +39,8 @@ func newCustomer(db *gorm.DB, opts ...gen.DOOption) customer {
 	_customer.Email = field.NewString(tableName, "email")
 	_customer.Password = field.NewString(tableName, "password")
 	_customer.IsActive = field.NewBool(tableName, "is_active")
+	_customer.Role = field.NewString(tableName, "role")
+	_customer.UserID = field.NewString(tableName, "user_id")
 
 	_customer.fillFieldMap()
 
@@ -52,7 +54,7 @@ type customer struct {
 	CustomerID field.String
 	CreatedAt  field.Int64
 	ModifiedAt field.Int64
-	DeletedAt  field.Int64
+	DeletedAt  field.Field
 	CreatedBy  field.String
 	ModifiedBy field.String
 	DeletedBy  field.String
@@ -60,6 +62,8 @@ type customer struct {
 	Email      field.String
 	Password   field.String
 	IsActive   field.Bool
+	Role       field.String
+	UserID     field.String
 
 	fieldMap map[string]field.Expr
 }
@@ -79,7 +83,7 @@ func (c *customer) updateTableName(table string) *customer {
 	c.CustomerID = field.NewString(table, "customer_id")
 	c.CreatedAt = field.NewInt64(table, "created_at")
 	c.ModifiedAt = field.NewInt64(table, "modified_at")
-	c.DeletedAt = field.NewInt64(table, "deleted_at")
+	c.DeletedAt = field.NewField(table, "deleted_at")
 	c.CreatedBy = field.NewString(table, "created_by")
 	c.ModifiedBy = field.NewString(table, "modified_by")
 	c.DeletedBy = field.NewString(table, "deleted_by")
@@ -87,6 +91,8 @@ func (c *customer) updateTableName(table string) *customer {
 	c.Email = field.NewString(table, "email")
 	c.Password = field.NewString(table, "password")
 	c.IsActive = field.NewBool(table, "is_active")
+	c.Role = field.NewString(table, "role")
+	c.UserID = field.NewString(table, "user_id")
 
 	c.fillFieldMap()
 
@@ -103,7 +109,7 @@ func (c *customer) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (c *customer) fillFieldMap() {
-	c.fieldMap = make(map[string]field.Expr, 11)
+	c.fieldMap = make(map[string]field.Expr, 13)
 	c.fieldMap["customer_id"] = c.CustomerID
 	c.fieldMap["created_at"] = c.CreatedAt
 	c.fieldMap["modified_at"] = c.ModifiedAt
@@ -115,6 +121,8 @@ func (c *customer) fillFieldMap() {
 	c.fieldMap["email"] = c.Email
 	c.fieldMap["password"] = c.Password
 	c.fieldMap["is_active"] = c.IsActive
+	c.fieldMap["role"] = c.Role
+	c.fieldMap["user_id"] = c.UserID
 }
 
 func (c customer) clone(db *gorm.DB) customer {
@@ -31,7 +31,7 @@ func newJlptBook(db *gorm.DB, opts ...gen.DOOption) jlptBook {
 	_jlptBook.JlptBookID = field.NewString(tableName, "jlpt_book_id")
 	_jlptBook.CreatedAt = field.NewInt64(tableName, "created_at")
 	_jlptBook.ModifiedAt = field.NewInt64(tableName, "modified_at")
-	_jlptBook.DeletedAt = field.NewInt64(tableName, "deleted_at")
+	_jlptBook.DeletedAt = field.NewField(tableName, "deleted_at")
 	_jlptBook.CreatedBy = field.NewString(tableName, "created_by")
 	_jlptBook.ModifiedBy = field.NewString(tableName, "modified_by")
 	_jlptBook.DeletedBy = field.NewString(tableName, "deleted_by")
@@ -54,7 +54,7 @@ type jlptBook struct {
 	JlptBookID field.String
 	CreatedAt  field.Int64
 	ModifiedAt field.Int64
-	DeletedAt  field.Int64
+	DeletedAt  field.Field
 	CreatedBy  field.String
 	ModifiedBy field.String
 	DeletedBy  field.String
@@ -83,7 +83,7 @@ func (j *jlptBook) updateTableName(table string) *jlptBook {
 	j.JlptBookID = field.NewString(table, "jlpt_book_id")
 	j.CreatedAt = field.NewInt64(table, "created_at")
 	j.ModifiedAt = field.NewInt64(table, "modified_at")
-	j.DeletedAt = field.NewInt64(table, "deleted_at")
+	j.DeletedAt = field.NewField(table, "deleted_at")
 	j.CreatedBy = field.NewString(table, "created_by")
 	j.ModifiedBy = field.NewString(table, "modified_by")
 	j.DeletedBy = field.NewString(table, "deleted_by")
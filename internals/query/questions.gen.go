@@ -31,13 +31,16 @@ func newQuestion(db *gorm.DB, opts ...gen.DOOption) question {
 	_question.QuestionID = field.NewString(tableName, "question_id")
 	_question.CreatedAt = field.NewInt64(tableName, "created_at")
 	_question.ModifiedAt = field.NewInt64(tableName, "modified_at")
-	_question.DeletedAt = field.NewInt64(tableName, "deleted_at")
+	_question.DeletedAt = field.NewField(tableName, "deleted_at")
 	_question.CreatedBy = field.NewString(tableName, "created_by")
 	_question.ModifiedBy = field.NewString(tableName, "modified_by")
 	_question.DeletedBy = field.NewString(tableName, "deleted_by")
 	_question.QuizID = field.NewString(tableName, "quiz_id")
 	_question.QuestionText = field.NewString(tableName, "question_text")
 	_question.QuestionType = field.NewString(tableName, "question_type")
+	_question.GradingMode = field.NewString(tableName, "grading_mode")
+	_question.PassageID = field.NewString(tableName, "passage_id")
+	_question.Section = field.NewString(tableName, "section")
 	_question.Quiz = questionBelongsToQuiz{
 		db: db.Session(&gorm.Session{}),
 
@@ -67,13 +70,16 @@ type question struct {
 	QuestionID   field.String
 	CreatedAt    field.Int64
 	ModifiedAt   field.Int64
-	DeletedAt    field.Int64
+	DeletedAt    field.Field
 	CreatedBy    field.String
 	ModifiedBy   field.String
 	DeletedBy    field.String
 	QuizID       field.String
 	QuestionText field.String
 	QuestionType field.String
+	GradingMode  field.String
+	PassageID    field.String
+	Section      field.String
 	Quiz         questionBelongsToQuiz
 
 	Answers questionHasManyAnswers
@@ -96,13 +102,16 @@ func (q *question) updateTableName(table string) *question {
 	q.QuestionID = field.NewString(table, "question_id")
 	q.CreatedAt = field.NewInt64(table, "created_at")
 	q.ModifiedAt = field.NewInt64(table, "modified_at")
-	q.DeletedAt = field.NewInt64(table, "deleted_at")
+	q.DeletedAt = field.NewField(table, "deleted_at")
 	q.CreatedBy = field.NewString(table, "created_by")
 	q.ModifiedBy = field.NewString(table, "modified_by")
 	q.DeletedBy = field.NewString(table, "deleted_by")
 	q.QuizID = field.NewString(table, "quiz_id")
 	q.QuestionText = field.NewString(table, "question_text")
 	q.QuestionType = field.NewString(table, "question_type")
+	q.GradingMode = field.NewString(table, "grading_mode")
+	q.PassageID = field.NewString(table, "passage_id")
+	q.Section = field.NewString(table, "section")
 
 	q.fillFieldMap()
 
@@ -119,7 +128,7 @@ func (q *question) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (q *question) fillFieldMap() {
-	q.fieldMap = make(map[string]field.Expr, 12)
+	q.fieldMap = make(map[string]field.Expr, 15)
 	q.fieldMap["question_id"] = q.QuestionID
 	q.fieldMap["created_at"] = q.CreatedAt
 	q.fieldMap["modified_at"] = q.ModifiedAt
@@ -130,6 +139,9 @@ func (q *question) fillFieldMap() {
 	q.fieldMap["quiz_id"] = q.QuizID
 	q.fieldMap["question_text"] = q.QuestionText
 	q.fieldMap["question_type"] = q.QuestionType
+	q.fieldMap["grading_mode"] = q.GradingMode
+	q.fieldMap["passage_id"] = q.PassageID
+	q.fieldMap["section"] = q.Section
 
 }
 
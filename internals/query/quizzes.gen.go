@@ -31,12 +31,20 @@ func newQuiz(db *gorm.DB, opts ...gen.DOOption) quiz {
 	_quiz.QuizID = field.NewString(tableName, "quiz_id")
 	_quiz.CreatedAt = field.NewInt64(tableName, "created_at")
 	_quiz.ModifiedAt = field.NewInt64(tableName, "modified_at")
-	_quiz.DeletedAt = field.NewInt64(tableName, "deleted_at")
+	_quiz.DeletedAt = field.NewField(tableName, "deleted_at")
 	_quiz.CreatedBy = field.NewString(tableName, "created_by")
 	_quiz.ModifiedBy = field.NewString(tableName, "modified_by")
 	_quiz.DeletedBy = field.NewString(tableName, "deleted_by")
 	_quiz.Title = field.NewString(tableName, "title")
 	_quiz.Description = field.NewString(tableName, "description")
+	_quiz.Status = field.NewString(tableName, "status")
+	_quiz.JlptLevel = field.NewString(tableName, "jlpt_level")
+	_quiz.ReviewerID = field.NewString(tableName, "reviewer_id")
+	_quiz.SubmittedAt = field.NewInt64(tableName, "submitted_at")
+	_quiz.SubmittedBy = field.NewString(tableName, "submitted_by")
+	_quiz.PublishAt = field.NewInt64(tableName, "publish_at")
+	_quiz.PublishedAt = field.NewInt64(tableName, "published_at")
+	_quiz.PublishedBy = field.NewString(tableName, "published_by")
 	_quiz.Questions = quizHasManyQuestions{
 		db: db.Session(&gorm.Session{}),
 
@@ -55,12 +63,20 @@ type quiz struct {
 	QuizID      field.String
 	CreatedAt   field.Int64
 	ModifiedAt  field.Int64
-	DeletedAt   field.Int64
+	DeletedAt   field.Field
 	CreatedBy   field.String
 	ModifiedBy  field.String
 	DeletedBy   field.String
 	Title       field.String
 	Description field.String
+	Status      field.String
+	JlptLevel   field.String
+	ReviewerID  field.String
+	SubmittedAt field.Int64
+	SubmittedBy field.String
+	PublishAt   field.Int64
+	PublishedAt field.Int64
+	PublishedBy field.String
 	Questions   quizHasManyQuestions
 
 	fieldMap map[string]field.Expr
@@ -81,12 +97,20 @@ func (q *quiz) updateTableName(table string) *quiz {
 	q.QuizID = field.NewString(table, "quiz_id")
 	q.CreatedAt = field.NewInt64(table, "created_at")
 	q.ModifiedAt = field.NewInt64(table, "modified_at")
-	q.DeletedAt = field.NewInt64(table, "deleted_at")
+	q.DeletedAt = field.NewField(table, "deleted_at")
 	q.CreatedBy = field.NewString(table, "created_by")
 	q.ModifiedBy = field.NewString(table, "modified_by")
 	q.DeletedBy = field.NewString(table, "deleted_by")
 	q.Title = field.NewString(table, "title")
 	q.Description = field.NewString(table, "description")
+	q.Status = field.NewString(table, "status")
+	q.JlptLevel = field.NewString(table, "jlpt_level")
+	q.ReviewerID = field.NewString(table, "reviewer_id")
+	q.SubmittedAt = field.NewInt64(table, "submitted_at")
+	q.SubmittedBy = field.NewString(table, "submitted_by")
+	q.PublishAt = field.NewInt64(table, "publish_at")
+	q.PublishedAt = field.NewInt64(table, "published_at")
+	q.PublishedBy = field.NewString(table, "published_by")
 
 	q.fillFieldMap()
 
@@ -103,7 +127,7 @@ func (q *quiz) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (q *quiz) fillFieldMap() {
-	q.fieldMap = make(map[string]field.Expr, 10)
+	q.fieldMap = make(map[string]field.Expr, 18)
 	q.fieldMap["quiz_id"] = q.QuizID
 	q.fieldMap["created_at"] = q.CreatedAt
 	q.fieldMap["modified_at"] = q.ModifiedAt
@@ -113,6 +137,14 @@ func (q *quiz) fillFieldMap() {
 	q.fieldMap["deleted_by"] = q.DeletedBy
 	q.fieldMap["title"] = q.Title
 	q.fieldMap["description"] = q.Description
+	q.fieldMap["status"] = q.Status
+	q.fieldMap["jlpt_level"] = q.JlptLevel
+	q.fieldMap["reviewer_id"] = q.ReviewerID
+	q.fieldMap["submitted_at"] = q.SubmittedAt
+	q.fieldMap["submitted_by"] = q.SubmittedBy
+	q.fieldMap["publish_at"] = q.PublishAt
+	q.fieldMap["published_at"] = q.PublishedAt
+	q.fieldMap["published_by"] = q.PublishedBy
 
 }
 
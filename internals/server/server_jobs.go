@@ -0,0 +1,28 @@
+package server
+
+import (
+	"context"
+
+	"wakuwaku_nihongo/config"
+	"wakuwaku_nihongo/internals/app/calibration"
+	"wakuwaku_nihongo/internals/app/leaderboards"
+	"wakuwaku_nihongo/internals/app/quizzes"
+	"wakuwaku_nihongo/internals/app/streaks"
+	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/pkg/scheduler"
+)
+
+// InitJobs starts the background jobs until ctx is done, unless the
+// scheduler is disabled for this instance.
+func InitJobs(ctx context.Context, f *factory.Factory) {
+	if !config.Get().EnableScheduler {
+		return
+	}
+
+	s := scheduler.New()
+	s.Every(streaks.ROLLOVER_INTERVAL, streaks.ROLLOVER_JOB_NAME, streaks.NewJob(f).Run)
+	s.Every(leaderboards.ARCHIVE_INTERVAL, leaderboards.ARCHIVE_JOB_NAME, leaderboards.NewJob(f).Run)
+	s.Every(calibration.CALIBRATION_INTERVAL, calibration.CALIBRATION_JOB_NAME, calibration.NewJob(f).Run)
+	s.Every(quizzes.PUBLISH_INTERVAL, quizzes.PUBLISH_JOB_NAME, quizzes.NewJob(f).Run)
+	s.Start(ctx)
+}
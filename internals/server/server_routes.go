@@ -9,8 +9,32 @@ import (
 	echoSwagger "github.com/swaggo/echo-swagger"
 	"wakuwaku_nihongo/config"
 	"wakuwaku_nihongo/docs"
+	"wakuwaku_nihongo/internals/app/achievements"
+	"wakuwaku_nihongo/internals/app/analysis"
+	"wakuwaku_nihongo/internals/app/attempts"
+	"wakuwaku_nihongo/internals/app/bookmarks"
+	"wakuwaku_nihongo/internals/app/calibration"
+	"wakuwaku_nihongo/internals/app/decks"
+	"wakuwaku_nihongo/internals/app/dictionary"
 	"wakuwaku_nihongo/internals/app/example_feat"
+	"wakuwaku_nihongo/internals/app/explanations"
+	"wakuwaku_nihongo/internals/app/friends"
+	"wakuwaku_nihongo/internals/app/grammar"
+	"wakuwaku_nihongo/internals/app/leaderboards"
+	"wakuwaku_nihongo/internals/app/media"
+	"wakuwaku_nihongo/internals/app/notifications"
+	"wakuwaku_nihongo/internals/app/passages"
+	"wakuwaku_nihongo/internals/app/plans"
+	"wakuwaku_nihongo/internals/app/preferences"
+	"wakuwaku_nihongo/internals/app/quizzes"
+	"wakuwaku_nihongo/internals/app/reports"
+	"wakuwaku_nihongo/internals/app/revisions"
+	"wakuwaku_nihongo/internals/app/search"
+	"wakuwaku_nihongo/internals/app/stats"
+	"wakuwaku_nihongo/internals/app/streaks"
+	"wakuwaku_nihongo/internals/app/vocabulary"
 	"wakuwaku_nihongo/internals/factory"
+	"wakuwaku_nihongo/internals/middleware"
 )
 
 func Init(e *echo.Echo, f *factory.Factory) {
@@ -30,8 +54,66 @@ func Init(e *echo.Echo, f *factory.Factory) {
 		e.GET("/swagger/*", echoSwagger.WrapHandler)
 	}
 
+	// a customer's preferred locale wins over Accept-Language
+	middleware.SetLocaleResolver(preferences.NewService(f).Locale)
+
 	// routes v1
 	api := e.Group("/api/v1")
 
 	example_feat.NewHandler(f).Route(api.Group("/users"))
+	dictionary.NewHandler(f).Route(api.Group("/dictionary"))
+
+	grammarHandler := grammar.NewHandler(f)
+	grammarHandler.Route(api.Group("/grammar-points"))
+	grammarHandler.QuestionRoute(api.Group("/questions"))
+
+	quizHandler := quizzes.NewHandler(f)
+	quizHandler.Route(api.Group("/quizzes"))
+	quizHandler.QuestionRoute(api.Group("/questions"))
+	search.NewHandler(f).QuestionRoute(api.Group("/questions"))
+	explanations.NewHandler(f).QuestionRoute(api.Group("/questions"))
+	attempts.NewHandler(f).Route(api.Group("/attempts"))
+	analysis.NewHandler(f).Route(api.Group("/analysis"))
+	vocabulary.NewHandler(f).Route(api.Group("/vocabulary"))
+	passages.NewHandler(f).Route(api.Group("/passages"))
+	preferences.NewHandler(f).Route(api.Group("/me/preferences"))
+	stats.NewHandler(f).Route(api.Group("/me/stats"))
+
+	streakHandler := streaks.NewHandler(f)
+	streakHandler.Route(api.Group("/me/streak"))
+	streakHandler.AdminRoute(api.Group("/streaks"))
+
+	friends.NewHandler(f).Route(api.Group("/me/friends"))
+	leaderboards.NewHandler(f).Route(api.Group("/leaderboards"))
+
+	achievementHandler := achievements.NewHandler(f)
+	achievementHandler.Route(api.Group("/achievements"))
+	achievementHandler.BadgeRoute(api.Group("/me/badges"))
+
+	plans.NewHandler(f).Route(api.Group("/me/plan"))
+
+	calibration.NewHandler(f).Route(api.Group("/calibrations"))
+
+	deckHandler := decks.NewHandler(f)
+	deckHandler.Route(api.Group("/decks"))
+	deckHandler.ReviewRoute(api.Group("/me/reviews"))
+
+	mediaHandler := media.NewHandler(f)
+	mediaHandler.Route(api.Group("/media"))
+	mediaHandler.QuestionRoute(api.Group("/questions"))
+	mediaHandler.PassageRoute(api.Group("/passages"))
+
+	bookmarkHandler := bookmarks.NewHandler(f)
+	bookmarkHandler.Route(api.Group("/me/bookmarks"))
+	bookmarkHandler.NoteRoute(api.Group("/me/notes"))
+	bookmarkHandler.QuestionRoute(api.Group("/questions"))
+
+	reportHandler := reports.NewHandler(f)
+	reportHandler.Route(api.Group("/reports"))
+	reportHandler.ReporterRoute(api.Group("/me/reports"))
+	reportHandler.QuestionRoute(api.Group("/questions"))
+
+	notifications.NewHandler(f).Route(api.Group("/me/notifications"))
+
+	revisions.NewHandler(f).QuestionRoute(api.Group("/questions"))
 }
@@ -28,7 +28,7 @@ var (
 	ErrValidation                       = CustomError(http.StatusBadRequest, 40002, "Invalid parameters or payload")
 	ErrForgotPasswordResendTokenInvalid = CustomError(http.StatusBadRequest, 40005, "Invalid forgot password resend token")
 	ErrInvalidUpdateStatus              = CustomError(http.StatusBadRequest, 40006, "Invalid status update")
-	ErrInvalidOldPassword               = CustomError(http.StatusBadRequest, 40007, "Kata Sandi Lama tidak sesuai")
+	ErrInvalidOldPassword               = CustomError(http.StatusBadRequest, 40007, "Old password does not match")
 
 	// Unauthorized
 	ErrUnauthorized           = CustomError(http.StatusUnauthorized, 40101, "Unauthorized, please login")
@@ -42,7 +42,7 @@ var (
 	ErrForbidden              = CustomError(http.StatusForbidden, 40301, "Forbidden")
 	ErrAccountNotInWhitelist  = CustomError(http.StatusForbidden, 40302, "User not in whitelist")
 	ErrForbiddenRoom          = CustomError(http.StatusForbidden, 40303, "You are not authorized to access this room.")
-	ErrForbiddenApiPermission = CustomError(http.StatusForbidden, 40304, "Anda tidak memiliki akses resource ini")
+	ErrForbiddenApiPermission = CustomError(http.StatusForbidden, 40304, "You do not have access to this resource")
 
 	// NotFound
 	ErrNotFound             = CustomError(http.StatusNotFound, 40401, "Data not found")
@@ -64,6 +64,9 @@ var (
 
 	// InternalServerError
 	ErrInternalServerError = CustomError(http.StatusInternalServerError, 50001, "Something bad happened")
+
+	// ServiceUnavailable
+	ErrServiceUnavailable = CustomError(http.StatusServiceUnavailable, 50301, "Service is temporarily unavailable")
 )
 
 func ErrorBuilder(res *Error, message error) *Error {
@@ -94,8 +97,10 @@ func ErrorWrap(errBase *Error, err error, detail ...any) *Error {
 		resp.Response.Meta.Detail = detail[0]
 	}
 
-	if errBase.Code != http.StatusInternalServerError {
-		resp.Response.Meta.Message = err.Error()
+	// Meta.Message is the localized message of the status code, the cause
+	// is reported in Error unless it is an internal error.
+	if errBase.Code != http.StatusInternalServerError && err != nil {
+		resp.Response.Error = err.Error()
 	}
 
 	return resp
@@ -114,16 +119,17 @@ func CustomError(httpCode, statusCode int, message string) *Error {
 	}
 }
 
-// CustomErrorMessage return new error
+// CustomErrorMessage return new error, message is reported in Error next to
+// the localized message of base.
 func CustomErrorMessage(base *Error, message string, err error) *Error {
 	return &Error{
 		Response: errorResponse{
 			Meta: Meta{
 				Success:    false,
-				Message:    message,
+				Message:    base.Response.Meta.Message,
 				StatusCode: base.Response.Meta.StatusCode,
 			},
-			Error: base.Response.Error,
+			Error: message,
 		},
 		Code:         base.Code,
 		ErrorMessage: err,
@@ -164,5 +170,7 @@ func (e *Error) Send(c echo.Context, request ...any) error {
 
 	event.Send()
 
-	return c.JSON(e.Code, e.Response)
+	res := e.Response
+	localize(c, &res.Meta)
+	return c.JSON(e.Code, res)
 }
@@ -0,0 +1,109 @@
+package response
+
+import (
+	"wakuwaku_nihongo/internals/pkg/i18n"
+
+	"github.com/labstack/echo/v4"
+)
+
+// MESSAGES translates Meta.Message by status code. English is the message
+// given where the error or success is declared.
+var MESSAGES = i18n.Catalog{
+	i18n.Id: {
+		20001: "Permintaan berhasil diproses",
+		20002: "ID dan VA sudah dibuat",
+
+		40001: "Permintaan tidak valid",
+		40002: "Parameter atau payload tidak valid",
+		40004: "Anda telah mencapai batas maksimum permintaan untuk hari ini",
+		40005: "Token kirim ulang lupa kata sandi tidak valid",
+		40006: "Pembaruan status tidak valid",
+		40007: "Kata Sandi Lama tidak sesuai",
+
+		40101: "Tidak terotorisasi, silakan masuk",
+		40102: "Kredensial pengguna tidak valid",
+		40103: "ID aplikasi tidak valid",
+		40104: "Token akses telah kedaluwarsa",
+		40105: "Akun pengguna tidak valid",
+		40106: "Autentikasi publik tidak valid",
+
+		40301: "Akses ditolak",
+		40302: "Pengguna tidak termasuk dalam daftar yang diizinkan",
+		40303: "Anda tidak memiliki akses ke ruangan ini.",
+		40304: "Anda tidak memiliki akses resource ini",
+
+		40401: "Data tidak ditemukan",
+		40402: "Rute tidak ditemukan",
+		40403: "Akun ini belum terdaftar. Silakan hubungi administrator Anda untuk bantuan",
+
+		40901: "Data yang dibuat sudah ada",
+		40902: "Akun Anda sudah terdaftar",
+
+		42201: "Parameter atau payload tidak valid",
+		42202: "Ups! Nilai yang dimasukkan bukan kelipatan 1000. Silakan perbaiki.",
+		42205: "OTP tidak valid",
+		42206: "Token tidak valid",
+
+		50001: "Terjadi kesalahan pada server",
+
+		50301: "Layanan sedang tidak tersedia",
+	},
+	i18n.Ja: {
+		20001: "リクエストは正常に処理されました",
+		20002: "IDとVAはすでに作成されています",
+
+		40001: "不正なリクエストです",
+		40002: "パラメータまたはペイロードが無効です",
+		40004: "本日のリクエスト上限に達しました",
+		40005: "パスワード再設定の再送トークンが無効です",
+		40006: "ステータスの更新が無効です",
+		40007: "現在のパスワードが一致しません",
+
+		40101: "認証されていません。ログインしてください",
+		40102: "ユーザーの認証情報が無効です",
+		40103: "アプリケーションIDが無効です",
+		40104: "アクセストークンの有効期限が切れています",
+		40105: "ユーザーアカウントが無効です",
+		40106: "公開認証が無効です",
+
+		40301: "アクセスが拒否されました",
+		40302: "ユーザーが許可リストに含まれていません",
+		40303: "このルームにアクセスする権限がありません。",
+		40304: "このリソースにアクセスする権限がありません",
+
+		40401: "データが見つかりません",
+		40402: "ルートが見つかりません",
+		40403: "このアカウントは登録されていません。管理者にお問い合わせください",
+
+		40901: "作成しようとした値はすでに存在します",
+		40902: "このアカウントはすでに登録されています",
+
+		42201: "パラメータまたはペイロードが無効です",
+		42202: "入力された値は1000の倍数ではありません。修正してください。",
+		42205: "OTPが無効です",
+		42206: "トークンが無効です",
+
+		50001: "サーバーでエラーが発生しました",
+
+		50301: "サービスは一時的に利用できません",
+	},
+}
+
+// Locale returns the locale of the request: the one set on the context from
+// the customer's preference, otherwise the best match of Accept-Language.
+func Locale(c echo.Context) i18n.Locale {
+	if l, ok := c.Get(i18n.CONTEXT_KEY).(i18n.Locale); ok && l.IsValid() {
+		return l
+	}
+	return i18n.Negotiate(c.Request().Header.Get("Accept-Language"))
+}
+
+// localize translates meta in place, keeping its message when the status
+// code has no translation.
+func localize(c echo.Context, meta *Meta) {
+	l := Locale(c)
+	if msg, ok := MESSAGES.Lookup(l, meta.StatusCode); ok {
+		meta.Message = msg
+	}
+	c.Response().Header().Set("Content-Language", string(l))
+}
@@ -53,12 +53,16 @@ func SuccessBuilder(res *Success, data interface{}) *Success {
 	return res
 }
 
+// SuccessResponse returns a copy of SuccessConstant.OK carrying data.
 func SuccessResponse(data interface{}) *Success {
-	return SuccessBuilder(&SuccessConstant.OK, data)
+	res := SuccessConstant.OK
+	return SuccessBuilder(&res, data)
 }
 
 func (s *Success) Send(c echo.Context) error {
-	return c.JSON(s.Code, s.Response)
+	res := s.Response
+	localize(c, &res.Meta)
+	return c.JSON(s.Code, res)
 }
 
 type SuccessResponseWithInfo struct {
@@ -78,5 +82,6 @@ func SuccessResponseInfo(data interface{}, info *abstraction.PaginationInfo) (re
 }
 
 func (s *SuccessResponseWithInfo) Send(c echo.Context) error {
+	localize(c, &s.Meta)
 	return c.JSON(200, s)
 }
@@ -0,0 +1,88 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"wakuwaku_nihongo/internals/pkg/i18n"
+	"wakuwaku_nihongo/internals/utils/response"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+type body struct {
+	Meta  response.Meta `json:"meta"`
+	Error string        `json:"error"`
+}
+
+func send(t *testing.T, acceptLanguage string, locale i18n.Locale, fn func(c echo.Context) error) (*httptest.ResponseRecorder, body) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	if acceptLanguage != "" {
+		req.Header.Set("Accept-Language", acceptLanguage)
+	}
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	if locale != "" {
+		c.Set(i18n.CONTEXT_KEY, locale)
+	}
+	assert.NoError(t, fn(c))
+
+	var out body
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &out))
+	return rec, out
+}
+
+func TestErrorLocalized(t *testing.T) {
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		locale         i18n.Locale
+		message        string
+		language       string
+	}{
+		{"default", "", "", "You do not have access to this resource", "en"},
+		{"accept language", "ja-JP,ja;q=0.9", "", "このリソースにアクセスする権限がありません", "ja"},
+		{"unsupported", "fr", "", "You do not have access to this resource", "en"},
+		{"preference wins", "ja", i18n.Id, "Anda tidak memiliki akses resource ini", "id"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec, out := send(t, tt.acceptLanguage, tt.locale, func(c echo.Context) error {
+				return response.ErrorWrap(response.ErrForbiddenApiPermission, errors.New(`role "learner" is not allowed`)).Send(c)
+			})
+			assert.Equal(t, http.StatusForbidden, rec.Code)
+			assert.Equal(t, 40304, out.Meta.StatusCode)
+			assert.Equal(t, tt.message, out.Meta.Message)
+			assert.Equal(t, `role "learner" is not allowed`, out.Error)
+			assert.Equal(t, tt.language, rec.Header().Get("Content-Language"))
+		})
+	}
+
+	// the shared error is left untouched for the next request
+	assert.Equal(t, "You do not have access to this resource", response.ErrForbiddenApiPermission.Response.Meta.Message)
+}
+
+func TestInternalErrorHidesCause(t *testing.T) {
+	_, out := send(t, "id", "", func(c echo.Context) error {
+		return response.ErrorWrap(response.ErrInternalServerError, errors.New("pq: connection refused")).Send(c)
+	})
+	assert.Equal(t, "Terjadi kesalahan pada server", out.Meta.Message)
+	assert.Empty(t, out.Error)
+}
+
+func TestSuccessLocalized(t *testing.T) {
+	rec, out := send(t, "ja", "", func(c echo.Context) error {
+		return response.SuccessResponse(map[string]string{"id": "1"}).Send(c)
+	})
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "リクエストは正常に処理されました", out.Meta.Message)
+
+	_, out = send(t, "", "", func(c echo.Context) error {
+		return response.SuccessResponse(nil).Send(c)
+	})
+	assert.Equal(t, "Request successfully proceed", out.Meta.Message)
+}
@@ -8,13 +8,20 @@ import (
 	"github.com/golang-jwt/jwt"
 )
 
+const (
+	ROLE_LEARNER = "learner"
+	ROLE_EDITOR  = "editor"
+	ROLE_ADMIN   = "admin"
+)
+
 // GenerateJWT generates a JWT token with a given UUID and expiration time.
-func GenerateJWT(userID string, email string) (string, error) {
+func GenerateJWT(userID string, email string, role string) (string, error) {
 	jwtKey := config.Get().JWT.Key
 	// Define token claims
 	claims := jwt.MapClaims{
 		"user_id": userID,
 		"email":   email,
+		"role":    role,
 		"exp":     time.Now().Add(time.Hour * 24).Unix(), // Token expires in 24 hours
 	}
 
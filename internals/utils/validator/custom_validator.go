@@ -65,3 +65,16 @@ func validateIsDate(fl validator.FieldLevel) bool {
 
 	return true
 }
+
+// validateText returns a validation function from a string predicate, used by
+// the kana and ruby tags.
+// Empty strings pass so the tags can be combined with omitempty or required.
+func validateText(fn func(string) bool) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		field := fl.Field().String()
+		if field == "" {
+			return true
+		}
+		return fn(field)
+	}
+}
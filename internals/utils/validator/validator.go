@@ -2,6 +2,9 @@ package validator
 
 import (
 	valid "github.com/go-playground/validator/v10"
+
+	"wakuwaku_nihongo/internals/pkg/kana"
+	"wakuwaku_nihongo/internals/pkg/ruby"
 )
 
 type customValidator struct {
@@ -15,6 +18,11 @@ func NewCustomValidator() *customValidator {
 	newValidator.RegisterValidation("is-date", validateIsDate)
 	newValidator.RegisterValidation("phone", validatePhoneNumber)
 	newValidator.RegisterValidation("password", validatePassword)
+	newValidator.RegisterValidation("hiragana", validateText(kana.IsHiragana))
+	newValidator.RegisterValidation("katakana", validateText(kana.IsKatakana))
+	newValidator.RegisterValidation("kana", validateText(kana.IsKana))
+	newValidator.RegisterValidation("romaji", validateText(kana.IsRomaji))
+	newValidator.RegisterValidation("ruby", validateText(ruby.IsValid))
 
 	return &customValidator{
 		validator: newValidator,
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -50,6 +51,7 @@ func main() {
 	e.IPExtractor = echo.ExtractIPDirect()
 	middleware.Init(e, f.Redis)
 	httpserver.Init(e, f)
+	httpserver.InitJobs(context.Background(), f)
 
 	if err := e.Start(fmt.Sprintf(":%d", port)); err != nil && err != http.ErrServerClosed {
 		e.Logger.Fatal("shutting down the server")